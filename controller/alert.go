@@ -0,0 +1,236 @@
+package controller
+
+import (
+	"Glue-API/httputil"
+	"Glue-API/model"
+	"Glue-API/utils"
+	"Glue-API/utils/alert"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+func (c *Controller) AlertOption(ctx *gin.Context) {
+	SetOptionHeader(ctx)
+	ctx.IndentedJSON(http.StatusOK, nil)
+}
+
+// AlertList godoc
+//
+//	@Summary		Show List of Glue Alerts
+//	@Description	경고 규칙을 평가하여 발생한 경고 목록을 보여줍니다. 해제된 경고는 24시간 동안 보여줍니다.
+//	@Tags			Alert
+//	@param			state	query	string	false	"Alert State(pending, firing, resolved)"
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{array}		model.Alert
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/alerts [get]
+func (c *Controller) AlertList(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	state := ctx.Request.URL.Query().Get("state")
+	if state != "" && state != "pending" && state != "firing" && state != "resolved" {
+		err := errors.New("state must be pending, firing or resolved")
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+	dat := alert.List(state)
+	ctx.IndentedJSON(http.StatusOK, dat)
+}
+
+// AlertRuleList godoc
+//
+//	@Summary		Show List of Glue Alert Rules
+//	@Description	등록된 경고 규칙 목록을 보여줍니다.
+//	@Tags			Alert
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{object}	model.AlertRuleList
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/alerts/rule [get]
+func (c *Controller) AlertRuleList(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	dat, err := alert.RuleList()
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, dat)
+}
+
+// AlertRuleCreate godoc
+//
+//	@Summary		Create of Glue Alert Rule
+//	@Description	경고 규칙을 등록합니다. 헬스 체크 코드, 클러스터 및 풀 사용률, 미러링 이미지 상태, SMB 호스트 상태를 조건으로 사용할 수 있습니다.
+//	@Tags			Alert
+//	@param			rule_name	formData	string	true	"Alert Rule Name"
+//	@param			type		formData	string	true	"Alert Rule Type"	Enums(health_check, cluster_usage, pool_usage, mirror_image, smb_host)
+//	@param			target		formData	string	false	"Health Check Code(* is all), Pool Name or Mirror Pool Name"
+//	@param			threshold	formData	number	false	"Usage Threshold(%)"
+//	@param			severity	formData	string	true	"Alert Severity"	Enums(warning, critical)
+//	@param			duration	formData	int		false	"Duration Seconds Before Firing"	default(0)
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{string}	string	"Success"
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/alerts/rule [post]
+func (c *Controller) AlertRuleCreate(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	var dat model.AlertRule
+	var err error
+	dat.Name, _ = ctx.GetPostForm("rule_name")
+	dat.Type, _ = ctx.GetPostForm("type")
+	dat.Target, _ = ctx.GetPostForm("target")
+	dat.Severity, _ = ctx.GetPostForm("severity")
+	if threshold, ok := ctx.GetPostForm("threshold"); ok && threshold != "" {
+		if dat.Threshold, err = strconv.ParseFloat(threshold, 64); err != nil {
+			err = errors.New("threshold must be a number")
+			utils.FancyHandleError(err)
+			httputil.NewError(ctx, http.StatusBadRequest, err)
+			return
+		}
+	}
+	if duration, ok := ctx.GetPostForm("duration"); ok && duration != "" {
+		if dat.Duration, err = strconv.Atoi(duration); err != nil || dat.Duration < 0 {
+			err = errors.New("duration must be a positive number")
+			utils.FancyHandleError(err)
+			httputil.NewError(ctx, http.StatusBadRequest, err)
+			return
+		}
+	}
+	// 미러링 규칙의 기본 대상 풀은 rbd 입니다.
+	if dat.Type == "mirror_image" && dat.Target == "" {
+		dat.Target = "rbd"
+	}
+
+	output, err := alert.RuleAdd(dat)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, output)
+}
+
+// AlertRuleDelete godoc
+//
+//	@Summary		Delete of Glue Alert Rule
+//	@Description	경고 규칙과 해당 규칙으로 발생한 경고를 삭제합니다.
+//	@Tags			Alert
+//	@param			rule_name	path	string	true	"Alert Rule Name"
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{string}	string	"Success"
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/alerts/rule/{rule_name} [delete]
+func (c *Controller) AlertRuleDelete(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	rule_name := ctx.Param("rule_name")
+	output, err := alert.RuleDelete(rule_name)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusNotFound, err)
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, output)
+}
+
+// AlertSilenceList godoc
+//
+//	@Summary		Show List of Glue Alert Silences
+//	@Description	등록된 경고 무시 설정 목록을 보여줍니다.
+//	@Tags			Alert
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{object}	model.AlertSilenceList
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/alerts/silence [get]
+func (c *Controller) AlertSilenceList(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	dat, err := alert.SilenceList()
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, dat)
+}
+
+// AlertSilenceCreate godoc
+//
+//	@Summary		Create of Glue Alert Silence
+//	@Description	지정한 시각까지 규칙의 경고를 무시합니다. instance 를 비워 두면 규칙의 모든 경고를 무시합니다.
+//	@Tags			Alert
+//	@param			rule_name	formData	string	true	"Alert Rule Name"
+//	@param			instance	formData	string	false	"Alert Instance"
+//	@param			ends_at		formData	string	true	"Silence End Time(RFC3339)"
+//	@param			comment		formData	string	false	"Comment"
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{string}	string	"Silence ID"
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/alerts/silence [post]
+func (c *Controller) AlertSilenceCreate(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	var dat model.AlertSilence
+	dat.Rule, _ = ctx.GetPostForm("rule_name")
+	dat.Instance, _ = ctx.GetPostForm("instance")
+	dat.EndsAt, _ = ctx.GetPostForm("ends_at")
+	dat.Comment, _ = ctx.GetPostForm("comment")
+
+	output, err := alert.SilenceAdd(dat)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, output)
+}
+
+// AlertSilenceDelete godoc
+//
+//	@Summary		Delete of Glue Alert Silence
+//	@Description	경고 무시 설정을 삭제합니다.
+//	@Tags			Alert
+//	@param			silence_id	path	string	true	"Silence ID"
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{string}	string	"Success"
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/alerts/silence/{silence_id} [delete]
+func (c *Controller) AlertSilenceDelete(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	silence_id := ctx.Param("silence_id")
+	output, err := alert.SilenceDelete(silence_id)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusNotFound, err)
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, output)
+}
@@ -0,0 +1,740 @@
+package controller
+
+import (
+	"Glue-API/httputil"
+	"Glue-API/model"
+	"Glue-API/utils"
+	"Glue-API/utils/fs"
+	"Glue-API/utils/glue"
+	"Glue-API/utils/nfs"
+	"Glue-API/utils/nvmeof"
+	"Glue-API/utils/rgw"
+	"Glue-API/utils/smb"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"gopkg.in/yaml.v2"
+)
+
+// 적용 계획의 한 단계와 실행 함수입니다. 변경이 없거나 충돌인 단계는 run 이 nil 입니다.
+type applyTask struct {
+	step model.ApplyStep
+	run  func() (string, error)
+}
+
+func (c *Controller) ApplyOption(ctx *gin.Context) {
+	SetOptionHeader(ctx)
+	ctx.IndentedJSON(http.StatusOK, nil)
+}
+
+func applyKey(kind string, name string) string {
+	return kind + "/" + name
+}
+
+// applySame 는 순서와 관계없이 두 목록이 같은지 비교합니다.
+func applySame(a []string, b []string) bool {
+	a = append([]string{}, a...)
+	b = append([]string{}, b...)
+	sort.Strings(a)
+	sort.Strings(b)
+	return strings.Join(a, "\n") == strings.Join(b, "\n")
+}
+
+// 대시보드가 돌려주는 iSCSI 타겟입니다. 클라이언트와 그룹, 디스크 설정은 수정할 때 그대로 돌려보냅니다.
+type applyIscsiDisk struct {
+	Pool      string          `json:"pool"`
+	Image     string          `json:"image"`
+	Controls  json.RawMessage `json:"controls"`
+	Backstore string          `json:"backstore"`
+	Lun       int             `json:"lun"`
+}
+type applyIscsiTarget struct {
+	TargetIqn  string           `json:"target_iqn"`
+	Portals    []model.Portals  `json:"portals"`
+	Disks      []applyIscsiDisk `json:"disks"`
+	Clients    json.RawMessage  `json:"clients"`
+	Groups     json.RawMessage  `json:"groups"`
+	AclEnabled bool             `json:"acl_enabled"`
+	Auth       model.Auth       `json:"auth"`
+}
+
+// applyIscsiDiff 는 타겟의 포털, 디스크, ACL, 인증 사용자 중 다른 항목을 반환합니다.
+func applyIscsiDiff(current applyIscsiTarget, target model.ApplyIscsiTarget) (diff []string) {
+	var current_portals, portals, current_disks, disks []string
+	for _, portal := range current.Portals {
+		current_portals = append(current_portals, portal.Host+"/"+portal.Ip)
+	}
+	for _, portal := range target.Portals {
+		portals = append(portals, portal.Host+"/"+portal.Ip)
+	}
+	for _, disk := range current.Disks {
+		current_disks = append(current_disks, disk.Pool+"/"+disk.Image)
+	}
+	for _, disk := range target.Disks {
+		disks = append(disks, disk.Pool+"/"+disk.Name)
+	}
+	if !applySame(current_portals, portals) {
+		diff = append(diff, "portals")
+	}
+	if !applySame(current_disks, disks) {
+		diff = append(diff, "disks")
+	}
+	if current.AclEnabled != target.AclEnabled {
+		diff = append(diff, "acl_enabled")
+	}
+	if current.Auth.User != target.Auth.User || current.Auth.Mutual_User != target.Auth.MutualUser {
+		diff = append(diff, "auth")
+	}
+	return
+}
+
+// applyIscsiUpdate 는 타겟을 명세대로 수정합니다. 남아 있는 디스크는 LUN 과 설정을 유지하고, 클라이언트와 그룹은 그대로 둡니다.
+func applyIscsiUpdate(current applyIscsiTarget, target model.ApplyIscsiTarget) (output string, err error) {
+	value := struct {
+		NewTargetIqn string           `json:"new_target_iqn"`
+		Portals      []model.Portals  `json:"portals"`
+		Disks        []applyIscsiDisk `json:"disks"`
+		Clients      json.RawMessage  `json:"clients"`
+		Groups       json.RawMessage  `json:"groups"`
+		AclEnabled   bool             `json:"acl_enabled"`
+		Auth         model.Auth       `json:"auth"`
+	}{
+		NewTargetIqn: target.TargetIqn,
+		Portals:      target.Portals,
+		Disks:        make([]applyIscsiDisk, 0),
+		Clients:      current.Clients,
+		Groups:       current.Groups,
+		AclEnabled:   target.AclEnabled,
+		Auth: model.Auth{
+			User:            target.Auth.User,
+			Password:        target.Auth.Password,
+			Mutual_User:     target.Auth.MutualUser,
+			Mutual_Password: target.Auth.MutualPassword,
+		},
+	}
+	if len(value.Clients) == 0 {
+		value.Clients = json.RawMessage("[]")
+	}
+	if len(value.Groups) == 0 {
+		value.Groups = json.RawMessage("[]")
+	}
+	lun := 0
+	for _, disk := range current.Disks {
+		if disk.Lun >= lun {
+			lun = disk.Lun + 1
+		}
+	}
+	for _, disk := range target.Disks {
+		found := false
+		for _, current_disk := range current.Disks {
+			if current_disk.Pool == disk.Pool && current_disk.Image == disk.Name {
+				value.Disks = append(value.Disks, current_disk)
+				found = true
+			}
+		}
+		if !found {
+			value.Disks = append(value.Disks, applyIscsiDisk{Pool: disk.Pool, Image: disk.Name, Controls: json.RawMessage("{}"), Backstore: "user:rbd", Lun: lun})
+			lun++
+		}
+	}
+	json_data, err := json.Marshal(value)
+	if err != nil {
+		return
+	}
+	if _, err = glueDashboardRequest(http.MethodPut, "api/iscsi/target/"+target.TargetIqn, json_data); err != nil {
+		return
+	}
+	output = "Success"
+	return
+}
+
+// applyNfsDiff 는 export 의 접근 방식, squash, 전송 프로토콜, 클라이언트 중 다른 항목을 반환합니다.
+// 명세에서 비워 둔 접근 방식, squash, 전송 프로토콜은 비교하지 않습니다.
+func applyNfsDiff(access_type string, squash string, transports []string, clients []any, export model.ApplyNfsExport) (diff []string, err error) {
+	if export.AccessType != "" && !strings.EqualFold(access_type, export.AccessType) {
+		diff = append(diff, "access_type")
+	}
+	if export.Squash != "" && !strings.EqualFold(squash, export.Squash) {
+		diff = append(diff, "squash")
+	}
+	if len(export.Transports) > 0 && !applySame(transports, export.Transports) {
+		diff = append(diff, "transports")
+	}
+	var current_clients []model.NfsExportClient
+	json_data, err := json.Marshal(clients)
+	if err != nil {
+		return
+	}
+	if err = json.Unmarshal(json_data, &current_clients); err != nil {
+		return
+	}
+	client_key := func(list []model.NfsExportClient) (keys []string) {
+		for _, client := range list {
+			for _, address := range client.Addresses {
+				keys = append(keys, address+"/"+strings.ToUpper(client.AccessType)+"/"+strings.ToLower(client.Squash))
+			}
+		}
+		return
+	}
+	if !applySame(client_key(current_clients), client_key(export.Clients)) {
+		diff = append(diff, "clients")
+	}
+	return
+}
+
+// 원하는 상태와 현재 상태를 비교하여 의존성 순서대로 적용 계획을 만듭니다.
+// 풀 -> 이미지 -> GlueFS -> 서브 볼륨 그룹 -> NFS -> SMB -> iSCSI -> NVMe-oF -> RGW 사용자 -> RGW 버킷
+func applyPlan(ctx context.Context, spec model.ApplySpec) (tasks []applyTask, err error) {
+	// 현재 상태 또는 계획에 포함되어 존재하게 될 리소스입니다.
+	known := make(map[string]bool)
+	add := func(kind string, name string, action string, detail string, requires []string, run func() (string, error)) {
+		for _, require := range requires {
+			if !known[require] && action != "conflict" {
+				action = "conflict"
+				detail = require + " does not exist and is not in the spec"
+				run = nil
+			}
+		}
+		if action == "unchanged" || action == "conflict" {
+			run = nil
+		}
+		if action != "conflict" {
+			known[applyKey(kind, name)] = true
+		}
+		if requires == nil {
+			requires = make([]string, 0)
+		}
+		tasks = append(tasks, applyTask{
+			step: model.ApplyStep{Kind: kind, Name: name, Action: action, Detail: detail, Requires: requires},
+			run:  run,
+		})
+	}
+
+	pools, err := glue.PoolDetail(ctx)
+	if err != nil {
+		return
+	}
+	for _, pool := range pools {
+		known[applyKey("pool", pool.PoolName)] = true
+	}
+	for _, pool := range spec.Pools {
+		pool := pool
+		if pool.Application == "" {
+			pool.Application = "rbd"
+		}
+		action := "create"
+		detail := ""
+		for _, current := range pools {
+			if current.PoolName != pool.Name {
+				continue
+			}
+			action = "unchanged"
+			if _, ok := current.ApplicationMetadata[pool.Application]; !ok {
+				action = "conflict"
+				detail = "pool application is not " + pool.Application
+			} else if pool.Size > 0 && current.Size != pool.Size {
+				action = "update"
+				detail = "size " + strconv.Itoa(current.Size) + " -> " + strconv.Itoa(pool.Size)
+			}
+		}
+		add("pool", pool.Name, action, detail, nil, func() (output string, err error) {
+			if action == "create" {
+				output, err = glue.PoolCreate(ctx, model.PoolSpec{Name: pool.Name, Application: pool.Application, Size: pool.Size})
+				return
+			}
+			if pool.Size > 0 {
+				output, err = glue.PoolSet(ctx, pool.Name, "size", strconv.Itoa(pool.Size))
+			}
+			return
+		})
+	}
+
+	images := make(map[string]model.Images)
+	load_images := func(pool_name string) (err error) {
+		if _, ok := images[pool_name]; ok || !known[applyKey("pool", pool_name)] {
+			return
+		}
+		if images[pool_name], err = glue.InfoImage(ctx, pool_name); err != nil {
+			return
+		}
+		for _, current := range images[pool_name] {
+			known[applyKey("image", pool_name+"/"+current.Image)] = true
+		}
+		return
+	}
+	for _, image := range spec.Images {
+		image := image
+		name := image.Pool + "/" + image.Name
+		if err = load_images(image.Pool); err != nil {
+			return
+		}
+		action := "create"
+		detail := ""
+		for _, current := range images[image.Pool] {
+			if current.Image != image.Name || action != "create" {
+				continue
+			}
+			current_size := current.Size / 1024 / 1024
+			action = "unchanged"
+			if current_size < image.Size {
+				action = "update"
+				detail = "size " + strconv.FormatInt(current_size, 10) + "MB -> " + strconv.FormatInt(image.Size, 10) + "MB"
+			} else if current_size > image.Size {
+				action = "conflict"
+				detail = "shrinking image is not applied"
+			}
+		}
+		add("image", name, action, detail, []string{applyKey("pool", image.Pool)}, func() (string, error) {
+			if action == "update" {
+				return glue.ResizeImage(ctx, image.Name, image.Pool, strconv.FormatInt(image.Size, 10))
+			}
+			return glue.CreateImage(ctx, image.Name, image.Pool, strconv.FormatInt(image.Size, 10))
+		})
+	}
+
+	fs_list, err := fs.FsList(ctx)
+	if err != nil {
+		return
+	}
+	for _, fs_info := range fs_list {
+		known[applyKey("filesystem", fs_info.Name)] = true
+	}
+	for _, fs_spec := range spec.Filesystems {
+		fs_spec := fs_spec
+		action := "create"
+		if known[applyKey("filesystem", fs_spec.Name)] {
+			action = "unchanged"
+		}
+		add("filesystem", fs_spec.Name, action, "", nil, func() (string, error) {
+			return fs.FsCreate(ctx, fs_spec.Name, strings.Join(fs_spec.Hosts, ","))
+		})
+	}
+
+	for _, group := range spec.SubVolumeGroups {
+		group := group
+		name := group.FsName + "/" + group.Name
+		if group.Mode == "" {
+			group.Mode = "755"
+		}
+		action := "create"
+		detail := ""
+		if known[applyKey("filesystem", group.FsName)] {
+			if info, err := fs.SubVolumeGroupInfo(ctx, group.FsName, group.Name); err == nil {
+				action = "unchanged"
+				if group.Size > 0 && info.BytesQuota != group.Size {
+					action = "update"
+					detail = "size " + strconv.FormatInt(info.BytesQuota, 10) + " -> " + strconv.FormatInt(group.Size, 10)
+				}
+			}
+		}
+		add("subvolume_group", name, action, detail, []string{applyKey("filesystem", group.FsName)}, func() (string, error) {
+			if action == "update" {
+				return fs.SubVolumeGroupResize(ctx, group.FsName, group.Name, strconv.FormatInt(group.Size, 10))
+			}
+			return fs.SubVolumeGroupCreate(ctx, group.FsName, group.Name, strconv.FormatInt(group.Size, 10), group.DataPool, group.Mode)
+		})
+	}
+
+	exports := make(map[string]model.NfsExportDetailed)
+	for _, export := range spec.NfsExports {
+		export := export
+		name := export.ClusterId + ":" + export.Pseudo
+		if export.StorageName == "" {
+			export.StorageName = "CEPH"
+		}
+		if _, ok := exports[export.ClusterId]; !ok {
+			if exports[export.ClusterId], err = nfs.NfsExportDetailed(export.ClusterId); err != nil {
+				return
+			}
+		}
+		action := "create"
+		detail := ""
+		for _, current := range exports[export.ClusterId] {
+			if current.Pseudo != export.Pseudo {
+				continue
+			}
+			action = "unchanged"
+			if current.Path != export.Path {
+				action = "conflict"
+				detail = "pseudo path is already exported from " + current.Path
+				continue
+			}
+			diff, err := applyNfsDiff(current.AccessType, current.Squash, current.Transports, current.Clients, export)
+			if err != nil {
+				return tasks, err
+			}
+			if len(diff) > 0 {
+				action = "update"
+				detail = strings.Join(diff, ", ") + " differ"
+			}
+		}
+		var requires []string
+		if export.StorageName == "CEPH" {
+			requires = append(requires, applyKey("filesystem", export.FsName))
+		}
+		add("nfs_export", name, action, detail, requires, func() (output string, err error) {
+			var value model.NfsExportAll
+			if export.StorageName == "CEPH" {
+				value = model.NfsExportCreate{
+					AccessType: export.AccessType,
+					Fsal:       model.NfsFsal{Name: export.StorageName, FsName: export.FsName},
+					Protocols:  []int{4},
+					Path:       export.Path,
+					Pseudo:     export.Pseudo,
+					Squash:     export.Squash,
+					Transports: export.Transports,
+					Clients:    export.Clients}
+			} else {
+				value = model.NfsExportRgwCreate{
+					AccessType: export.AccessType,
+					Fsal:       model.RgwFsal{Name: export.StorageName},
+					Protocols:  []int{4},
+					Path:       export.Path,
+					Pseudo:     export.Pseudo,
+					Squash:     export.Squash,
+					Transports: export.Transports,
+					Clients:    export.Clients}
+			}
+			json_data, err := json.MarshalIndent(value, "", " ")
+			if err != nil {
+				return
+			}
+			json_file, err := os.CreateTemp("", "glue-apply-*.json")
+			if err != nil {
+				return
+			}
+			defer os.Remove(json_file.Name())
+			json_file.Write(json_data)
+			json_file.Close()
+			return nfs.NfsExportCreateOrUpdate(export.ClusterId, json_file.Name())
+		})
+	}
+
+	if len(spec.SmbShares) > 0 {
+		smb_status, err := smb.StatusList()
+		if err != nil {
+			return tasks, err
+		}
+		for _, share := range spec.SmbShares {
+			share := share
+			name := share.Host + ":" + share.FolderName
+			if share.CachePolicy == "" {
+				share.CachePolicy = "true"
+			}
+			action := "conflict"
+			detail := "smb host is not found"
+			for _, current := range smb_status {
+				if current.IpAddress != share.Host {
+					continue
+				}
+				if current.ShareFolder == share.FolderName {
+					action = "unchanged"
+					detail = ""
+				} else if current.ShareFolder == "" {
+					action = "create"
+					detail = ""
+				} else {
+					action = "update"
+					detail = "add share folder to existing smb service"
+				}
+			}
+			if action == "create" && (share.Username == "" || share.Password == "") {
+				action = "conflict"
+				detail = "username and password are required to create smb service"
+			}
+			add("smb_share", name, action, detail, []string{applyKey("filesystem", share.FsName)}, func() (string, error) {
+				if action == "update" {
+					return smb.SmbShareFolderAdd(share.Host, share.CachePolicy, share.FolderName, share.Path, share.FsName, share.VolumePath)
+				}
+				return smb.SmbCreate(share.Host, "normal", share.CachePolicy, share.Username, share.Password, share.FolderName, share.Path, share.FsName, share.VolumePath, "", "")
+			})
+		}
+	}
+
+	if len(spec.IscsiTargets) > 0 {
+		var current_targets []applyIscsiTarget
+		current_target_data, err := glueDashboardRequest(http.MethodGet, "api/iscsi/target", nil)
+		if err != nil {
+			return tasks, err
+		}
+		if err = json.Unmarshal(current_target_data, &current_targets); err != nil {
+			return tasks, err
+		}
+		for _, target := range spec.IscsiTargets {
+			target := target
+			action := "create"
+			detail := ""
+			var current applyIscsiTarget
+			for _, current_target := range current_targets {
+				if current_target.TargetIqn != target.TargetIqn {
+					continue
+				}
+				current = current_target
+				action = "unchanged"
+				if diff := applyIscsiDiff(current, target); len(diff) > 0 {
+					action = "update"
+					detail = strings.Join(diff, ", ") + " differ"
+				}
+			}
+			var requires []string
+			for _, disk := range target.Disks {
+				if err = load_images(disk.Pool); err != nil {
+					return tasks, err
+				}
+				requires = append(requires, applyKey("image", disk.Pool+"/"+disk.Name))
+			}
+			add("iscsi_target", target.TargetIqn, action, detail, requires, func() (output string, err error) {
+				if action == "update" {
+					return applyIscsiUpdate(current, target)
+				}
+				value := model.IscsiTargetCreate{
+					Target_Iqn:  target.TargetIqn,
+					Portals:     target.Portals,
+					Disks:       make([]model.Disks, 0),
+					Acl_Enabled: target.AclEnabled,
+					Auth: model.Auth{
+						User:            target.Auth.User,
+						Password:        target.Auth.Password,
+						Mutual_User:     target.Auth.MutualUser,
+						Mutual_Password: target.Auth.MutualPassword,
+					},
+				}
+				for i, disk := range target.Disks {
+					value.Disks = append(value.Disks, model.Disks{Pool: disk.Pool, Image: disk.Name, Backstore: "user:rbd", Lun: i})
+				}
+				json_data, err := json.Marshal(value)
+				if err != nil {
+					return
+				}
+				if _, err = glueDashboardRequest(http.MethodPost, "api/iscsi/target", json_data); err != nil {
+					return
+				}
+				output = "Success"
+				return
+			})
+		}
+	}
+
+	if len(spec.NvmeOfNameSpaces) > 0 {
+		server_gateway_ip, port, err := NvmeOfServerIPandPort()
+		if err != nil {
+			return tasks, err
+		}
+		namespaces := make(map[string]model.NvmeOfNameSpaceList)
+		subsystems := make(map[string]bool)
+		if server_gateway_ip != "not" {
+			current, err := nvmeof.NvmeOfSubSystemList(server_gateway_ip, server_gateway_ip, port, "")
+			if err != nil {
+				return tasks, err
+			}
+			for _, subsystem := range current.Subsystems {
+				subsystems[subsystem.Nqn] = true
+			}
+		}
+		for _, namespace := range spec.NvmeOfNameSpaces {
+			namespace := namespace
+			name := namespace.SubsystemNqn + ":" + namespace.Pool + "/" + namespace.Image
+			if err = load_images(namespace.Pool); err != nil {
+				return tasks, err
+			}
+			action := "create"
+			detail := ""
+			if server_gateway_ip == "not" {
+				action = "conflict"
+				detail = "nvmeof gateway is not deployed"
+			} else if subsystems[namespace.SubsystemNqn] {
+				if _, ok := namespaces[namespace.SubsystemNqn]; !ok {
+					if namespaces[namespace.SubsystemNqn], err = nvmeof.NvmeOfNameSpaceList(server_gateway_ip, server_gateway_ip, port, namespace.SubsystemNqn); err != nil {
+						return tasks, err
+					}
+				}
+				for _, current := range namespaces[namespace.SubsystemNqn].Namespaces {
+					if current.RbdPoolName == namespace.Pool && current.RbdImageName == namespace.Image {
+						action = "unchanged"
+					}
+				}
+			} else {
+				detail = "subsystem will be created"
+			}
+			create_subsystem := !subsystems[namespace.SubsystemNqn]
+			subsystems[namespace.SubsystemNqn] = true
+			add("nvmeof_namespace", name, action, detail, []string{applyKey("image", namespace.Pool+"/"+namespace.Image)}, func() (output string, err error) {
+				if create_subsystem {
+					if output, err = nvmeof.NvmeOfSubSystemCreate(server_gateway_ip, server_gateway_ip, port, namespace.SubsystemNqn); err != nil {
+						return
+					}
+					gat_name, _ := nvmeof.NvmeOfGatewayName()
+					for i := 0; i < len(gat_name); i++ {
+						var gateway_ip string
+						if gateway_ip, err = nvmeof.ServerGatewayIp(gat_name[i].Hostname); err != nil {
+							return
+						}
+						if output, err = nvmeof.NvmeOfDefineGateway(server_gateway_ip, server_gateway_ip, port, namespace.SubsystemNqn, "client."+gat_name[i].Daemon_name, gateway_ip); err != nil {
+							return
+						}
+					}
+					if output, err = nvmeof.NvmeOfHostAdd(server_gateway_ip, server_gateway_ip, port, namespace.SubsystemNqn); err != nil {
+						return
+					}
+				}
+				return nvmeof.NvmeOfNameSpaceCreate(server_gateway_ip, server_gateway_ip, port, namespace.SubsystemNqn, namespace.Pool, namespace.Image)
+			})
+		}
+	}
+
+	if len(spec.RgwUsers) > 0 || len(spec.RgwBuckets) > 0 {
+		users, err := rgw.RgwUserList()
+		if err != nil {
+			return tasks, err
+		}
+		for _, user := range users {
+			known[applyKey("rgw_user", user)] = true
+		}
+		for _, user := range spec.RgwUsers {
+			user := user
+			action := "create"
+			detail := ""
+			if known[applyKey("rgw_user", user.UserId)] {
+				action = "unchanged"
+				info, err := rgw.RgwUserInfo(user.UserId)
+				if err != nil {
+					return tasks, err
+				}
+				if info.DisplayName != user.DisplayName || info.Email != user.Email {
+					action = "update"
+					detail = "display name or email differs"
+				}
+			}
+			add("rgw_user", user.UserId, action, detail, nil, func() (string, error) {
+				if action == "update" {
+					return rgw.RgwUserUpdate(user.UserId, user.DisplayName, user.Email, "", "", "")
+				}
+				return rgw.RgwUserCreate(user.UserId, user.DisplayName, user.Email)
+			})
+		}
+
+		var buckets []string
+		bucket_data, err := rgw.RgwBucketList()
+		if err != nil {
+			return tasks, err
+		}
+		json_data, err := json.Marshal(bucket_data)
+		if err != nil {
+			return tasks, err
+		}
+		if err = json.Unmarshal(json_data, &buckets); err != nil {
+			return tasks, err
+		}
+		for _, bucket := range spec.RgwBuckets {
+			bucket := bucket
+			if bucket.Zonegroup == "" {
+				bucket.Zonegroup = "default"
+			}
+			if bucket.PlacementTarget == "" {
+				bucket.PlacementTarget = "default-placement"
+			}
+			action := "create"
+			for _, current := range buckets {
+				if current == bucket.Name {
+					action = "unchanged"
+				}
+			}
+			add("rgw_bucket", bucket.Name, action, "", []string{applyKey("rgw_user", bucket.Owner)}, func() (output string, err error) {
+				json_data, err := json.Marshal(map[string]string{
+					"bucket":           bucket.Name,
+					"uid":              bucket.Owner,
+					"zonegroup":        bucket.Zonegroup,
+					"placement_target": bucket.PlacementTarget,
+				})
+				if err != nil {
+					return
+				}
+				if _, err = glueDashboardRequest(http.MethodPost, "api/rgw/bucket", json_data); err != nil {
+					return
+				}
+				output = "Success"
+				return
+			})
+		}
+	}
+	return
+}
+
+// Apply godoc
+//
+//	@Summary		Apply of Declarative Desired State
+//	@Description	YAML 로 작성된 원하는 상태(풀, 이미지, GlueFS, 서브 볼륨 그룹, NFS, SMB, iSCSI, NVMe-oF, RGW)를 현재 상태와 비교하여 계획을 보여주고 의존성 순서대로 적용합니다.
+//	@Tags			Apply
+//	@param			spec	formData	string	true	"Desired State YAML"
+//	@param			dry_run	formData	boolean	false	"Only Show Plan" default(false)
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{object}	model.ApplyResult
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/apply [post]
+func (c *Controller) Apply(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	spec_yaml, _ := ctx.GetPostForm("spec")
+	dry_run_str, _ := ctx.GetPostForm("dry_run")
+	dry_run, _ := strconv.ParseBool(dry_run_str)
+
+	if strings.TrimSpace(spec_yaml) == "" {
+		err := errors.New("spec is required")
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+	var spec model.ApplySpec
+	if err := yaml.UnmarshalStrict([]byte(spec_yaml), &spec); err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+	tasks, err := applyPlan(ctx.Request.Context(), spec)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	result := model.ApplyResult{DryRun: dry_run, Steps: make([]model.ApplyStep, 0)}
+	failed := make(map[string]bool)
+	for _, task := range tasks {
+		step := task.step
+		key := applyKey(step.Kind, step.Name)
+		switch {
+		case dry_run || task.run == nil:
+			if step.Action == "conflict" {
+				failed[key] = true
+			}
+		default:
+			for _, require := range step.Requires {
+				if failed[require] {
+					step.Result = "skipped: " + require + " failed"
+				}
+			}
+			if step.Result != "" {
+				failed[key] = true
+				break
+			}
+			if _, err := task.run(); err != nil {
+				step.Result = err.Error()
+				failed[key] = true
+			} else {
+				step.Result = "Success"
+			}
+		}
+		result.Steps = append(result.Steps, step)
+	}
+	ctx.IndentedJSON(http.StatusOK, result)
+}
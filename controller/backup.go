@@ -0,0 +1,842 @@
+package controller
+
+import (
+	"Glue-API/httputil"
+	"Glue-API/model"
+	"Glue-API/utils"
+	"Glue-API/utils/backup"
+	"Glue-API/utils/fs"
+	"Glue-API/utils/glue"
+	"Glue-API/utils/iscsi"
+	"Glue-API/utils/mirror"
+	"Glue-API/utils/nfs"
+	"Glue-API/utils/nvmeof"
+	"Glue-API/utils/rgw"
+	"Glue-API/utils/smb"
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"gopkg.in/yaml.v2"
+)
+
+// ceph orch ls --export 결과에서 복원에 필요한 항목만 읽기 위한 구조체입니다.
+type backupServiceSpec struct {
+	ServiceType string `yaml:"service_type"`
+	ServiceId   string `yaml:"service_id"`
+	ServiceName string `yaml:"service_name"`
+	Placement   struct {
+		Hosts []string `yaml:"hosts"`
+	} `yaml:"placement"`
+	Spec struct {
+		Pool string `yaml:"pool"`
+	} `yaml:"spec"`
+}
+
+func (c *Controller) BackupOption(ctx *gin.Context) {
+	SetOptionHeader(ctx)
+	ctx.IndentedJSON(http.StatusOK, nil)
+}
+
+// Glue 대시보드 API 를 호출합니다. iSCSI 타겟과 디스커버리 인증 정보는 대시보드에서만 조회할 수 있습니다.
+func glueDashboardRequest(method string, api string, body []byte) (output []byte, err error) {
+	var request *http.Request
+	if request, err = http.NewRequest(method, GlueUrl()+api, bytes.NewBuffer(body)); err != nil {
+		utils.FancyHandleError(err)
+		return
+	}
+	token, err := GetToken()
+	if err != nil {
+		utils.FancyHandleError(err)
+		return
+	}
+	request.Header.Add("accept", "application/vnd.ceph.api.v1.0+json")
+	request.Header.Add("Authorization", "Bearer "+token)
+	request.Header.Set("Content-Type", "application/json")
+
+	client := &http.Client{
+		Timeout: 60 * time.Second,
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+		},
+	}
+	response, err := client.Do(request)
+	if err != nil {
+		utils.FancyHandleError(err)
+		return
+	}
+	defer response.Body.Close()
+
+	if output, err = io.ReadAll(response.Body); err != nil {
+		utils.FancyHandleError(err)
+		return
+	}
+	if response.StatusCode >= 300 {
+		err = errors.New(strings.ReplaceAll(string(output), "\n", ""))
+		utils.FancyHandleError(err)
+		return
+	}
+	return
+}
+
+// 여러 문서로 구성된 서비스 스펙 YAML 을 문서 단위로 나눕니다.
+func backupSpecDocs(spec string) (docs []string, dat []backupServiceSpec) {
+	for _, doc := range strings.Split(spec, "\n---") {
+		doc = strings.TrimPrefix(strings.TrimSpace(doc), "---")
+		if strings.TrimSpace(doc) == "" {
+			continue
+		}
+		var service backupServiceSpec
+		if err := yaml.Unmarshal([]byte(doc), &service); err != nil {
+			utils.FancyHandleError(err)
+			continue
+		}
+		if service.ServiceName == "" {
+			service.ServiceName = service.ServiceType
+			if service.ServiceId != "" {
+				service.ServiceName += "." + service.ServiceId
+			}
+		}
+		docs = append(docs, doc+"\n")
+		dat = append(dat, service)
+	}
+	return
+}
+
+// 현재 클러스터의 게이트웨이 서비스 설정을 수집합니다.
+// 배포되지 않은 서비스는 오류로 처리하지 않고 매니페스트의 섹션 목록에서 제외합니다.
+func backupCollect(ctx context.Context) (dat model.Backup, err error) {
+	dat.Manifest.Version = backup.Version
+	dat.Manifest.CreatedAt = time.Now().Format(time.RFC3339)
+	if dat.Manifest.Fsid, err = backup.Fsid(); err != nil {
+		return
+	}
+
+	dat.ServiceSpecs = make(map[string]string)
+	for _, service_type := range backup.ServiceTypes {
+		spec, err := backup.ServiceSpec(service_type)
+		if err != nil {
+			return dat, err
+		}
+		if spec != "" {
+			dat.ServiceSpecs[service_type] = spec
+		}
+	}
+	dat.Manifest.Sections = append(dat.Manifest.Sections, "service_specs")
+
+	// GlueFS 볼륨과 서브 볼륨 그룹
+	if fs_list, err := fs.FsList(ctx); err == nil {
+		_, mds_specs := backupSpecDocs(dat.ServiceSpecs["mds"])
+		for _, fs_info := range fs_list {
+			backup_fs := model.BackupFs{Name: fs_info.Name}
+			for _, mds := range mds_specs {
+				if mds.ServiceId == fs_info.Name {
+					backup_fs.Hosts = mds.Placement.Hosts
+				}
+			}
+			groups, _ := fs.SubVolumeGroupLs(ctx, fs_info.Name)
+			for _, group := range groups {
+				info, err := fs.SubVolumeGroupInfo(ctx, fs_info.Name, group.Name)
+				if err != nil {
+					continue
+				}
+				backup_fs.Groups = append(backup_fs.Groups, model.BackupSubVolumeGroup{
+					Name:     group.Name,
+					Size:     info.BytesQuota,
+					DataPool: info.DataPool,
+					Mode:     fmt.Sprintf("%o", info.Mode&0777),
+				})
+			}
+			dat.Fs = append(dat.Fs, backup_fs)
+		}
+		dat.Manifest.Sections = append(dat.Manifest.Sections, "fs")
+	}
+
+	// NFS Export
+	if cluster_ids, err := nfs.NfsClusterLs(); err == nil {
+		dat.NfsExports = make(map[string][]json.RawMessage)
+		for _, cluster_id := range cluster_ids {
+			if exports, err := backup.NfsExports(cluster_id); err == nil {
+				dat.NfsExports[cluster_id] = exports
+			}
+		}
+		dat.Manifest.Sections = append(dat.Manifest.Sections, "nfs_exports")
+	}
+
+	// RGW 사용자, 키 및 쿼터
+	if _, ok := dat.ServiceSpecs["rgw"]; ok {
+		if users, err := rgw.RgwUserList(); err == nil {
+			for _, user := range users {
+				info, err := rgw.RgwUserInfo(user)
+				if err != nil {
+					continue
+				}
+				dat.RgwUsers = append(dat.RgwUsers, info)
+			}
+			dat.Manifest.Sections = append(dat.Manifest.Sections, "rgw_users")
+		}
+	}
+
+	// iSCSI 디스커버리 인증 및 타겟
+	if _, ok := dat.ServiceSpecs["iscsi"]; ok {
+		auth, err_auth := glueDashboardRequest(http.MethodGet, "api/iscsi/discoveryauth", nil)
+		targets, err_target := glueDashboardRequest(http.MethodGet, "api/iscsi/target", nil)
+		if err_auth == nil && err_target == nil {
+			if err := json.Unmarshal(targets, &dat.IscsiTargets); err != nil {
+				utils.FancyHandleError(err)
+			} else {
+				dat.IscsiAuth = auth
+				dat.Manifest.Sections = append(dat.Manifest.Sections, "iscsi")
+			}
+		}
+	}
+
+	// NVMe-oF 서브 시스템과 네임스페이스, 접근을 허용한 호스트. 하나라도 조회에 실패하면 섹션을 넣지 않습니다.
+	if server_gateway_ip, port, err := NvmeOfServerIPandPort(); err == nil && server_gateway_ip != "not" {
+		if subsystems, err := nvmeof.NvmeOfSubSystemList(server_gateway_ip, server_gateway_ip, port, ""); err == nil {
+			var backup_subsystems []model.BackupNvmeOfSubSystem
+			for _, subsystem := range subsystems.Subsystems {
+				backup_subsystem := model.BackupNvmeOfSubSystem{Nqn: subsystem.Nqn, Hosts: make([]string, 0)}
+				var namespaces model.NvmeOfNameSpaceList
+				if namespaces, err = nvmeof.NvmeOfNameSpaceList(server_gateway_ip, server_gateway_ip, port, subsystem.Nqn); err != nil {
+					break
+				}
+				for _, namespace := range namespaces.Namespaces {
+					backup_subsystem.Namespaces = append(backup_subsystem.Namespaces, model.BackupNvmeOfNameSpace{
+						PoolName:  namespace.RbdPoolName,
+						ImageName: namespace.RbdImageName,
+					})
+				}
+				var hosts model.NvmeOfHostList
+				if hosts, err = nvmeof.NvmeOfHostList(server_gateway_ip, server_gateway_ip, port, subsystem.Nqn); err != nil {
+					break
+				}
+				allow_any_host := hosts.AllowAnyHost
+				backup_subsystem.AllowAnyHost = &allow_any_host
+				for _, host := range hosts.Hosts {
+					backup_subsystem.Hosts = append(backup_subsystem.Hosts, host.Nqn)
+				}
+				backup_subsystems = append(backup_subsystems, backup_subsystem)
+			}
+			if err == nil {
+				dat.NvmeOf = backup_subsystems
+				dat.Manifest.Sections = append(dat.Manifest.Sections, "nvmeof")
+			}
+		}
+	}
+
+	// SMB 공유
+	if status, err := smb.StatusList(); err == nil {
+		for _, smb_status := range status {
+			if smb_status.ShareFolder != "" {
+				dat.Smb = append(dat.Smb, smb_status)
+			}
+		}
+		dat.Manifest.Sections = append(dat.Manifest.Sections, "smb")
+	}
+
+	// 미러링 피어 및 스냅샷 스케줄
+	if pools, err := glue.RbdPool(ctx); err == nil {
+		for _, pool_name := range pools {
+			conf, err := backup.MirrorPoolInfo(pool_name)
+			if err != nil || conf.Mode == "" || conf.Mode == "disabled" {
+				continue
+			}
+			schedules, _ := backup.MirrorSchedules(pool_name)
+			dat.MirrorPools = append(dat.MirrorPools, model.BackupMirrorPool{
+				PoolName:  pool_name,
+				Conf:      conf,
+				Schedules: schedules,
+			})
+		}
+		dat.Manifest.Sections = append(dat.Manifest.Sections, "mirror")
+	}
+	return
+}
+
+// backupScrub 은 iSCSI 인증 정보의 비밀번호 항목을 모두 비웁니다.
+func backupScrub(dat interface{}) {
+	switch value := dat.(type) {
+	case map[string]interface{}:
+		for key, item := range value {
+			if key == "password" || key == "mutual_password" {
+				value[key] = ""
+				continue
+			}
+			backupScrub(item)
+		}
+	case []interface{}:
+		for _, item := range value {
+			backupScrub(item)
+		}
+	}
+}
+func backupScrubJson(raw json.RawMessage) json.RawMessage {
+	var dat interface{}
+	if err := json.Unmarshal(raw, &dat); err != nil {
+		return nil
+	}
+	backupScrub(dat)
+	output, _ := json.Marshal(dat)
+	return output
+}
+
+// backupMissingSecret 은 비밀번호가 빠진 CHAP 사용자가 있는지 확인합니다.
+func backupMissingSecret(dat interface{}) bool {
+	switch value := dat.(type) {
+	case map[string]interface{}:
+		for _, prefix := range []string{"", "mutual_"} {
+			user, _ := value[prefix+"user"].(string)
+			password, _ := value[prefix+"password"].(string)
+			if user != "" && password == "" {
+				return true
+			}
+		}
+		for _, item := range value {
+			if backupMissingSecret(item) {
+				return true
+			}
+		}
+	case []interface{}:
+		for _, item := range value {
+			if backupMissingSecret(item) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// backupScrubSecrets 는 번들에서 RGW 키, 미러링 피어 키, iSCSI 인증 비밀번호를 제거합니다.
+func backupScrubSecrets(dat *model.Backup) {
+	for i := range dat.RgwUsers {
+		dat.RgwUsers[i].Keys = nil
+		dat.RgwUsers[i].SwiftKeys = nil
+	}
+	for i := range dat.MirrorPools {
+		for j := range dat.MirrorPools[i].Conf.Peers {
+			dat.MirrorPools[i].Conf.Peers[j].Key = ""
+		}
+	}
+	if len(dat.IscsiAuth) > 0 {
+		dat.IscsiAuth = backupScrubJson(dat.IscsiAuth)
+	}
+	for i := range dat.IscsiTargets {
+		dat.IscsiTargets[i] = backupScrubJson(dat.IscsiTargets[i])
+	}
+	dat.Manifest.Secrets = false
+}
+
+// BackupExport godoc
+//
+//	@Summary		Export of Glue Gateway Configuration Backup
+//	@Description	NFS, SMB, iSCSI, NVMe-oF, RGW, GlueFS, 미러링 설정을 tar.gz 백업 번들로 내려받습니다. RGW 키, 미러링 피어 키, iSCSI 인증 비밀번호는 include_secrets 를 지정한 경우에만 평문으로 포함되므로 번들을 안전하게 보관해야 합니다.
+//	@Tags			Backup
+//	@param			include_secrets	query	bool	false	"Include RGW Keys, Mirror Peer Keys and iSCSI Passwords"	default(false)
+//	@Accept			x-www-form-urlencoded
+//	@Produce		application/gzip
+//	@Success		200	{file}		binary
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/backup [get]
+func (c *Controller) BackupExport(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	include_secrets, _ := strconv.ParseBool(ctx.Request.URL.Query().Get("include_secrets"))
+	dat, err := backupCollect(ctx.Request.Context())
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	dat.Manifest.Secrets = include_secrets
+	if !include_secrets {
+		backupScrubSecrets(&dat)
+	}
+	var buf bytes.Buffer
+	if err = backup.Write(&buf, dat); err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	file_name := "glue-backup-" + time.Now().Format("20060102150405") + ".tar.gz"
+	ctx.Header("Content-Disposition", "attachment; filename="+file_name)
+	ctx.Data(http.StatusOK, "application/gzip", buf.Bytes())
+}
+
+func backupResult(items *[]model.BackupItem, section string, name string, message string) {
+	*items = append(*items, model.BackupItem{Section: section, Name: name, Message: message})
+}
+
+// BackupRestore godoc
+//
+//	@Summary		Restore of Glue Gateway Configuration Backup
+//	@Description	백업 번들을 현재 클러스터에 복원합니다. 이미 존재하는 리소스는 충돌로 보고하고 변경하지 않습니다. 복원되는 모든 SMB 사용자에게는 같은 smb_password 가 설정되므로 복원 후 사용자별로 비밀번호를 변경해야 합니다. 비밀 정보 없이 만든 번들은 RGW 키를 새로 발급하고, 미러링 피어와 CHAP 인증을 사용하는 iSCSI 설정은 충돌로 보고합니다.
+//	@Tags			Backup
+//	@param			backup_file		formData	file	true	"Glue Backup Bundle(tar.gz)"
+//	@param			dry_run			formData	boolean	false	"Only Report What Would Be Restored" default(false)
+//	@param			smb_password	formData	string	false	"Password For All Restored SMB Users"
+//	@Accept			multipart/form-data
+//	@Produce		json
+//	@Success		200	{object}	model.BackupRestoreResult
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/backup/restore [post]
+func (c *Controller) BackupRestore(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	dry_run_str, _ := ctx.GetPostForm("dry_run")
+	dry_run, _ := strconv.ParseBool(dry_run_str)
+	smb_password, _ := ctx.GetPostForm("smb_password")
+	file, err := ctx.FormFile("backup_file")
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+	reader, err := file.Open()
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	defer reader.Close()
+	dat, err := backup.Read(reader)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	result := model.BackupRestoreResult{
+		Version:   dat.Manifest.Version,
+		Fsid:      dat.Manifest.Fsid,
+		DryRun:    dry_run,
+		Applied:   make([]model.BackupItem, 0),
+		Conflicts: make([]model.BackupItem, 0),
+		Errors:    make([]model.BackupItem, 0),
+	}
+	applied := func(section string, name string, err error) {
+		if err != nil {
+			backupResult(&result.Errors, section, name, err.Error())
+		} else if dry_run {
+			backupResult(&result.Applied, section, name, "planned")
+		} else {
+			backupResult(&result.Applied, section, name, "restored")
+		}
+	}
+	if fsid, err := backup.Fsid(); err == nil && fsid != dat.Manifest.Fsid {
+		backupResult(&result.Conflicts, "manifest", fsid, "backup was taken from another cluster("+dat.Manifest.Fsid+")")
+	}
+
+	// 서비스 간 의존성 순서대로 복원합니다.
+	// GlueFS -> 서비스 스펙 -> NFS Export -> RGW 사용자 -> iSCSI -> NVMe-oF -> SMB -> 미러링
+	fs_list, _ := fs.FsList(ctx.Request.Context())
+	for _, backup_fs := range dat.Fs {
+		exist := false
+		for _, fs_info := range fs_list {
+			if fs_info.Name == backup_fs.Name {
+				exist = true
+			}
+		}
+		if exist {
+			backupResult(&result.Conflicts, "fs", backup_fs.Name, "file system already exists")
+		} else if len(backup_fs.Hosts) == 0 {
+			backupResult(&result.Errors, "fs", backup_fs.Name, "mds placement hosts are not found in backup")
+			continue
+		} else {
+			var err error
+			if !dry_run {
+				_, err = fs.FsCreate(ctx.Request.Context(), backup_fs.Name, strings.Join(backup_fs.Hosts, ","))
+			}
+			applied("fs", backup_fs.Name, err)
+			if err != nil {
+				continue
+			}
+		}
+		groups, _ := fs.SubVolumeGroupLs(ctx.Request.Context(), backup_fs.Name)
+		for _, group := range backup_fs.Groups {
+			name := backup_fs.Name + "/" + group.Name
+			group_exist := false
+			for i := 0; i < len(groups); i++ {
+				if groups[i].Name == group.Name {
+					group_exist = true
+				}
+			}
+			if group_exist {
+				backupResult(&result.Conflicts, "subvolume_group", name, "subvolume group already exists")
+				continue
+			}
+			var err error
+			if !dry_run {
+				_, err = fs.SubVolumeGroupCreate(ctx.Request.Context(), backup_fs.Name, group.Name, strconv.FormatInt(group.Size, 10), group.DataPool, group.Mode)
+			}
+			applied("subvolume_group", name, err)
+		}
+	}
+
+	service_names, err := backup.ServiceNames()
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	for _, service_type := range backup.ServiceTypes {
+		// mds 는 GlueFS 생성 시 함께 배포됩니다.
+		if service_type == "mds" {
+			continue
+		}
+		docs, specs := backupSpecDocs(dat.ServiceSpecs[service_type])
+		for i, spec := range specs {
+			exist := false
+			for _, service_name := range service_names {
+				if service_name == spec.ServiceName {
+					exist = true
+				}
+			}
+			if exist {
+				backupResult(&result.Conflicts, "service_specs", spec.ServiceName, "service already exists")
+				continue
+			}
+			var err error
+			if !dry_run {
+				var yaml_file *os.File
+				if yaml_file, err = os.CreateTemp("", "glue-restore-*.yaml"); err != nil {
+					utils.FancyHandleError(err)
+				} else {
+					_, err = yaml_file.WriteString(docs[i])
+					yaml_file.Close()
+					if err == nil && service_type == "nvmeof" {
+						_, err = nvmeof.NvmeOfServiceCreate(yaml_file.Name(), spec.Spec.Pool)
+					} else if err == nil {
+						_, err = backup.ServiceApply(yaml_file.Name())
+					}
+					os.Remove(yaml_file.Name())
+				}
+			}
+			applied("service_specs", spec.ServiceName, err)
+		}
+	}
+
+	for cluster_id, exports := range dat.NfsExports {
+		current, err := nfs.NfsExportDetailed(cluster_id)
+		if err != nil {
+			backupResult(&result.Errors, "nfs_exports", cluster_id, err.Error())
+			continue
+		}
+		for _, export := range exports {
+			var export_info struct {
+				Pseudo string `json:"pseudo"`
+			}
+			if err := json.Unmarshal(export, &export_info); err != nil {
+				backupResult(&result.Errors, "nfs_exports", cluster_id, err.Error())
+				continue
+			}
+			name := cluster_id + ":" + export_info.Pseudo
+			exist := false
+			for i := 0; i < len(current); i++ {
+				if current[i].Pseudo == export_info.Pseudo {
+					exist = true
+				}
+			}
+			if exist {
+				backupResult(&result.Conflicts, "nfs_exports", name, "export pseudo path already exists")
+				continue
+			}
+			var err error
+			if !dry_run {
+				var json_file *os.File
+				if json_file, err = os.CreateTemp("", "glue-restore-*.json"); err != nil {
+					utils.FancyHandleError(err)
+				} else {
+					_, err = json_file.Write(export)
+					json_file.Close()
+					if err == nil {
+						_, err = nfs.NfsExportCreateOrUpdate(cluster_id, json_file.Name())
+					}
+					os.Remove(json_file.Name())
+				}
+			}
+			applied("nfs_exports", name, err)
+		}
+	}
+
+	if len(dat.RgwUsers) > 0 {
+		users, _ := rgw.RgwUserList()
+		for _, user := range dat.RgwUsers {
+			exist := false
+			for i := 0; i < len(users); i++ {
+				if users[i] == user.UserID {
+					exist = true
+				}
+			}
+			if exist {
+				backupResult(&result.Conflicts, "rgw_users", user.UserID, "user already exists")
+				continue
+			}
+			if len(user.Keys) == 0 {
+				backupResult(&result.Conflicts, "rgw_users", user.UserID, "keys are not included in backup, new keys are issued")
+			}
+			var err error
+			if !dry_run {
+				_, err = rgw.RgwUserCreate(user.UserID, user.DisplayName, user.Email)
+				for i := 0; err == nil && i < len(user.Keys); i++ {
+					_, err = rgw.RgwUserUpdate(user.UserID, "", "", "s3", user.Keys[i].AccessKey, user.Keys[i].SecretKey)
+				}
+				if err == nil {
+					state := "disable"
+					if user.UserQuota.Enabled {
+						state = "enable"
+					}
+					_, err = rgw.RgwQuota(user.UserID, "user", strconv.Itoa(user.UserQuota.MaxObjects), strconv.Itoa(user.UserQuota.MaxSize), state)
+				}
+				if err == nil {
+					state := "disable"
+					if user.BucketQuota.Enabled {
+						state = "enable"
+					}
+					_, err = rgw.RgwQuota(user.UserID, "bucket", strconv.Itoa(user.BucketQuota.MaxObjects), strconv.Itoa(user.BucketQuota.MaxSize), state)
+				}
+			}
+			applied("rgw_users", user.UserID, err)
+		}
+	}
+
+	if len(dat.IscsiTargets) > 0 || len(dat.IscsiAuth) > 0 {
+		if iscsi_service, err := iscsi.IscsiService(); err != nil || len(iscsi_service) == 0 {
+			backupResult(&result.Errors, "iscsi", "iscsi", "iscsi service is not deployed")
+		} else {
+			var auth model.Auth
+			var current_auth model.Auth
+			current_auth_data, err := glueDashboardRequest(http.MethodGet, "api/iscsi/discoveryauth", nil)
+			if err == nil && len(dat.IscsiAuth) > 0 {
+				err = json.Unmarshal(dat.IscsiAuth, &auth)
+			}
+			if err == nil {
+				err = json.Unmarshal(current_auth_data, &current_auth)
+			}
+			if err != nil {
+				backupResult(&result.Errors, "iscsi", "discoveryauth", err.Error())
+			} else if (auth.User != "" && auth.Password == "") || (auth.Mutual_User != "" && auth.Mutual_Password == "") {
+				backupResult(&result.Conflicts, "iscsi", "discoveryauth", "discovery auth password is not included in backup")
+			} else if auth.User != "" {
+				if current_auth.User != "" && current_auth != auth {
+					backupResult(&result.Conflicts, "iscsi", "discoveryauth", "discovery auth is already configured")
+				} else if current_auth != auth {
+					var err error
+					if !dry_run {
+						auth_data, _ := json.Marshal(auth)
+						_, err = glueDashboardRequest(http.MethodPut, "api/iscsi/discoveryauth", auth_data)
+					}
+					applied("iscsi", "discoveryauth", err)
+				}
+			}
+			var current_targets []struct {
+				TargetIqn string `json:"target_iqn"`
+			}
+			current_target_data, err := glueDashboardRequest(http.MethodGet, "api/iscsi/target", nil)
+			if err == nil {
+				err = json.Unmarshal(current_target_data, &current_targets)
+			}
+			if err != nil && len(dat.IscsiTargets) > 0 {
+				backupResult(&result.Errors, "iscsi", "target", err.Error())
+				dat.IscsiTargets = nil
+			}
+			for _, target := range dat.IscsiTargets {
+				var target_info struct {
+					TargetIqn string `json:"target_iqn"`
+				}
+				var target_auth interface{}
+				if err := json.Unmarshal(target, &target_info); err != nil {
+					backupResult(&result.Errors, "iscsi", "target", err.Error())
+					continue
+				}
+				if err := json.Unmarshal(target, &target_auth); err != nil {
+					backupResult(&result.Errors, "iscsi", target_info.TargetIqn, err.Error())
+					continue
+				}
+				exist := false
+				for i := 0; i < len(current_targets); i++ {
+					if current_targets[i].TargetIqn == target_info.TargetIqn {
+						exist = true
+					}
+				}
+				if exist {
+					backupResult(&result.Conflicts, "iscsi", target_info.TargetIqn, "target already exists")
+					continue
+				}
+				if backupMissingSecret(target_auth) {
+					backupResult(&result.Conflicts, "iscsi", target_info.TargetIqn, "target auth password is not included in backup")
+					continue
+				}
+				var err error
+				if !dry_run {
+					_, err = glueDashboardRequest(http.MethodPost, "api/iscsi/target", target)
+				}
+				applied("iscsi", target_info.TargetIqn, err)
+			}
+		}
+	}
+
+	if len(dat.NvmeOf) > 0 {
+		server_gateway_ip, port, err := NvmeOfServerIPandPort()
+		if err != nil || server_gateway_ip == "not" {
+			backupResult(&result.Errors, "nvmeof", "nvmeof", "nvmeof gateway is not deployed")
+		} else if current, err := nvmeof.NvmeOfSubSystemList(server_gateway_ip, server_gateway_ip, port, ""); err != nil {
+			backupResult(&result.Errors, "nvmeof", "nvmeof", err.Error())
+		} else if gat_name, err := nvmeof.NvmeOfGatewayName(); err != nil {
+			backupResult(&result.Errors, "nvmeof", "nvmeof", err.Error())
+		} else {
+			for _, subsystem := range dat.NvmeOf {
+				exist := false
+				for i := 0; i < len(current.Subsystems); i++ {
+					if current.Subsystems[i].Nqn == subsystem.Nqn {
+						exist = true
+					}
+				}
+				if exist {
+					backupResult(&result.Conflicts, "nvmeof", subsystem.Nqn, "subsystem already exists")
+					continue
+				}
+				var err error
+				if !dry_run {
+					_, err = nvmeof.NvmeOfSubSystemCreate(server_gateway_ip, server_gateway_ip, port, subsystem.Nqn)
+					for i := 0; err == nil && i < len(gat_name); i++ {
+						var gateway_ip string
+						if gateway_ip, err = nvmeof.ServerGatewayIp(gat_name[i].Hostname); err == nil {
+							_, err = nvmeof.NvmeOfDefineGateway(server_gateway_ip, server_gateway_ip, port, subsystem.Nqn, "client."+gat_name[i].Daemon_name, gateway_ip)
+						}
+					}
+					// 백업할 때 허용되어 있던 호스트만 다시 허용합니다.
+					if err == nil && (subsystem.AllowAnyHost == nil || *subsystem.AllowAnyHost) {
+						_, err = nvmeof.NvmeOfHostAdd(server_gateway_ip, server_gateway_ip, port, subsystem.Nqn)
+					}
+					for i := 0; err == nil && i < len(subsystem.Hosts); i++ {
+						_, err = nvmeof.NvmeOfHostAllow(server_gateway_ip, server_gateway_ip, port, subsystem.Nqn, subsystem.Hosts[i])
+					}
+					for i := 0; err == nil && i < len(subsystem.Namespaces); i++ {
+						_, err = nvmeof.NvmeOfNameSpaceCreate(server_gateway_ip, server_gateway_ip, port, subsystem.Nqn, subsystem.Namespaces[i].PoolName, subsystem.Namespaces[i].ImageName)
+					}
+				}
+				applied("nvmeof", subsystem.Nqn, err)
+			}
+		}
+	}
+
+	if len(dat.Smb) > 0 {
+		settings, _ := utils.ReadConfFile()
+		current, err := smb.StatusList()
+		if err != nil {
+			backupResult(&result.Errors, "smb", "smb", err.Error())
+			dat.Smb = nil
+		}
+		for _, share := range dat.Smb {
+			name := share.IpAddress + ":" + share.ShareFolder
+			if settings.Samba_Security_Type != "" && settings.Samba_Security_Type != "normal" {
+				backupResult(&result.Conflicts, "smb", name, "active directory smb must be restored manually")
+				continue
+			}
+			if smb_password == "" || len(share.Users) == 0 {
+				backupResult(&result.Conflicts, "smb", name, "smb_password is required to restore smb users")
+				continue
+			}
+			host_found := false
+			exist := false
+			for i := 0; i < len(current); i++ {
+				if current[i].IpAddress == share.IpAddress {
+					host_found = true
+					exist = current[i].ShareFolder != ""
+				}
+			}
+			if !host_found {
+				backupResult(&result.Errors, "smb", name, "smb host is not found")
+				continue
+			}
+			if exist {
+				backupResult(&result.Conflicts, "smb", name, "smb share already exists")
+				continue
+			}
+			var err error
+			if !dry_run {
+				_, err = smb.SmbCreate(share.IpAddress, "normal", "true", share.Users[0], smb_password, share.ShareFolder, share.SharePath, share.FsName, share.VolumePath, "", "")
+				for i := 1; err == nil && i < len(share.Users); i++ {
+					_, err = smb.SmbUserCreate(share.IpAddress, share.Users[i], smb_password)
+				}
+			}
+			applied("smb", name, err)
+		}
+	}
+
+	for _, pool := range dat.MirrorPools {
+		conf, err := backup.MirrorPoolInfo(pool.PoolName)
+		if err != nil {
+			backupResult(&result.Errors, "mirror", pool.PoolName, err.Error())
+			continue
+		}
+		if conf.Mode != "" && conf.Mode != "disabled" && len(conf.Peers) > 0 {
+			backupResult(&result.Conflicts, "mirror", pool.PoolName, "mirroring is already configured")
+		} else {
+			if !dry_run {
+				if conf.Mode == "" || conf.Mode == "disabled" {
+					_, err = backup.MirrorPoolEnable(pool.PoolName, pool.Conf.Mode, pool.Conf.SiteName)
+				}
+				for i := 0; err == nil && i < len(pool.Conf.Peers); i++ {
+					peer := pool.Conf.Peers[i]
+					if peer.Key == "" {
+						continue
+					}
+					_, err = backup.MirrorPeerAdd(pool.PoolName, peer.ClientName, peer.SiteName, peer.MonHost, peer.Key)
+				}
+			}
+			for _, peer := range pool.Conf.Peers {
+				if peer.Key == "" {
+					backupResult(&result.Conflicts, "mirror", pool.PoolName+"/"+peer.SiteName, "peer key is not included in backup, add the peer manually")
+				}
+			}
+			applied("mirror", pool.PoolName, err)
+			if err != nil {
+				continue
+			}
+		}
+		current_schedules, _ := backup.MirrorSchedules(pool.PoolName)
+		for _, schedule := range pool.Schedules {
+			for _, item := range schedule.Items {
+				name := schedule.Pool + "/" + schedule.Image + "@" + item.Interval
+				exist := false
+				for _, current_schedule := range current_schedules {
+					for _, current_item := range current_schedule.Items {
+						if current_schedule.Image == schedule.Image && current_item.Interval == item.Interval {
+							exist = true
+						}
+					}
+				}
+				if exist {
+					backupResult(&result.Conflicts, "mirror_schedule", name, "schedule already exists")
+					continue
+				}
+				if schedule.Image == "" {
+					backupResult(&result.Conflicts, "mirror_schedule", name, "pool level schedule must be restored manually")
+					continue
+				}
+				var err error
+				if !dry_run {
+					_, err = mirror.ImageConfig(pool.PoolName, schedule.Image, item.Interval, item.StartTime)
+				}
+				applied("mirror_schedule", name, err)
+			}
+		}
+	}
+	ctx.IndentedJSON(http.StatusOK, result)
+}
@@ -0,0 +1,178 @@
+package controller
+
+import (
+	"Glue-API/httputil"
+	"Glue-API/model"
+	"Glue-API/utils"
+	"Glue-API/utils/cluster"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+func (c *Controller) ClusterOption(ctx *gin.Context) {
+	SetOptionHeader(ctx)
+	ctx.IndentedJSON(http.StatusOK, nil)
+}
+
+// 원격 클러스터를 대상으로 할 수 있는 API 입니다. 나머지 API 는 로컬 호스트의 서비스와 파일을 다루므로 로컬 클러스터만 지원합니다.
+var remote_paths = []string{"/api/v1/pool", "/api/v1/image", "/api/v1/gluefs", "/api/v1/mirror"}
+
+// ClusterSelect 는 X-Glue-Cluster 헤더 또는 cluster 쿼리로 요청을 처리할 클러스터를 선택하여 요청 context 에 저장합니다.
+func ClusterSelect() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		cluster_name := ctx.GetHeader("X-Glue-Cluster")
+		if cluster_name == "" {
+			cluster_name = ctx.Request.URL.Query().Get("cluster")
+		}
+		if cluster_name == "" || cluster_name == "local" {
+			ctx.Next()
+			return
+		}
+		dat, err := cluster.Get(cluster_name)
+		if err != nil {
+			utils.FancyHandleError(err)
+			ctx.Header("Access-Control-Allow-Origin", "*")
+			httputil.NewError(ctx, http.StatusNotFound, err)
+			ctx.Abort()
+			return
+		}
+		supported := false
+		for _, path := range remote_paths {
+			if ctx.FullPath() == path || strings.HasPrefix(ctx.FullPath(), path+"/") {
+				supported = true
+				break
+			}
+		}
+		if !supported {
+			err = errors.New("this endpoint supports only the local cluster")
+			utils.FancyHandleError(err)
+			ctx.Header("Access-Control-Allow-Origin", "*")
+			httputil.NewError(ctx, http.StatusBadRequest, err)
+			ctx.Abort()
+			return
+		}
+		ctx.Request = ctx.Request.WithContext(cluster.WithCluster(ctx.Request.Context(), dat))
+		ctx.Next()
+	}
+}
+
+// localCluster 는 로컬 호스트에서만 처리할 수 있는 API 가 원격 클러스터를 대상으로 하면 400 을 응답하고 false 를 반환합니다.
+func localCluster(ctx *gin.Context) bool {
+	if _, remote := cluster.FromContext(ctx.Request.Context()); remote {
+		err := errors.New("this endpoint supports only the local cluster")
+		utils.FancyHandleError(err)
+		ctx.Header("Access-Control-Allow-Origin", "*")
+		httputil.NewError(ctx, http.StatusBadRequest, err)
+		return false
+	}
+	return true
+}
+
+// ClusterList godoc
+//
+//	@Summary		Show List of Managed Clusters
+//	@Description	Glue API 에 등록된 클러스터 목록을 보여줍니다.
+//	@Tags			Cluster
+//	@param			cluster_name	query	string	false	"Cluster Name"
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{object}	model.ClusterList
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/cluster [get]
+func (c *Controller) ClusterList(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	cluster_name := ctx.Request.URL.Query().Get("cluster_name")
+	if cluster_name != "" {
+		dat, err := cluster.Get(cluster_name)
+		if err != nil {
+			utils.FancyHandleError(err)
+			httputil.NewError(ctx, http.StatusNotFound, err)
+			return
+		}
+		ctx.IndentedJSON(http.StatusOK, dat)
+		return
+	}
+	dat, err := cluster.List()
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, dat)
+}
+
+// ClusterCreate godoc
+//
+//	@Summary		Register of Managed Cluster
+//	@Description	Glue API 에서 관리할 클러스터를 등록합니다. ceph.conf/keyring 경로 또는 SSH 접속 정보가 필요합니다.
+//	@Tags			Cluster
+//	@param			cluster_name	formData	string	true	"Cluster Name"
+//	@param			conf_path	formData	string	false	"ceph.conf Path"
+//	@param			keyring_path	formData	string	false	"Keyring Path"
+//	@param			client_name	formData	string	false	"Ceph Client Name" default(client.admin)
+//	@param			ssh_host	formData	string	false	"SSH Target Host"
+//	@param			ssh_key_path	formData	string	false	"SSH Private Key Path" default(/root/.ssh/id_rsa)
+//	@param			dashboard_url	formData	string	false	"Glue Dashboard URL"
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{string}	string	"Success"
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/cluster [post]
+func (c *Controller) ClusterCreate(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	var dat model.Cluster
+	dat.Name, _ = ctx.GetPostForm("cluster_name")
+	dat.ConfPath, _ = ctx.GetPostForm("conf_path")
+	dat.KeyringPath, _ = ctx.GetPostForm("keyring_path")
+	dat.ClientName, _ = ctx.GetPostForm("client_name")
+	dat.SshHost, _ = ctx.GetPostForm("ssh_host")
+	dat.SshKeyPath, _ = ctx.GetPostForm("ssh_key_path")
+	dat.DashboardUrl, _ = ctx.GetPostForm("dashboard_url")
+	if dat.SshHost != "" && dat.SshKeyPath == "" {
+		settings, _ := utils.ReadConfFile()
+		dat.SshKeyPath = settings.RemoteRootRsaIdPath
+	}
+
+	output, err := cluster.Add(dat)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, output)
+}
+
+// ClusterDelete godoc
+//
+//	@Summary		Unregister of Managed Cluster
+//	@Description	Glue API 에 등록된 클러스터를 삭제합니다.
+//	@Tags			Cluster
+//	@param			cluster_name	path	string	true	"Cluster Name"
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{string}	string	"Success"
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/cluster/{cluster_name} [delete]
+func (c *Controller) ClusterDelete(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	cluster_name := ctx.Param("cluster_name")
+	output, err := cluster.Delete(cluster_name)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, output)
+}
@@ -0,0 +1,281 @@
+package controller
+
+import (
+	"Glue-API/httputil"
+	"Glue-API/model"
+	"Glue-API/utils"
+	"Glue-API/utils/config"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+func (c *Controller) ConfigOption(ctx *gin.Context) {
+	SetOptionHeader(ctx)
+	ctx.IndentedJSON(http.StatusOK, nil)
+}
+
+// ConfigDump godoc
+//
+//	@Summary		Show Central Configuration of Glue
+//	@Description	ceph config dump 로 중앙 설정 목록을 보여줍니다. diff 를 지정하면 기본값과 다른 설정만 기본값과 함께 보여줍니다.
+//	@Tags			Config
+//	@param			section	query	string	false	"Config Section(global, mon, osd, osd.0, client.rgw ...)"
+//	@param			diff	query	bool	false	"Show Only Values Different From Defaults"
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{object}	model.ConfigDump
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/config [get]
+func (c *Controller) ConfigDump(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	section := ctx.Request.URL.Query().Get("section")
+	diff, _ := strconv.ParseBool(ctx.Request.URL.Query().Get("diff"))
+	options, err := config.Dump(ctx.Request.Context())
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	dat := make(model.ConfigDump, 0)
+	for _, option := range options {
+		if section != "" && option.Section != section {
+			continue
+		}
+		if diff {
+			help, err := config.Help(option.Name)
+			if err != nil {
+				utils.FancyHandleError(err)
+				httputil.NewError(ctx, http.StatusInternalServerError, err)
+				return
+			}
+			if config.Equal(option.Value, help.Default) {
+				continue
+			}
+			option.Default = config.String(help.Default)
+		}
+		dat = append(dat, option)
+	}
+	ctx.IndentedJSON(http.StatusOK, dat)
+}
+
+// ConfigGet godoc
+//
+//	@Summary		Show Value of Configuration Option
+//	@Description	섹션 또는 데몬에 적용되는 설정 값과 타입, 범위, 기본값 등 설정 설명을 보여줍니다.
+//	@Tags			Config
+//	@param			who		query	string	true	"Config Section or Daemon(global, mon, osd, osd.0 ...)"
+//	@param			name	query	string	true	"Config Option Name"
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{object}	model.ConfigValue
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/config/option [get]
+func (c *Controller) ConfigGet(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	who := ctx.Request.URL.Query().Get("who")
+	name := ctx.Request.URL.Query().Get("name")
+	if who == "" || name == "" {
+		err := errors.New("who and name are required")
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+	help, err := config.Help(name)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusNotFound, err)
+		return
+	}
+	value, err := config.Get(ctx.Request.Context(), who, name)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	dat := model.ConfigValue{Who: who, Name: name, Value: value, Help: help}
+	ctx.IndentedJSON(http.StatusOK, dat)
+}
+
+// ConfigSet godoc
+//
+//	@Summary		Set Value of Configuration Option
+//	@Description	섹션 또는 데몬의 설정 값을 변경합니다. ceph config help 의 타입, 범위, 허용 값으로 값을 검증합니다.
+//	@Tags			Config
+//	@param			who		formData	string	true	"Config Section or Daemon(global, mon, osd, osd.0 ...)"
+//	@param			name	formData	string	true	"Config Option Name"
+//	@param			value	formData	string	true	"Config Option Value"
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{string}	string	"Success"
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/config/option [put]
+func (c *Controller) ConfigSet(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	who, _ := ctx.GetPostForm("who")
+	name, _ := ctx.GetPostForm("name")
+	value, ok := ctx.GetPostForm("value")
+	if who == "" || name == "" || !ok {
+		err := errors.New("who, name and value are required")
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+	help, err := config.Help(name)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusNotFound, err)
+		return
+	}
+	if err = config.Validate(help, value); err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+	output, err := config.Set(ctx.Request.Context(), who, name, value)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, output)
+}
+
+// ConfigRemove godoc
+//
+//	@Summary		Reset Configuration Option To Default
+//	@Description	중앙 설정에서 섹션 또는 데몬의 설정을 지워 기본값으로 되돌립니다.
+//	@Tags			Config
+//	@param			who		query	string	true	"Config Section or Daemon(global, mon, osd, osd.0 ...)"
+//	@param			name	query	string	true	"Config Option Name"
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{string}	string	"Success"
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/config/option [delete]
+func (c *Controller) ConfigRemove(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	who := ctx.Request.URL.Query().Get("who")
+	name := ctx.Request.URL.Query().Get("name")
+	if who == "" || name == "" {
+		err := errors.New("who and name are required")
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+	output, err := config.Remove(ctx.Request.Context(), who, name)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, output)
+}
+
+// ConfigHistory godoc
+//
+//	@Summary		Show Change History of Configuration
+//	@Description	모니터에 기록된 중앙 설정 변경 이력을 최신 순으로 보여줍니다.
+//	@Tags			Config
+//	@param			num	query	int	false	"Number of History Entries"	default(50)
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{object}	model.ConfigLog
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/config/history [get]
+func (c *Controller) ConfigHistory(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	num, err := strconv.Atoi(ctx.DefaultQuery("num", "50"))
+	if err != nil || num <= 0 {
+		err = errors.New("num must be a positive number")
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+	dat, err := config.Log(num)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, dat)
+}
+
+// ConfigRevert godoc
+//
+//	@Summary		Revert Configuration Option Change
+//	@Description	설정 변경 이력의 한 버전에서 바뀐 설정을 이전 값으로 되돌립니다. 이전 값이 없던 설정은 중앙 설정에서 지웁니다.
+//	@Tags			Config
+//	@param			version	formData	int		true	"Config Version From History"
+//	@param			name	formData	string	true	"Changed Option(who/name)"
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{string}	string	"Success"
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/config/revert [post]
+func (c *Controller) ConfigRevert(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	version, err := strconv.Atoi(ctx.PostForm("version"))
+	name := ctx.PostForm("name")
+	// 마스크가 있는 섹션(osd/class:ssd)도 있으므로 마지막 / 를 기준으로 나눕니다.
+	separator := strings.LastIndex(name, "/")
+	if err != nil || separator <= 0 {
+		err = errors.New("version and name(who/name) are required")
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+	history, err := config.Log(1000)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	for _, entry := range history {
+		if entry.Version != version {
+			continue
+		}
+		for _, change := range entry.Changes {
+			if change.Name != name {
+				continue
+			}
+			var output string
+			if change.PreviousValue == "" {
+				output, err = config.Remove(ctx.Request.Context(), name[:separator], name[separator+1:])
+			} else {
+				output, err = config.Set(ctx.Request.Context(), name[:separator], name[separator+1:], change.PreviousValue)
+			}
+			if err != nil {
+				utils.FancyHandleError(err)
+				httputil.NewError(ctx, http.StatusInternalServerError, err)
+				return
+			}
+			ctx.IndentedJSON(http.StatusOK, output)
+			return
+		}
+	}
+	err = errors.New("change of " + name + " is not found in config version " + strconv.Itoa(version))
+	utils.FancyHandleError(err)
+	httputil.NewError(ctx, http.StatusNotFound, err)
+}
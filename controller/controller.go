@@ -66,6 +66,15 @@ func SetOptionHeader(ctx *gin.Context) {
 	ctx.Header("Access-Control-Allow-Headers", "*")
 	ctx.Header("Access-Control-Max-Age", "3600")
 }
+
+// Recovery 는 gin.CustomRecovery 의 처리 함수입니다. http.ErrAbortHandler 는 응답을 보내는 도중 중단한다는 뜻이므로
+// 다시 패닉을 일으켜 net/http 가 연결을 끊게 합니다. 그렇지 않으면 잘린 응답이 정상적으로 끝난 것처럼 보입니다.
+func Recovery(ctx *gin.Context, err any) {
+	if err == http.ErrAbortHandler {
+		panic(err)
+	}
+	ctx.AbortWithStatus(http.StatusInternalServerError)
+}
 func GlueUrl() (output string) {
 	dat, err := glue.GlueUrl()
 	if err != nil {
@@ -0,0 +1,119 @@
+package controller
+
+import (
+	"Glue-API/httputil"
+	_ "Glue-API/model" // swag 이 godoc 의 model 타입을 찾을 수 있도록 가져옵니다.
+	"Glue-API/utils"
+	"Glue-API/utils/crash"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+func (c *Controller) CrashOption(ctx *gin.Context) {
+	SetOptionHeader(ctx)
+	ctx.IndentedJSON(http.StatusOK, nil)
+}
+
+// CrashList godoc
+//
+//	@Summary		Show List of Glue Daemon Crash Reports
+//	@Description	crash 모듈에 수집된 데몬 장애 보고서 목록을 보여줍니다. 기본으로 보관되지 않은 보고서만 보여줍니다.
+//	@Tags			Crash
+//	@param			archived	query	bool	false	"Include Archived Crash Reports"	default(false)
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{object}	model.CrashList
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/crash [get]
+func (c *Controller) CrashList(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	archived, _ := strconv.ParseBool(ctx.Request.URL.Query().Get("archived"))
+	dat, err := crash.List(archived)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, dat)
+}
+
+// CrashInfo godoc
+//
+//	@Summary		Show Detail of Glue Daemon Crash Report
+//	@Description	데몬 장애 보고서의 assert 정보와 backtrace 를 보여줍니다.
+//	@Tags			Crash
+//	@param			crash_id	path	string	true	"Crash ID"
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{object}	model.Crash
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/crash/{crash_id} [get]
+func (c *Controller) CrashInfo(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	crash_id := ctx.Param("crash_id")
+	dat, err := crash.Info(crash_id)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusNotFound, err)
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, dat)
+}
+
+// CrashArchive godoc
+//
+//	@Summary		Archive of Glue Daemon Crash Report
+//	@Description	데몬 장애 보고서를 확인 처리하여 RECENT_CRASH 경고에서 제외합니다. 보고서는 삭제되지 않습니다.
+//	@Tags			Crash
+//	@param			crash_id	path	string	true	"Crash ID"
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{string}	string	"Success"
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/crash/{crash_id} [put]
+func (c *Controller) CrashArchive(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	crash_id := ctx.Param("crash_id")
+	output, err := crash.Archive(crash_id)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusNotFound, err)
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, output)
+}
+
+// CrashArchiveAll godoc
+//
+//	@Summary		Archive of All Glue Daemon Crash Reports
+//	@Description	모든 데몬 장애 보고서를 확인 처리하여 RECENT_CRASH 경고를 해소합니다.
+//	@Tags			Crash
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{string}	string	"Success"
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/crash [put]
+func (c *Controller) CrashArchiveAll(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	output, err := crash.Archive("")
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, output)
+}
@@ -0,0 +1,253 @@
+package controller
+
+import (
+	"Glue-API/httputil"
+	"Glue-API/model"
+	"Glue-API/utils"
+	"Glue-API/utils/glue"
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+func (c *Controller) CrushOption(ctx *gin.Context) {
+	SetOptionHeader(ctx)
+	ctx.IndentedJSON(http.StatusOK, nil)
+}
+
+// crushNode 는 ceph osd tree 의 하위 노드 ID 를 따라 트리를 만듭니다.
+func crushNode(id int, nodes map[int]model.OsdTreeNode) (dat model.CrushNode) {
+	node := nodes[id]
+	dat = model.CrushNode{
+		Id:          node.Id,
+		Name:        node.Name,
+		Type:        node.Type,
+		DeviceClass: node.DeviceClass,
+		CrushWeight: node.CrushWeight,
+		Status:      node.Status,
+		Reweight:    node.Reweight,
+	}
+	for _, child := range node.Children {
+		if _, ok := nodes[child]; ok {
+			dat.Children = append(dat.Children, crushNode(child, nodes))
+		}
+	}
+	return
+}
+
+// crushRules 는 규칙의 단계에서 root, 장애 도메인, 디바이스 클래스를 읽고 규칙을 사용하는 풀을 채웁니다.
+func crushRules(ctx context.Context) (dat []model.CrushRule, err error) {
+	rules, err := glue.CrushRuleDump(ctx)
+	if err != nil {
+		return
+	}
+	pools, err := glue.PoolDetail(ctx)
+	if err != nil {
+		return
+	}
+	dat = make([]model.CrushRule, 0)
+	for _, rule := range rules {
+		item := model.CrushRule{RuleId: rule.RuleId, RuleName: rule.RuleName, Type: "replicated", Pools: make([]string, 0)}
+		// type 3 은 EC 규칙입니다.
+		if rule.Type == 3 {
+			item.Type = "erasure"
+		}
+		for _, step := range rule.Steps {
+			switch step.Op {
+			case "take":
+				// 디바이스 클래스를 지정한 규칙은 root~class 형식의 섀도 트리를 사용합니다.
+				item.Root, item.DeviceClass, _ = strings.Cut(step.ItemName, "~")
+			case "choose_firstn", "chooseleaf_firstn", "choose_indep", "chooseleaf_indep":
+				item.FailureDomain = step.Type
+			}
+		}
+		for _, pool := range pools {
+			if pool.CrushRule == rule.RuleId {
+				item.Pools = append(item.Pools, pool.PoolName)
+			}
+		}
+		dat = append(dat, item)
+	}
+	return
+}
+
+// CrushTree godoc
+//
+//	@Summary		Show CRUSH Tree of Glue
+//	@Description	Glue 의 CRUSH 트리(root, 호스트, OSD)와 디바이스 클래스 목록을 보여줍니다.
+//	@Tags			Crush
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{object}	model.CrushTree
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/crush [get]
+func (c *Controller) CrushTree(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	tree, err := glue.OsdTree()
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	classes, err := glue.CrushClassList()
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	nodes := make(map[int]model.OsdTreeNode)
+	is_child := make(map[int]bool)
+	for _, node := range tree.Nodes {
+		nodes[node.Id] = node
+		for _, child := range node.Children {
+			is_child[child] = true
+		}
+	}
+	dat := model.CrushTree{Roots: make([]model.CrushNode, 0), Stray: make([]model.CrushNode, 0), Classes: classes}
+	for _, node := range tree.Nodes {
+		if !is_child[node.Id] {
+			dat.Roots = append(dat.Roots, crushNode(node.Id, nodes))
+		}
+	}
+	for _, node := range tree.Stray {
+		dat.Stray = append(dat.Stray, model.CrushNode{Id: node.Id, Name: node.Name, Type: node.Type, DeviceClass: node.DeviceClass, Status: node.Status})
+	}
+	ctx.IndentedJSON(http.StatusOK, dat)
+}
+
+// CrushRuleList godoc
+//
+//	@Summary		Show List of CRUSH Rules
+//	@Description	Glue 의 CRUSH 규칙 목록과 각 규칙의 root, 장애 도메인, 디바이스 클래스, 사용하는 풀을 보여줍니다.
+//	@Tags			Crush
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{array}		model.CrushRule
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/crush/rule [get]
+func (c *Controller) CrushRuleList(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	dat, err := crushRules(ctx.Request.Context())
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, dat)
+}
+
+// CrushRuleCreate godoc
+//
+//	@Summary		Create of CRUSH Rule
+//	@Description	root 아래에서 장애 도메인 단위로 복제본을 배치하는 CRUSH 규칙을 생성합니다. 디바이스 클래스를 지정하면 해당 클래스(ssd, hdd 등)의 OSD 만 사용합니다.
+//	@Tags			Crush
+//	@param			rule_name		formData	string	true	"CRUSH Rule Name"
+//	@param			root			formData	string	false	"CRUSH Root"	default(default)
+//	@param			failure_domain	formData	string	false	"Failure Domain Type"	default(host)
+//	@param			device_class	formData	string	false	"Device Class"
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{string}	string	"Success"
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/crush/rule [post]
+func (c *Controller) CrushRuleCreate(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	rule_name, _ := ctx.GetPostForm("rule_name")
+	root := ctx.DefaultPostForm("root", "default")
+	failure_domain := ctx.DefaultPostForm("failure_domain", "host")
+	device_class, _ := ctx.GetPostForm("device_class")
+	if rule_name == "" {
+		err := errors.New("rule_name is required")
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+	if device_class != "" {
+		classes, err := glue.CrushClassList()
+		if err != nil {
+			utils.FancyHandleError(err)
+			httputil.NewError(ctx, http.StatusInternalServerError, err)
+			return
+		}
+		found := false
+		for _, class := range classes {
+			found = found || class == device_class
+		}
+		if !found {
+			err = errors.New("device class " + device_class + " is not found")
+			utils.FancyHandleError(err)
+			httputil.NewError(ctx, http.StatusNotFound, err)
+			return
+		}
+	}
+	output, err := glue.CrushRuleCreate(rule_name, root, failure_domain, device_class)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, output)
+}
+
+// CrushRuleDelete godoc
+//
+//	@Summary		Delete of CRUSH Rule
+//	@Description	풀에서 사용하지 않는 CRUSH 규칙을 삭제합니다.
+//	@Tags			Crush
+//	@param			rule_name	path	string	true	"CRUSH Rule Name"
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{string}	string	"Success"
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/crush/rule/{rule_name} [delete]
+func (c *Controller) CrushRuleDelete(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	rule_name := ctx.Param("rule_name")
+	rules, err := crushRules(ctx.Request.Context())
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	found := false
+	for _, rule := range rules {
+		if rule.RuleName != rule_name {
+			continue
+		}
+		found = true
+		if len(rule.Pools) > 0 {
+			err = errors.New("crush rule " + rule_name + " is used by pool " + strings.Join(rule.Pools, ", "))
+			utils.FancyHandleError(err)
+			httputil.NewError(ctx, http.StatusBadRequest, err)
+			return
+		}
+	}
+	if !found {
+		err = errors.New("crush rule " + rule_name + " is not found")
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusNotFound, err)
+		return
+	}
+	output, err := glue.CrushRuleDelete(rule_name)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, output)
+}
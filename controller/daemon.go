@@ -0,0 +1,103 @@
+package controller
+
+import (
+	"Glue-API/httputil"
+	"Glue-API/model"
+	"Glue-API/utils"
+	"Glue-API/utils/glue"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+func (c *Controller) DaemonOption(ctx *gin.Context) {
+	SetOptionHeader(ctx)
+	ctx.IndentedJSON(http.StatusOK, nil)
+}
+
+// DaemonList godoc
+//
+//	@Summary		Show List of Glue Daemons
+//	@Description	ceph orch ps 로 Glue 데몬 목록(호스트, 상태, 버전, 메모리, 컨테이너 이미지)을 보여줍니다. 서비스, 호스트, 데몬 종류로 필터링할 수 있습니다.
+//	@Tags			Daemon
+//	@param			service_name	query	string	false	"Glue Service Name"
+//	@param			hostname		query	string	false	"Host Name"
+//	@param			daemon_type		query	string	false	"Daemon Type"
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{object}	model.DaemonList
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/daemon [get]
+func (c *Controller) DaemonList(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	service_name := ctx.Request.URL.Query().Get("service_name")
+	hostname := ctx.Request.URL.Query().Get("hostname")
+	daemon_type := ctx.Request.URL.Query().Get("daemon_type")
+	daemons, err := glue.DaemonList(hostname, service_name)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	dat := make(model.DaemonList, 0)
+	for _, daemon := range daemons {
+		if daemon_type == "" || daemon.DaemonType == daemon_type {
+			dat = append(dat, daemon)
+		}
+	}
+	ctx.IndentedJSON(http.StatusOK, dat)
+}
+
+// DaemonControl godoc
+//
+//	@Summary		Control of Glue Daemon
+//	@Description	서비스 전체가 아닌 Glue 데몬 하나를 시작, 중지, 재시작하거나 다시 배포합니다.
+//	@Tags			Daemon
+//	@param			daemon_name	path	string	true	"Daemon Name"
+//	@param			control		query	string	true	"Daemon Control"	Enums(start, stop, restart, redeploy)
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{string}	string	"Success"
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/daemon/{daemon_name} [post]
+func (c *Controller) DaemonControl(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	daemon_name := ctx.Param("daemon_name")
+	control := ctx.Request.URL.Query().Get("control")
+	if control != "start" && control != "stop" && control != "restart" && control != "redeploy" {
+		err := errors.New("control must be start, stop, restart or redeploy")
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+	daemons, err := glue.DaemonList("", "")
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	found := false
+	for _, daemon := range daemons {
+		found = found || daemon.DaemonName == daemon_name
+	}
+	if !found {
+		err = errors.New("daemon " + daemon_name + " is not found")
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusNotFound, err)
+		return
+	}
+	dat, err := glue.DaemonControl(control, daemon_name)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, dat)
+}
@@ -0,0 +1,303 @@
+package controller
+
+import (
+	"Glue-API/httputil"
+	"Glue-API/model"
+	"Glue-API/utils"
+	"Glue-API/utils/fs"
+	"Glue-API/utils/glue"
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+func (c *Controller) ErasureCodeProfileOption(ctx *gin.Context) {
+	SetOptionHeader(ctx)
+	ctx.IndentedJSON(http.StatusOK, nil)
+}
+
+// 프로파일을 사용하는 풀 목록을 채웁니다.
+func erasureCodeProfilePools(dat *model.ErasureCodeProfile, pools model.PoolDetail) {
+	for _, pool := range pools {
+		// type 3 은 EC 풀입니다.
+		if pool.Type == 3 && pool.ErasureCodeProfile == dat.Name {
+			dat.Pools = append(dat.Pools, pool.PoolName)
+		}
+	}
+}
+
+// erasureCodeProfileForm 은 폼 값을 읽고, k+m 개의 청크를 배치할 수 있는 호스트 또는 OSD 가 있는지 확인합니다.
+func erasureCodeProfileForm(ctx *gin.Context, profile_name string) (dat model.ErasureCodeProfile, err error) {
+	dat.Name = profile_name
+	k, _ := ctx.GetPostForm("k")
+	m, _ := ctx.GetPostForm("m")
+	dat.Plugin, _ = ctx.GetPostForm("plugin")
+	dat.Technique, _ = ctx.GetPostForm("technique")
+	dat.CrushFailureDomain, _ = ctx.GetPostForm("crush_failure_domain")
+	dat.CrushDeviceClass, _ = ctx.GetPostForm("crush_device_class")
+	dat.CrushRoot, _ = ctx.GetPostForm("crush_root")
+	if dat.Plugin == "" {
+		dat.Plugin = "jerasure"
+	}
+	if dat.CrushFailureDomain == "" {
+		dat.CrushFailureDomain = "host"
+	}
+	if dat.Name == "" {
+		err = errors.New("profile_name is required")
+		return
+	}
+	if dat.K, err = strconv.Atoi(k); err != nil || dat.K < 2 {
+		err = errors.New("k must be 2 or more")
+		return
+	}
+	if dat.M, err = strconv.Atoi(m); err != nil || dat.M < 1 {
+		err = errors.New("m must be 1 or more")
+		return
+	}
+	if dat.Plugin != "jerasure" && dat.Plugin != "isa" && dat.Plugin != "clay" {
+		err = errors.New("plugin must be jerasure, isa or clay")
+		return
+	}
+
+	chunks := dat.K + dat.M
+	osds := 0
+	if dat.CrushDeviceClass != "" {
+		class_osds, err := glue.CrushClassOsds(dat.CrushDeviceClass)
+		if err != nil {
+			return dat, err
+		}
+		osds = len(class_osds)
+	} else {
+		status, err := glue.Status()
+		if err != nil {
+			return dat, err
+		}
+		osds = status.Osdmap.NumOsds
+	}
+	if osds < chunks {
+		err = errors.New("k+m(" + strconv.Itoa(chunks) + ") is greater than the number of osds(" + strconv.Itoa(osds) + ")")
+		return
+	}
+	if dat.CrushFailureDomain == "host" {
+		hosts, err := fs.CephHost()
+		if err != nil {
+			return dat, err
+		}
+		if len(hosts) < chunks {
+			err = errors.New("k+m(" + strconv.Itoa(chunks) + ") is greater than the number of hosts(" + strconv.Itoa(len(hosts)) + ") for host failure domain")
+			return dat, err
+		}
+	}
+	return
+}
+
+// ErasureCodeProfileList godoc
+//
+//	@Summary		Show List or Info of Erasure Code Profiles
+//	@Description	Glue EC 프로파일 목록 또는 상세정보와 이를 사용하는 풀을 보여줍니다.
+//	@Tags			ErasureCodeProfile
+//	@param			profile_name	query	string	false	"Erasure Code Profile Name"
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{object}	model.ErasureCodeProfileList
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/ecprofile [get]
+func (c *Controller) ErasureCodeProfileList(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	profile_name := ctx.Request.URL.Query().Get("profile_name")
+	pools, err := glue.PoolDetail(ctx.Request.Context())
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	if profile_name != "" {
+		dat, err := glue.ErasureCodeProfileGet(ctx.Request.Context(), profile_name)
+		if err != nil {
+			utils.FancyHandleError(err)
+			httputil.NewError(ctx, http.StatusNotFound, err)
+			return
+		}
+		erasureCodeProfilePools(&dat, pools)
+		ctx.IndentedJSON(http.StatusOK, dat)
+		return
+	}
+	names, err := glue.ErasureCodeProfileNames()
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	dat := make(model.ErasureCodeProfileList, 0)
+	for _, name := range names {
+		profile, err := glue.ErasureCodeProfileGet(ctx.Request.Context(), name)
+		if err != nil {
+			utils.FancyHandleError(err)
+			httputil.NewError(ctx, http.StatusInternalServerError, err)
+			return
+		}
+		erasureCodeProfilePools(&profile, pools)
+		dat = append(dat, profile)
+	}
+	ctx.IndentedJSON(http.StatusOK, dat)
+}
+
+// ErasureCodeProfileCreate godoc
+//
+//	@Summary		Create of Erasure Code Profile
+//	@Description	Glue EC 프로파일을 생성합니다. k+m 이 장애 도메인(host)의 호스트 수 또는 OSD 수보다 크면 생성하지 않습니다.
+//	@Tags			ErasureCodeProfile
+//	@param			profile_name			formData	string	true	"Erasure Code Profile Name"
+//	@param			k						formData	int		true	"Data Chunks"
+//	@param			m						formData	int		true	"Coding Chunks"
+//	@param			plugin					formData	string	false	"Erasure Code Plugin"	Enums(jerasure, isa, clay)	default(jerasure)
+//	@param			technique				formData	string	false	"Erasure Code Technique"
+//	@param			crush_failure_domain	formData	string	false	"CRUSH Failure Domain"	default(host)
+//	@param			crush_device_class		formData	string	false	"CRUSH Device Class"
+//	@param			crush_root				formData	string	false	"CRUSH Root"
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{string}	string	"Success"
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/ecprofile [post]
+func (c *Controller) ErasureCodeProfileCreate(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	profile_name, _ := ctx.GetPostForm("profile_name")
+	dat, err := erasureCodeProfileForm(ctx, profile_name)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+	names, err := glue.ErasureCodeProfileNames()
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	for _, name := range names {
+		if name == profile_name {
+			err = errors.New("erasure code profile " + profile_name + " already exists")
+			utils.FancyHandleError(err)
+			httputil.NewError(ctx, http.StatusBadRequest, err)
+			return
+		}
+	}
+	output, err := glue.ErasureCodeProfileSet(dat, false)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, output)
+}
+
+// ErasureCodeProfileUpdate godoc
+//
+//	@Summary		Update of Erasure Code Profile
+//	@Description	풀에서 사용하지 않는 Glue EC 프로파일을 변경합니다. 풀이 생성된 뒤에는 EC 설정을 바꿀 수 없으므로 사용 중인 프로파일은 변경하지 않습니다.
+//	@Tags			ErasureCodeProfile
+//	@param			profile_name			path		string	true	"Erasure Code Profile Name"
+//	@param			k						formData	int		true	"Data Chunks"
+//	@param			m						formData	int		true	"Coding Chunks"
+//	@param			plugin					formData	string	false	"Erasure Code Plugin"	Enums(jerasure, isa, clay)	default(jerasure)
+//	@param			technique				formData	string	false	"Erasure Code Technique"
+//	@param			crush_failure_domain	formData	string	false	"CRUSH Failure Domain"	default(host)
+//	@param			crush_device_class		formData	string	false	"CRUSH Device Class"
+//	@param			crush_root				formData	string	false	"CRUSH Root"
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{string}	string	"Success"
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/ecprofile/{profile_name} [put]
+func (c *Controller) ErasureCodeProfileUpdate(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	profile_name := ctx.Param("profile_name")
+	current, status, err := erasureCodeProfileUnused(ctx.Request.Context(), profile_name)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, status, err)
+		return
+	}
+	dat, err := erasureCodeProfileForm(ctx, current.Name)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+	output, err := glue.ErasureCodeProfileSet(dat, true)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, output)
+}
+
+// ErasureCodeProfileDelete godoc
+//
+//	@Summary		Delete of Erasure Code Profile
+//	@Description	풀에서 사용하지 않는 Glue EC 프로파일을 삭제합니다.
+//	@Tags			ErasureCodeProfile
+//	@param			profile_name	path	string	true	"Erasure Code Profile Name"
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{string}	string	"Success"
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/ecprofile/{profile_name} [delete]
+func (c *Controller) ErasureCodeProfileDelete(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	profile_name := ctx.Param("profile_name")
+	if _, status, err := erasureCodeProfileUnused(ctx.Request.Context(), profile_name); err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, status, err)
+		return
+	}
+	output, err := glue.ErasureCodeProfileDelete(profile_name)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, output)
+}
+
+// erasureCodeProfileUnused 는 프로파일이 있고 어떤 풀에서도 사용하지 않는지 확인합니다.
+func erasureCodeProfileUnused(ctx context.Context, profile_name string) (dat model.ErasureCodeProfile, status int, err error) {
+	names, err := glue.ErasureCodeProfileNames()
+	if err != nil {
+		return dat, http.StatusInternalServerError, err
+	}
+	found := false
+	for _, name := range names {
+		found = found || name == profile_name
+	}
+	if !found {
+		return dat, http.StatusNotFound, errors.New("erasure code profile " + profile_name + " is not found")
+	}
+	pools, err := glue.PoolDetail(ctx)
+	if err != nil {
+		return dat, http.StatusInternalServerError, err
+	}
+	dat = model.ErasureCodeProfile{Name: profile_name, Pools: make([]string, 0)}
+	erasureCodeProfilePools(&dat, pools)
+	if len(dat.Pools) > 0 {
+		return dat, http.StatusBadRequest, errors.New("erasure code profile " + profile_name + " is used by pool " + dat.Pools[0])
+	}
+	return dat, http.StatusOK, nil
+}
@@ -31,13 +31,13 @@ func (c *Controller) FsOption(ctx *gin.Context) {
 func (c *Controller) FsStatus(ctx *gin.Context) {
 	ctx.Header("Access-Control-Allow-Origin", "*")
 
-	dat, err := fs.FsStatus()
+	dat, err := fs.FsStatus(ctx.Request.Context())
 	if err != nil {
 		utils.FancyHandleError(err)
 		httputil.NewError(ctx, http.StatusInternalServerError, err)
 		return
 	}
-	dat2, err := fs.FsList()
+	dat2, err := fs.FsList(ctx.Request.Context())
 	value := model.FsSum{
 		FsStatus: dat,
 		FsList:   dat2,
@@ -72,7 +72,7 @@ func (c *Controller) FsCreate(ctx *gin.Context) {
 	hosts, _ := ctx.GetPostFormArray("hosts")
 
 	hosts_str := strings.Join(hosts, ",")
-	dat, err := fs.FsCreate(fs_name, hosts_str)
+	dat, err := fs.FsCreate(ctx.Request.Context(), fs_name, hosts_str)
 	if err != nil {
 		utils.FancyHandleError(err)
 		httputil.NewError(ctx, http.StatusInternalServerError, err)
@@ -105,7 +105,7 @@ func (c *Controller) FsUpdate(ctx *gin.Context) {
 	hosts, _ := ctx.GetPostFormArray("hosts")
 
 	hosts_str := strings.Join(hosts, ",")
-	dat, err := fs.FsUpdate(old_name, new_name, hosts_str)
+	dat, err := fs.FsUpdate(ctx.Request.Context(), old_name, new_name, hosts_str)
 	if err != nil {
 		utils.FancyHandleError(err)
 		httputil.NewError(ctx, http.StatusInternalServerError, err)
@@ -132,7 +132,7 @@ func (c *Controller) FsDelete(ctx *gin.Context) {
 	ctx.Header("Access-Control-Allow-Origin", "*")
 
 	fs_name := ctx.Param("fs_name")
-	list, err := fs.SubVolumeGroupLs(fs_name)
+	list, err := fs.SubVolumeGroupLs(ctx.Request.Context(), fs_name)
 	if err != nil {
 		utils.FancyHandleError(err)
 		httputil.NewError(ctx, http.StatusInternalServerError, err)
@@ -141,7 +141,7 @@ func (c *Controller) FsDelete(ctx *gin.Context) {
 	if len(list) != 0 {
 		ctx.IndentedJSON(http.StatusOK, "Please Subvolume Group Check")
 	} else {
-		dat, err := fs.FsDelete(fs_name)
+		dat, err := fs.FsDelete(ctx.Request.Context(), fs_name)
 		if err != nil {
 			utils.FancyHandleError(err)
 			httputil.NewError(ctx, http.StatusInternalServerError, err)
@@ -169,7 +169,7 @@ func (c *Controller) FsGetInfo(ctx *gin.Context) {
 	ctx.Header("Access-Control-Allow-Origin", "*")
 
 	fs_name := ctx.Param("fs_name")
-	dat, err := fs.FsGetInfo(fs_name)
+	dat, err := fs.FsGetInfo(ctx.Request.Context(), fs_name)
 	if err != nil {
 		utils.FancyHandleError(err)
 		httputil.NewError(ctx, http.StatusInternalServerError, err)
@@ -6,6 +6,7 @@ import (
 	"Glue-API/utils"
 	"Glue-API/utils/glue"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"os/exec"
 	"strconv"
@@ -93,7 +94,7 @@ func (c *Controller) ListPools(ctx *gin.Context) {
 	ctx.Header("Access-Control-Allow-Origin", "*")
 
 	pool_type := ctx.Request.URL.Query().Get("pool_type")
-	dat, err := glue.ListPool(pool_type)
+	dat, err := glue.ListPool(ctx.Request.Context(), pool_type)
 	if err != nil {
 		utils.FancyHandleError(err)
 		httputil.NewError(ctx, http.StatusInternalServerError, err)
@@ -105,12 +106,14 @@ func (c *Controller) ListPools(ctx *gin.Context) {
 // PoolDelete godoc
 //
 //	@Summary		Delete of Pool
-//	@Description	Glue 스토리지 풀을 삭제합니다.
+//	@Description	Glue 스토리지 풀을 삭제합니다. 첫 번째 요청은 풀 사용 현황과 확인 토큰을 반환하며, 확인 토큰을 전달한 두 번째 요청에서 삭제합니다. 보호된 풀은 삭제할 수 없으며 사용 중인 풀은 force 를 지정해야 삭제합니다.
 //	@Tags			Pool
-//	@param			pool_name	path	string	true	"pool_name"
+//	@param			pool_name		path	string	true	"pool_name"
+//	@param			force			query	bool	false	"Delete Pool Even If It Is In Use"
+//	@param			confirm_token	query	string	false	"Confirm Token Returned By First Request"
 //	@Accept			x-www-form-urlencoded
 //	@Produce		json
-//	@Success		200	{string}	string	"Success"
+//	@Success		200	{object}	model.PoolDeleteConfirm
 //	@Failure		400	{object}	httputil.HTTP400BadRequest
 //	@Failure		404	{object}	httputil.HTTP404NotFound
 //	@Failure		500	{object}	httputil.HTTP500InternalServerError
@@ -119,14 +122,32 @@ func (c *Controller) PoolDelete(ctx *gin.Context) {
 	ctx.Header("Access-Control-Allow-Origin", "*")
 
 	pool_name := ctx.Param("pool_name")
-	dat, err := glue.PoolDelete(pool_name)
+	force, _ := strconv.ParseBool(ctx.Request.URL.Query().Get("force"))
+	confirm_token := ctx.Request.URL.Query().Get("confirm_token")
+
+	dat, status, err := poolDeleteCheck(ctx.Request.Context(), pool_name, force)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, status, err)
+		return
+	}
+	if confirm_token == "" {
+		ctx.IndentedJSON(http.StatusOK, poolDeleteToken(dat))
+		return
+	}
+	if err = poolDeleteConfirm(ctx.Request.Context(), pool_name, force, confirm_token); err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+	output, err := glue.PoolDelete(ctx.Request.Context(), pool_name)
 	if err != nil {
 		utils.FancyHandleError(err)
 		httputil.NewError(ctx, http.StatusInternalServerError, err)
 		return
 	}
 	// Print the output
-	ctx.IndentedJSON(http.StatusOK, dat)
+	ctx.IndentedJSON(http.StatusOK, output)
 }
 
 // ListAndInfoImage godoc
@@ -150,7 +171,7 @@ func (c *Controller) ListAndInfoImage(ctx *gin.Context) {
 	image_name := ctx.Request.URL.Query().Get("image_name")
 
 	if image_name == "" && pool_name == "" {
-		rbd_pool_dat, err := glue.RbdPool()
+		rbd_pool_dat, err := glue.RbdPool(ctx.Request.Context())
 		if err != nil {
 			utils.FancyHandleError(err)
 			httputil.NewError(ctx, http.StatusInternalServerError, err)
@@ -158,7 +179,7 @@ func (c *Controller) ListAndInfoImage(ctx *gin.Context) {
 		}
 		var pools []string
 		for i := 0; i < len(rbd_pool_dat); i++ {
-			rbd_image_dat, err := glue.RbdImage(rbd_pool_dat[i])
+			rbd_image_dat, err := glue.RbdImage(ctx.Request.Context(), rbd_pool_dat[i])
 			if err != nil {
 				utils.FancyHandleError(err)
 				httputil.NewError(ctx, http.StatusInternalServerError, err)
@@ -171,7 +192,7 @@ func (c *Controller) ListAndInfoImage(ctx *gin.Context) {
 		}
 		ctx.IndentedJSON(http.StatusOK, pools)
 	} else if image_name == "" && pool_name != "" {
-		dat, err := glue.InfoImage(pool_name)
+		dat, err := glue.InfoImage(ctx.Request.Context(), pool_name)
 		if err != nil {
 			utils.FancyHandleError(err)
 			httputil.NewError(ctx, http.StatusInternalServerError, err)
@@ -179,7 +200,7 @@ func (c *Controller) ListAndInfoImage(ctx *gin.Context) {
 		}
 		ctx.IndentedJSON(http.StatusOK, dat)
 	} else {
-		dat, err := glue.ListAndInfoImage(image_name, pool_name)
+		dat, err := glue.ListAndInfoImage(ctx.Request.Context(), image_name, pool_name)
 		if err != nil {
 			utils.FancyHandleError(err)
 			httputil.NewError(ctx, http.StatusInternalServerError, err)
@@ -218,7 +239,7 @@ func (c *Controller) CreateImage(ctx *gin.Context) {
 	}
 	size_int = size_int * 1024
 	size_st := strconv.Itoa(size_int)
-	dat, err := glue.CreateImage(image_name, pool_name, size_st)
+	dat, err := glue.CreateImage(ctx.Request.Context(), image_name, pool_name, size_st)
 	if err != nil {
 		utils.FancyHandleError(err)
 		httputil.NewError(ctx, http.StatusInternalServerError, err)
@@ -230,10 +251,11 @@ func (c *Controller) CreateImage(ctx *gin.Context) {
 // DeleteImage godoc
 //
 //	@Summary		Delete Images of Pool
-//	@Description	Glue 스토리지 풀의 이미지를 삭제합니다.
+//	@Description	Glue 스토리지 풀의 이미지를 휴지통으로 옮깁니다. 유예 기간(초)을 지정하지 않으면 풀의 휴지통 유예 기간을 사용하며, 유예 기간 안에는 휴지통에서 복구할 수 있습니다.
 //	@Tags			Image
 //	@param			image_name	query	string	true	"Glue Image Name"
 //	@param			pool_name	query	string	true	"Glue Pool Name"
+//	@param			expires_in	query	int		false	"Trash Deferment Seconds"
 //	@Accept			x-www-form-urlencoded
 //	@Produce		json
 //	@Success		200	{string}	string "Success"
@@ -246,7 +268,21 @@ func (c *Controller) DeleteImage(ctx *gin.Context) {
 
 	image_name := ctx.Request.URL.Query().Get("image_name")
 	pool_name := ctx.Request.URL.Query().Get("pool_name")
-	dat, err := glue.DeleteImage(image_name, pool_name)
+	expires_in := ctx.Request.URL.Query().Get("expires_in")
+	var dat string
+	var err error
+	if expires_in == "" {
+		dat, err = glue.DeleteImage(ctx.Request.Context(), image_name, pool_name)
+	} else {
+		seconds, conv_err := strconv.Atoi(expires_in)
+		if conv_err != nil || seconds < 0 {
+			err = errors.New("expires_in must be a non-negative number of seconds")
+			utils.FancyHandleError(err)
+			httputil.NewError(ctx, http.StatusBadRequest, err)
+			return
+		}
+		dat, err = glue.TrashMove(ctx.Request.Context(), pool_name, image_name, seconds)
+	}
 	if err != nil {
 		utils.FancyHandleError(err)
 		httputil.NewError(ctx, http.StatusInternalServerError, err)
@@ -0,0 +1,136 @@
+package controller
+
+import (
+	"Glue-API/httputil"
+	"Glue-API/model"
+	"Glue-API/utils"
+	"Glue-API/utils/glue"
+	"errors"
+	"net/http"
+	"regexp"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ceph health mute 가 받는 기간 형식(30m, 2h, 1d, 1w 등)입니다.
+var health_mute_ttl = regexp.MustCompile(`^[0-9]+(s|m|h|d|w)$`)
+
+func (c *Controller) HealthOption(ctx *gin.Context) {
+	SetOptionHeader(ctx)
+	ctx.IndentedJSON(http.StatusOK, nil)
+}
+
+// HealthMuteList godoc
+//
+//	@Summary		Show List of Muted Health Checks
+//	@Description	무시 중인 Glue 상태 점검 항목 목록을 보여줍니다.
+//	@Tags			Glue
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{array}		model.HealthMute
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/glue/health/mute [get]
+func (c *Controller) HealthMuteList(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	status, err := glue.Status()
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	dat := status.Health.Mutes
+	if dat == nil {
+		dat = make([]model.HealthMute, 0)
+	}
+	ctx.IndentedJSON(http.StatusOK, dat)
+}
+
+// HealthMute godoc
+//
+//	@Summary		Mute of Health Check
+//	@Description	장애 조치 중 반복되는 상태 점검 항목을 무시합니다. ttl 을 지정하면 해당 기간이 지난 뒤 자동으로 해제됩니다.
+//	@Tags			Glue
+//	@param			code	formData	string	true	"Health Check Code"
+//	@param			ttl		formData	string	false	"Mute Duration(30m, 2h, 1d, 1w ...)"
+//	@param			sticky	formData	bool	false	"Keep Mute After Health Check Is Cleared"	default(false)
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{string}	string	"Success"
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/glue/health/mute [post]
+func (c *Controller) HealthMute(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	code, _ := ctx.GetPostForm("code")
+	ttl, _ := ctx.GetPostForm("ttl")
+	sticky, _ := strconv.ParseBool(ctx.PostForm("sticky"))
+	if code == "" {
+		err := errors.New("code is required")
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+	if ttl != "" && !health_mute_ttl.MatchString(ttl) {
+		err := errors.New("ttl must be a number with unit s, m, h, d or w")
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+	output, err := glue.HealthMute(code, ttl, sticky)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, output)
+}
+
+// HealthUnmute godoc
+//
+//	@Summary		Unmute of Health Check
+//	@Description	상태 점검 항목의 무시를 해제합니다.
+//	@Tags			Glue
+//	@param			code	path	string	true	"Health Check Code"
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{string}	string	"Success"
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/glue/health/mute/{code} [delete]
+func (c *Controller) HealthUnmute(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	code := ctx.Param("code")
+	status, err := glue.Status()
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	muted := false
+	for _, mute := range status.Health.Mutes {
+		if mute.Code == code {
+			muted = true
+		}
+	}
+	if !muted {
+		err = errors.New(code + " is not muted")
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusNotFound, err)
+		return
+	}
+	output, err := glue.HealthUnmute(code)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, output)
+}
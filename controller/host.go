@@ -0,0 +1,366 @@
+package controller
+
+import (
+	"Glue-API/httputil"
+	"Glue-API/model"
+	"Glue-API/utils"
+	"Glue-API/utils/glue"
+	gluevm "Glue-API/utils/gwvm"
+	"Glue-API/utils/host"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// 유지보수 점검에서 게이트웨이로 보는 데몬 종류입니다.
+var hostGatewayTypes = []string{"nfs", "rgw", "iscsi", "nvmeof", "ingress", "haproxy", "keepalived", "mds"}
+
+func (c *Controller) HostOption(ctx *gin.Context) {
+	SetOptionHeader(ctx)
+	ctx.IndentedJSON(http.StatusOK, nil)
+}
+
+// hostGwvm 은 게이트웨이 VM 이 실행 중인 호스트 주소를 반환합니다. 조회할 수 없으면 빈 값을 반환합니다.
+func hostGwvm() string {
+	var dat struct {
+		Val struct {
+			Started string `json:"started"`
+		} `json:"val"`
+	}
+	output, err := gluevm.VmState("cell")
+	if err != nil || json.Unmarshal([]byte(output), &dat) != nil {
+		return ""
+	}
+	return dat.Val.Started
+}
+
+// hostMaintenanceReport 는 호스트를 유지보수 모드로 전환하기 전에 ok-to-stop, 게이트웨이 데몬, GWVM 배치를 점검합니다.
+func hostMaintenanceReport(hostname string) (dat model.HostMaintenanceReport, status int, err error) {
+	hosts, err := host.List()
+	if err != nil {
+		return dat, http.StatusInternalServerError, err
+	}
+	var target *model.OrchHost
+	for i := range hosts {
+		if hosts[i].Hostname == hostname {
+			target = &hosts[i]
+		}
+	}
+	if target == nil {
+		return dat, http.StatusNotFound, errors.New("host " + hostname + " is not found")
+	}
+	daemons, err := glue.DaemonList("", "")
+	if err != nil {
+		return dat, http.StatusInternalServerError, err
+	}
+
+	dat = model.HostMaintenanceReport{Hostname: hostname, Services: make([]model.HostServiceImpact, 0), Blockers: make([]string, 0)}
+	dat.OkToStop, dat.OkToStopMessage = host.OkToStop(hostname)
+	if !dat.OkToStop {
+		dat.Blockers = append(dat.Blockers, "ok-to-stop: "+dat.OkToStopMessage)
+	}
+	if gwvm := hostGwvm(); gwvm != "" && (gwvm == target.Addr || gwvm == target.Hostname) {
+		dat.Gwvm = true
+		dat.Blockers = append(dat.Blockers, "gateway vm is running on "+hostname+", migrate it first")
+	}
+
+	services := make(map[string]*model.HostServiceImpact)
+	for _, daemon := range daemons {
+		if daemon.Hostname == hostname && daemon.ServiceName != "" {
+			if _, ok := services[daemon.ServiceName]; !ok {
+				services[daemon.ServiceName] = &model.HostServiceImpact{ServiceName: daemon.ServiceName, Daemons: make([]string, 0)}
+			}
+			services[daemon.ServiceName].Daemons = append(services[daemon.ServiceName].Daemons, daemon.DaemonName)
+		}
+	}
+	for _, daemon := range daemons {
+		// status 1 은 running 입니다.
+		if impact, ok := services[daemon.ServiceName]; ok && daemon.Hostname != hostname && daemon.Status == 1 {
+			impact.OtherRunningDaemons++
+		}
+	}
+	for _, impact := range services {
+		impact.Impact = "degraded"
+		if impact.OtherRunningDaemons == 0 {
+			impact.Impact = "unavailable"
+			service_type, _, _ := strings.Cut(impact.ServiceName, ".")
+			for _, gateway := range hostGatewayTypes {
+				if service_type == gateway {
+					dat.Blockers = append(dat.Blockers, "service "+impact.ServiceName+" has no running daemon on other hosts")
+				}
+			}
+		}
+		dat.Services = append(dat.Services, *impact)
+	}
+	sort.Slice(dat.Services, func(i, j int) bool {
+		return dat.Services[i].ServiceName < dat.Services[j].ServiceName
+	})
+	return dat, http.StatusOK, nil
+}
+
+// HostOrchList godoc
+//
+//	@Summary		Show List of Orchestrator Hosts
+//	@Description	오케스트레이터에 등록된 Glue 호스트 목록과 레이블, 유지보수 상태를 보여줍니다.
+//	@Tags			Hosts
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{object}	model.OrchHostList
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/host [get]
+func (c *Controller) HostOrchList(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	dat, err := host.List()
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, dat)
+}
+
+// HostAdd godoc
+//
+//	@Summary		Add of Glue Host
+//	@Description	Glue 호스트를 오케스트레이터에 추가합니다. 호스트에는 cephadm 공개 키가 등록되어 있어야 합니다. 레이블을 지정하려면 주소도 함께 지정해야 합니다.
+//	@Tags			Hosts
+//	@param			hostname	formData	string		true	"Host Name"
+//	@param			addr		formData	string		false	"Host Address"
+//	@param			labels		formData	[]string	false	"Host Labels"	collectionFormat(multi)
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{string}	string	"Success"
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/host [post]
+func (c *Controller) HostAdd(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	hostname, _ := ctx.GetPostForm("hostname")
+	addr, _ := ctx.GetPostForm("addr")
+	labels, _ := ctx.GetPostFormArray("labels")
+	if hostname == "" {
+		err := errors.New("hostname is required")
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+	// ceph orch host add 는 주소와 레이블을 위치 인자로 받으므로 주소 없이 레이블만 넘기면 첫 레이블을 주소로 읽습니다.
+	if addr == "" && len(labels) > 0 {
+		err := errors.New("addr is required when labels are given")
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+	output, err := host.Add(hostname, addr, labels)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, output)
+}
+
+// HostRemove godoc
+//
+//	@Summary		Remove of Glue Host
+//	@Description	데몬이 없는 Glue 호스트를 오케스트레이터에서 제거합니다. 데몬이 남아 있으면 drain 을 지정하여 데몬을 먼저 다른 호스트로 옮긴 뒤 다시 요청해야 합니다.
+//	@Tags			Hosts
+//	@param			hostname	path	string	true	"Host Name"
+//	@param			drain		query	bool	false	"Drain Daemons Before Removal"
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{string}	string	"Success"
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/host/{hostname} [delete]
+func (c *Controller) HostRemove(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	hostname := ctx.Param("hostname")
+	drain, _ := strconv.ParseBool(ctx.Request.URL.Query().Get("drain"))
+	daemons, err := glue.DaemonList(hostname, "")
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	if len(daemons) > 0 {
+		if !drain {
+			err = errors.New("host " + hostname + " still has " + strconv.Itoa(len(daemons)) + " daemons, drain it first")
+			utils.FancyHandleError(err)
+			httputil.NewError(ctx, http.StatusBadRequest, err)
+			return
+		}
+		if _, err = host.Drain(hostname); err != nil {
+			utils.FancyHandleError(err)
+			httputil.NewError(ctx, http.StatusInternalServerError, err)
+			return
+		}
+		ctx.IndentedJSON(http.StatusOK, "Draining")
+		return
+	}
+	output, err := host.Remove(hostname)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, output)
+}
+
+// HostLabelAdd godoc
+//
+//	@Summary		Add Label of Glue Host
+//	@Description	Glue 호스트에 레이블을 추가합니다. NFS, RGW, iSCSI 서비스 배치(placement)에 레이블을 사용할 수 있습니다.
+//	@Tags			Hosts
+//	@param			hostname	path		string	true	"Host Name"
+//	@param			label		formData	string	true	"Host Label"
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{string}	string	"Success"
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/host/{hostname}/label [post]
+func (c *Controller) HostLabelAdd(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	hostname := ctx.Param("hostname")
+	label, _ := ctx.GetPostForm("label")
+	if label == "" {
+		err := errors.New("label is required")
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+	output, err := host.LabelAdd(hostname, label)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, output)
+}
+
+// HostLabelRemove godoc
+//
+//	@Summary		Remove Label of Glue Host
+//	@Description	Glue 호스트의 레이블을 제거합니다.
+//	@Tags			Hosts
+//	@param			hostname	path	string	true	"Host Name"
+//	@param			label		path	string	true	"Host Label"
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{string}	string	"Success"
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/host/{hostname}/label/{label} [delete]
+func (c *Controller) HostLabelRemove(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	hostname := ctx.Param("hostname")
+	label := ctx.Param("label")
+	output, err := host.LabelRemove(hostname, label)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, output)
+}
+
+// HostMaintenanceCheck godoc
+//
+//	@Summary		Show Pre-check of Host Maintenance
+//	@Description	호스트를 유지보수 모드로 전환하기 전에 ok-to-stop, 게이트웨이 VM 배치, 영향을 받는 서비스를 점검합니다.
+//	@Tags			Hosts
+//	@param			hostname	path	string	true	"Host Name"
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{object}	model.HostMaintenanceReport
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/host/{hostname}/maintenance [get]
+func (c *Controller) HostMaintenanceCheck(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	hostname := ctx.Param("hostname")
+	dat, status, err := hostMaintenanceReport(hostname)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, status, err)
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, dat)
+}
+
+// HostMaintenance godoc
+//
+//	@Summary		Enter or Exit Host Maintenance
+//	@Description	호스트를 유지보수 모드로 전환하거나 해제합니다. 전환 시 사전 점검에서 문제가 있으면 force 를 지정해야 하며, 영향을 받는 서비스 보고서를 반환합니다.
+//	@Tags			Hosts
+//	@param			hostname	path		string	true	"Host Name"
+//	@param			action		formData	string	true	"Maintenance Action"	Enums(enter, exit)
+//	@param			force		formData	bool	false	"Ignore Pre-check Failures"	default(false)
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{object}	model.HostMaintenanceReport
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/host/{hostname}/maintenance [put]
+func (c *Controller) HostMaintenance(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	hostname := ctx.Param("hostname")
+	action, _ := ctx.GetPostForm("action")
+	force, _ := strconv.ParseBool(ctx.PostForm("force"))
+	if action != "enter" && action != "exit" {
+		err := errors.New("action must be enter or exit")
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+	if action == "exit" {
+		output, err := host.Maintenance(hostname, action, false)
+		if err != nil {
+			utils.FancyHandleError(err)
+			httputil.NewError(ctx, http.StatusInternalServerError, err)
+			return
+		}
+		ctx.IndentedJSON(http.StatusOK, output)
+		return
+	}
+
+	dat, status, err := hostMaintenanceReport(hostname)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, status, err)
+		return
+	}
+	if len(dat.Blockers) > 0 && !force {
+		err = errors.New("host " + hostname + " is not ready for maintenance: " + strings.Join(dat.Blockers, "; "))
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+	if _, err = host.Maintenance(hostname, action, force); err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, dat)
+}
@@ -0,0 +1,256 @@
+package controller
+
+import (
+	"Glue-API/httputil"
+	"Glue-API/model"
+	"Glue-API/utils"
+	"Glue-API/utils/glue"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+func (c *Controller) ImageOption(ctx *gin.Context) {
+	SetOptionHeader(ctx)
+	ctx.IndentedJSON(http.StatusOK, nil)
+}
+
+// 경로의 풀과 이미지를 조회합니다. 이미지가 없으면 404 로 응답합니다.
+func imageDetail(ctx *gin.Context) (dat model.ImageDetail, ok bool) {
+	dat, err := glue.ImageDetail(ctx.Request.Context(), ctx.Param("pool_name"), ctx.Param("image_name"))
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusNotFound, err)
+		return dat, false
+	}
+	return dat, true
+}
+
+func imageHasFeature(dat model.ImageDetail, feature string) bool {
+	for _, item := range dat.Features {
+		if item == feature {
+			return true
+		}
+	}
+	return false
+}
+
+// ImageResize godoc
+//
+//	@Summary		Resize of Image
+//	@Description	Glue 스토리지 풀의 이미지 크기를 변경합니다. 크기를 줄이면 데이터가 삭제되므로 allow_shrink 를 지정해야 합니다.
+//	@Tags			Image
+//	@param			pool_name		path		string	true	"Glue Pool Name"
+//	@param			image_name		path		string	true	"Glue Image Name"
+//	@param			size			formData	int		true	"Image Size(default:GB)"
+//	@param			allow_shrink	formData	bool	false	"Allow Shrinking Image"	default(false)
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{string}	string	"Success"
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/image/{pool_name}/{image_name}/size [put]
+func (c *Controller) ImageResize(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	pool_name := ctx.Param("pool_name")
+	image_name := ctx.Param("image_name")
+	allow_shrink, _ := strconv.ParseBool(ctx.PostForm("allow_shrink"))
+	size, err := strconv.ParseInt(ctx.PostForm("size"), 10, 64)
+	if err != nil || size <= 0 {
+		err = errors.New("size must be a positive number")
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+	dat, ok := imageDetail(ctx)
+	if !ok {
+		return
+	}
+	size_bytes := size * 1024 * 1024 * 1024
+	size_st := strconv.FormatInt(size*1024, 10)
+	var output string
+	switch {
+	case size_bytes == dat.Size:
+		err = errors.New("image " + pool_name + "/" + image_name + " is already " + strconv.FormatInt(size, 10) + "GB")
+	case size_bytes < dat.Size && !allow_shrink:
+		err = errors.New("shrinking image " + pool_name + "/" + image_name + " discards data beyond " + strconv.FormatInt(size, 10) + "GB, set allow_shrink to proceed")
+	}
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+	if size_bytes < dat.Size {
+		output, err = glue.ShrinkImage(ctx.Request.Context(), image_name, pool_name, size_st)
+	} else {
+		output, err = glue.ResizeImage(ctx.Request.Context(), image_name, pool_name, size_st)
+	}
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, output)
+}
+
+// ImageRename godoc
+//
+//	@Summary		Rename of Image
+//	@Description	같은 스토리지 풀 안에서 이미지 이름을 변경합니다.
+//	@Tags			Image
+//	@param			pool_name		path		string	true	"Glue Pool Name"
+//	@param			image_name		path		string	true	"Glue Image Name"
+//	@param			new_image_name	formData	string	true	"New Glue Image Name"
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{string}	string	"Success"
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/image/{pool_name}/{image_name}/name [put]
+func (c *Controller) ImageRename(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	pool_name := ctx.Param("pool_name")
+	image_name := ctx.Param("image_name")
+	new_image_name, _ := ctx.GetPostForm("new_image_name")
+	if new_image_name == "" || strings.Contains(new_image_name, "/") {
+		err := errors.New("new_image_name is required and must not contain /")
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+	if _, ok := imageDetail(ctx); !ok {
+		return
+	}
+	output, err := glue.RenameImage(ctx.Request.Context(), image_name, pool_name, new_image_name)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, output)
+}
+
+// ImageCopy godoc
+//
+//	@Summary		Copy of Image
+//	@Description	이미지를 다른 스토리지 풀 또는 이름으로 복사합니다. deep 이면 스냅샷과 클론 관계까지 복사합니다.
+//	@Tags			Image
+//	@param			pool_name		path		string	true	"Glue Pool Name"
+//	@param			image_name		path		string	true	"Glue Image Name"
+//	@param			dest_pool_name	formData	string	true	"Destination Glue Pool Name"
+//	@param			dest_image_name	formData	string	true	"Destination Glue Image Name"
+//	@param			deep			formData	bool	false	"Deep Copy With Snapshots"	default(false)
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{string}	string	"Success"
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/image/{pool_name}/{image_name}/copy [post]
+func (c *Controller) ImageCopy(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	pool_name := ctx.Param("pool_name")
+	image_name := ctx.Param("image_name")
+	dest_pool_name, _ := ctx.GetPostForm("dest_pool_name")
+	dest_image_name, _ := ctx.GetPostForm("dest_image_name")
+	deep, _ := strconv.ParseBool(ctx.PostForm("deep"))
+	if dest_pool_name == "" || dest_image_name == "" {
+		err := errors.New("dest_pool_name and dest_image_name are required")
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+	if _, ok := imageDetail(ctx); !ok {
+		return
+	}
+	if _, err := glue.ImageDetail(ctx.Request.Context(), dest_pool_name, dest_image_name); err == nil {
+		err = errors.New("image " + dest_pool_name + "/" + dest_image_name + " already exists")
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+	output, err := glue.CopyImage(ctx.Request.Context(), pool_name, image_name, dest_pool_name, dest_image_name, deep)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, output)
+}
+
+// ImageFeature godoc
+//
+//	@Summary		Enable or Disable Image Feature
+//	@Description	이미지 기능을 켜거나 끕니다. object-map, journaling 은 exclusive-lock 이, fast-diff 는 object-map 이 먼저 켜져 있어야 합니다.
+//	@Tags			Image
+//	@param			pool_name	path		string	true	"Glue Pool Name"
+//	@param			image_name	path		string	true	"Glue Image Name"
+//	@param			feature		formData	string	true	"Image Feature"	Enums(exclusive-lock, object-map, fast-diff, journaling)
+//	@param			enabled		formData	bool	true	"Enable Feature"
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{string}	string	"Success"
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/image/{pool_name}/{image_name}/feature [put]
+func (c *Controller) ImageFeature(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	pool_name := ctx.Param("pool_name")
+	image_name := ctx.Param("image_name")
+	feature, _ := ctx.GetPostForm("feature")
+	enabled, err := strconv.ParseBool(ctx.PostForm("enabled"))
+	requires, valid := glue.ImageFeatures[feature]
+	if err != nil || !valid {
+		err = errors.New("feature(exclusive-lock, object-map, fast-diff, journaling) and enabled are required")
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+	dat, ok := imageDetail(ctx)
+	if !ok {
+		return
+	}
+	state := "disabled"
+	if enabled {
+		state = "enabled"
+	}
+	if imageHasFeature(dat, feature) == enabled {
+		err = errors.New("feature " + feature + " of image " + pool_name + "/" + image_name + " is already " + state)
+	} else if enabled {
+		for _, required := range requires {
+			if !imageHasFeature(dat, required) {
+				err = errors.New("feature " + feature + " requires " + required + " to be enabled first")
+			}
+		}
+	} else {
+		for dependent, dependent_requires := range glue.ImageFeatures {
+			for _, required := range dependent_requires {
+				if required == feature && imageHasFeature(dat, dependent) {
+					err = errors.New("feature " + dependent + " depends on " + feature + ", disable it first")
+				}
+			}
+		}
+	}
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+	output, err := glue.ImageFeature(ctx.Request.Context(), pool_name, image_name, feature, enabled)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, output)
+}
@@ -0,0 +1,199 @@
+package controller
+
+import (
+	"Glue-API/httputil"
+	_ "Glue-API/model" // swag 이 godoc 의 model 타입을 찾을 수 있도록 가져옵니다.
+	"Glue-API/utils"
+	"Glue-API/utils/glue"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ImageClone godoc
+//
+//	@Summary		Clone of Image Snapshot
+//	@Description	보호된 스냅샷에서 임의의 스토리지 풀에 클론 이미지를 생성합니다.
+//	@Tags			Image
+//	@param			pool_name		path		string	true	"Glue Pool Name"
+//	@param			image_name		path		string	true	"Glue Image Name"
+//	@param			snapshot_name	path		string	true	"Snapshot Name"
+//	@param			dest_pool_name	formData	string	true	"Clone Glue Pool Name"
+//	@param			dest_image_name	formData	string	true	"Clone Glue Image Name"
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{string}	string	"Success"
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/image/{pool_name}/{image_name}/snapshot/{snapshot_name}/clone [post]
+func (c *Controller) ImageClone(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	pool_name := ctx.Param("pool_name")
+	image_name := ctx.Param("image_name")
+	dest_pool_name, _ := ctx.GetPostForm("dest_pool_name")
+	dest_image_name, _ := ctx.GetPostForm("dest_image_name")
+	if dest_pool_name == "" || dest_image_name == "" || strings.Contains(dest_image_name, "/") {
+		err := errors.New("dest_pool_name and dest_image_name are required")
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+	snap, ok := imageSnapshot(ctx)
+	if !ok {
+		return
+	}
+	if !snap.Protected {
+		err := errors.New("snapshot " + snap.Name + " must be protected before cloning")
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+	if _, err := glue.ImageDetail(ctx.Request.Context(), dest_pool_name, dest_image_name); err == nil {
+		err = errors.New("image " + dest_pool_name + "/" + dest_image_name + " already exists")
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+	output, err := glue.CloneImage(ctx.Request.Context(), pool_name, image_name, snap.Name, dest_pool_name, dest_image_name)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, output)
+}
+
+// ImageFlatten godoc
+//
+//	@Summary		Flatten of Cloned Image
+//	@Description	클론 이미지에 부모 데이터를 복사하여 부모와의 관계를 끊는 작업을 백그라운드로 시작합니다. 진행률은 작업 목록에서 확인합니다.
+//	@Tags			Image
+//	@param			pool_name	path	string	true	"Glue Pool Name"
+//	@param			image_name	path	string	true	"Glue Image Name"
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{object}	model.ImageTask
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/image/{pool_name}/{image_name}/flatten [post]
+func (c *Controller) ImageFlatten(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	pool_name := ctx.Param("pool_name")
+	image_name := ctx.Param("image_name")
+	detail, ok := imageDetail(ctx)
+	if !ok {
+		return
+	}
+	if detail.Parent == nil {
+		err := errors.New("image " + pool_name + "/" + image_name + " is not a clone")
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+	dat, err := glue.FlattenImage(ctx.Request.Context(), pool_name, image_name)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, dat)
+}
+
+// ImageTaskList godoc
+//
+//	@Summary		Show List of Image Background Tasks
+//	@Description	flatten 등 대기 중이거나 진행 중인 이미지 백그라운드 작업과 진행률을 보여줍니다.
+//	@Tags			Image
+//	@param			task_id	query	string	false	"Task ID"
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{object}	model.ImageTaskList
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/image/task [get]
+func (c *Controller) ImageTaskList(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	task_id := ctx.Request.URL.Query().Get("task_id")
+	dat, err := glue.TaskList(ctx.Request.Context())
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	if task_id != "" {
+		for _, item := range dat {
+			if item.Id == task_id {
+				ctx.IndentedJSON(http.StatusOK, item)
+				return
+			}
+		}
+		// 완료된 작업은 목록에서 사라집니다.
+		err = errors.New("task " + task_id + " is not found or already completed")
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusNotFound, err)
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, dat)
+}
+
+// ImageTaskCancel godoc
+//
+//	@Summary		Cancel of Image Background Task
+//	@Description	대기 중이거나 진행 중인 이미지 백그라운드 작업을 취소합니다.
+//	@Tags			Image
+//	@param			task_id	path	string	true	"Task ID"
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{string}	string	"Success"
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/image/task/{task_id} [delete]
+func (c *Controller) ImageTaskCancel(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	output, err := glue.TaskCancel(ctx.Request.Context(), ctx.Param("task_id"))
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusNotFound, err)
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, output)
+}
+
+// ImageLineage godoc
+//
+//	@Summary		Show Lineage of Image
+//	@Description	이미지의 부모 체인(가까운 부모부터)과 스냅샷별 자식 클론 트리를 보여줍니다.
+//	@Tags			Image
+//	@param			pool_name	path	string	true	"Glue Pool Name"
+//	@param			image_name	path	string	true	"Glue Image Name"
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{object}	model.ImageLineage
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/image/{pool_name}/{image_name}/lineage [get]
+func (c *Controller) ImageLineage(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	if _, ok := imageDetail(ctx); !ok {
+		return
+	}
+	dat, err := glue.ImageLineage(ctx.Request.Context(), ctx.Param("pool_name"), ctx.Param("image_name"))
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, dat)
+}
@@ -0,0 +1,283 @@
+package controller
+
+import (
+	"Glue-API/httputil"
+	"Glue-API/model"
+	"Glue-API/utils"
+	"Glue-API/utils/glue"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+func snapshotNameValid(snapshot_name string) (err error) {
+	if snapshot_name == "" || strings.ContainsAny(snapshot_name, "@/") {
+		err = errors.New("snapshot name is required and must not contain @ or /")
+	}
+	return
+}
+
+// 경로의 스냅샷을 조회합니다. 스냅샷이 없으면 404 로 응답합니다.
+func imageSnapshot(ctx *gin.Context) (dat model.ImageSnapshot, ok bool) {
+	pool_name := ctx.Param("pool_name")
+	image_name := ctx.Param("image_name")
+	snapshot_name := ctx.Param("snapshot_name")
+	snaps, err := glue.SnapshotList(ctx.Request.Context(), pool_name, image_name)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusNotFound, err)
+		return dat, false
+	}
+	for _, snap := range snaps {
+		if snap.Name == snapshot_name {
+			return snap, true
+		}
+	}
+	err = errors.New("snapshot " + pool_name + "/" + image_name + "@" + snapshot_name + " is not found")
+	utils.FancyHandleError(err)
+	httputil.NewError(ctx, http.StatusNotFound, err)
+	return dat, false
+}
+
+// ImageSnapshotList godoc
+//
+//	@Summary		Show List of Image Snapshots
+//	@Description	이미지의 스냅샷 목록(크기, 생성 시각, 보호 여부)을 보여줍니다.
+//	@Tags			Image
+//	@param			pool_name	path	string	true	"Glue Pool Name"
+//	@param			image_name	path	string	true	"Glue Image Name"
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{object}	model.ImageSnapshotList
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/image/{pool_name}/{image_name}/snapshot [get]
+func (c *Controller) ImageSnapshotList(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	dat, err := glue.SnapshotList(ctx.Request.Context(), ctx.Param("pool_name"), ctx.Param("image_name"))
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusNotFound, err)
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, dat)
+}
+
+// ImageSnapshotCreate godoc
+//
+//	@Summary		Create of Image Snapshot
+//	@Description	이미지 스냅샷을 생성합니다. host_name 과 vm_name 을 지정하면 virsh domfsfreeze 로 게스트 파일시스템을 고정한 뒤 스냅샷을 생성합니다.
+//	@Tags			Image
+//	@param			pool_name		path		string	true	"Glue Pool Name"
+//	@param			image_name		path		string	true	"Glue Image Name"
+//	@param			snapshot_name	formData	string	true	"Snapshot Name"
+//	@param			host_name		formData	string	false	"Host Name of VM"
+//	@param			vm_name			formData	string	false	"VM Name For Filesystem Freeze"
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{string}	string	"Success"
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/image/{pool_name}/{image_name}/snapshot [post]
+func (c *Controller) ImageSnapshotCreate(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	pool_name := ctx.Param("pool_name")
+	image_name := ctx.Param("image_name")
+	snapshot_name, _ := ctx.GetPostForm("snapshot_name")
+	host_name, _ := ctx.GetPostForm("host_name")
+	vm_name, _ := ctx.GetPostForm("vm_name")
+	err := snapshotNameValid(snapshot_name)
+	if err == nil && (host_name == "") != (vm_name == "") {
+		err = errors.New("host_name and vm_name must be set together")
+	}
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+	// 파일시스템 고정은 로컬 클러스터의 하이퍼바이저에 SSH 로 접속하여 수행합니다.
+	if host_name != "" && !localCluster(ctx) {
+		return
+	}
+	if _, ok := imageDetail(ctx); !ok {
+		return
+	}
+	output, err := glue.SnapshotCreate(ctx.Request.Context(), pool_name, image_name, snapshot_name, host_name, vm_name)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, output)
+}
+
+// ImageSnapshotRename godoc
+//
+//	@Summary		Rename of Image Snapshot
+//	@Description	이미지 스냅샷 이름을 변경합니다.
+//	@Tags			Image
+//	@param			pool_name			path		string	true	"Glue Pool Name"
+//	@param			image_name			path		string	true	"Glue Image Name"
+//	@param			snapshot_name		path		string	true	"Snapshot Name"
+//	@param			new_snapshot_name	formData	string	true	"New Snapshot Name"
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{string}	string	"Success"
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/image/{pool_name}/{image_name}/snapshot/{snapshot_name}/name [put]
+func (c *Controller) ImageSnapshotRename(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	new_snapshot_name, _ := ctx.GetPostForm("new_snapshot_name")
+	if err := snapshotNameValid(new_snapshot_name); err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+	if _, ok := imageSnapshot(ctx); !ok {
+		return
+	}
+	output, err := glue.SnapshotRename(ctx.Request.Context(), ctx.Param("pool_name"), ctx.Param("image_name"), ctx.Param("snapshot_name"), new_snapshot_name)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, output)
+}
+
+// ImageSnapshotProtect godoc
+//
+//	@Summary		Protect or Unprotect of Image Snapshot
+//	@Description	클론의 부모로 사용할 수 있도록 스냅샷을 보호하거나 보호를 해제합니다. 클론이 남아 있는 스냅샷은 보호를 해제할 수 없습니다.
+//	@Tags			Image
+//	@param			pool_name		path		string	true	"Glue Pool Name"
+//	@param			image_name		path		string	true	"Glue Image Name"
+//	@param			snapshot_name	path		string	true	"Snapshot Name"
+//	@param			protected		formData	bool	true	"Protect Snapshot"
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{string}	string	"Success"
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/image/{pool_name}/{image_name}/snapshot/{snapshot_name}/protect [put]
+func (c *Controller) ImageSnapshotProtect(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	protected, err := strconv.ParseBool(ctx.PostForm("protected"))
+	if err != nil {
+		err = errors.New("protected must be true or false")
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+	dat, ok := imageSnapshot(ctx)
+	if !ok {
+		return
+	}
+	if dat.Protected == protected {
+		ctx.IndentedJSON(http.StatusOK, "Success")
+		return
+	}
+	output, err := glue.SnapshotProtect(ctx.Request.Context(), ctx.Param("pool_name"), ctx.Param("image_name"), dat.Name, protected)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, output)
+}
+
+// ImageSnapshotRollback godoc
+//
+//	@Summary		Rollback of Image To Snapshot
+//	@Description	이미지를 스냅샷 시점으로 되돌립니다. 이미지를 사용 중인 클라이언트가 있으면 force 를 지정해야 합니다.
+//	@Tags			Image
+//	@param			pool_name		path	string	true	"Glue Pool Name"
+//	@param			image_name		path	string	true	"Glue Image Name"
+//	@param			snapshot_name	path	string	true	"Snapshot Name"
+//	@param			force			query	bool	false	"Rollback Even If Image Is In Use"	default(false)
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{string}	string	"Success"
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/image/{pool_name}/{image_name}/snapshot/{snapshot_name}/rollback [post]
+func (c *Controller) ImageSnapshotRollback(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	pool_name := ctx.Param("pool_name")
+	image_name := ctx.Param("image_name")
+	force, _ := strconv.ParseBool(ctx.Request.URL.Query().Get("force"))
+	dat, ok := imageSnapshot(ctx)
+	if !ok {
+		return
+	}
+	watchers, err := glue.ImageWatchers(ctx.Request.Context(), pool_name, image_name)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	if len(watchers) > 0 && !force {
+		err = errors.New("image " + pool_name + "/" + image_name + " is in use by " + watchers[0].Address + ", stop the client or set force")
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+	output, err := glue.SnapshotRollback(ctx.Request.Context(), pool_name, image_name, dat.Name)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, output)
+}
+
+// ImageSnapshotDelete godoc
+//
+//	@Summary		Delete of Image Snapshot
+//	@Description	이미지 스냅샷을 삭제합니다. 보호된 스냅샷은 보호를 해제한 뒤 삭제할 수 있습니다.
+//	@Tags			Image
+//	@param			pool_name		path	string	true	"Glue Pool Name"
+//	@param			image_name		path	string	true	"Glue Image Name"
+//	@param			snapshot_name	path	string	true	"Snapshot Name"
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{string}	string	"Success"
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/image/{pool_name}/{image_name}/snapshot/{snapshot_name} [delete]
+func (c *Controller) ImageSnapshotDelete(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	dat, ok := imageSnapshot(ctx)
+	if !ok {
+		return
+	}
+	if dat.Protected {
+		err := errors.New("snapshot " + dat.Name + " is protected, unprotect it first")
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+	output, err := glue.SnapshotDelete(ctx.Request.Context(), ctx.Param("pool_name"), ctx.Param("image_name"), dat.Name)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, output)
+}
@@ -0,0 +1,246 @@
+package controller
+
+import (
+	"Glue-API/httputil"
+	"Glue-API/utils"
+	"Glue-API/utils/glue"
+	"compress/gzip"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+func imageCompress(ctx *gin.Context) (compress string, err error) {
+	compress = ctx.DefaultQuery("compress", "none")
+	if compress != "none" && compress != "gzip" {
+		err = errors.New("compress must be none or gzip")
+	}
+	return
+}
+
+// imageExportStream 은 rbd 출력을 응답으로 바로 흘려보냅니다.
+// 데이터를 보내기 시작한 뒤의 오류는 상태 코드로 알릴 수 없으므로 연결을 끊어 불완전한 파일임을 알립니다.
+func imageExportStream(ctx *gin.Context, file_name string, export func(w io.Writer) error) {
+	compress, err := imageCompress(ctx)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+	content_type := "application/octet-stream"
+	if compress == "gzip" {
+		content_type = "application/gzip"
+		file_name += ".gz"
+	}
+	ctx.Header("Content-Type", content_type)
+	ctx.Header("Content-Disposition", "attachment; filename="+file_name)
+
+	var w io.Writer = ctx.Writer
+	var gz *gzip.Writer
+	if compress == "gzip" {
+		gz = gzip.NewWriter(ctx.Writer)
+		w = gz
+	}
+	err = export(w)
+	if err == nil && gz != nil {
+		err = gz.Close()
+	}
+	if err == nil {
+		return
+	}
+	if !ctx.Writer.Written() {
+		ctx.Header("Content-Type", "")
+		ctx.Header("Content-Disposition", "")
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	utils.FancyHandleError(err)
+	// HTTP/2 연결은 Hijack 할 수 없으므로 ErrAbortHandler 로 중단하면 net/http 가 연결 또는 스트림을 끊습니다.
+	panic(http.ErrAbortHandler)
+}
+
+func imageImportStream(ctx *gin.Context) (r io.Reader, err error) {
+	compress, err := imageCompress(ctx)
+	if err != nil {
+		return
+	}
+	r = ctx.Request.Body
+	if compress == "gzip" {
+		r, err = gzip.NewReader(ctx.Request.Body)
+	}
+	return
+}
+
+// ImageExport godoc
+//
+//	@Summary		Export of Image
+//	@Description	이미지 또는 스냅샷의 raw 데이터를 스트리밍으로 내려받습니다. compress 를 gzip 으로 지정하면 압축해서 보냅니다.
+//	@Tags			Image
+//	@param			pool_name	path	string	true	"Glue Pool Name"
+//	@param			image_name	path	string	true	"Glue Image Name"
+//	@param			snapshot	query	string	false	"Snapshot Name"
+//	@param			compress	query	string	false	"Compression"	Enums(none, gzip)	default(none)
+//	@Accept			x-www-form-urlencoded
+//	@Produce		application/octet-stream
+//	@Success		200	{file}		binary
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/image/{pool_name}/{image_name}/export [get]
+func (c *Controller) ImageExport(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	pool_name := ctx.Param("pool_name")
+	image_name := ctx.Param("image_name")
+	snapshot := ctx.Request.URL.Query().Get("snapshot")
+	if _, ok := imageDetail(ctx); !ok {
+		return
+	}
+	file_name := image_name + ".raw"
+	if snapshot != "" {
+		file_name = image_name + "@" + snapshot + ".raw"
+	}
+	imageExportStream(ctx, file_name, func(w io.Writer) error {
+		return glue.Export(ctx.Request.Context(), w, pool_name, image_name, snapshot)
+	})
+}
+
+// ImageImport godoc
+//
+//	@Summary		Import of Image
+//	@Description	요청 본문으로 스트리밍한 raw 데이터로 새 이미지를 생성합니다. compress 를 gzip 으로 지정하면 압축을 풀어서 가져옵니다.
+//	@Tags			Image
+//	@param			pool_name	path	string	true	"Glue Pool Name"
+//	@param			image_name	path	string	true	"New Glue Image Name"
+//	@param			compress	query	string	false	"Compression"	Enums(none, gzip)	default(none)
+//	@Accept			application/octet-stream
+//	@Produce		json
+//	@Success		200	{string}	string	"Success"
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/image/{pool_name}/{image_name}/import [put]
+func (c *Controller) ImageImport(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	pool_name := ctx.Param("pool_name")
+	image_name := ctx.Param("image_name")
+	if _, err := glue.ImageDetail(ctx.Request.Context(), pool_name, image_name); err == nil {
+		err = errors.New("image " + pool_name + "/" + image_name + " already exists")
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+	r, err := imageImportStream(ctx)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+	output, err := glue.Import(ctx.Request.Context(), r, pool_name, image_name)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, output)
+}
+
+// ImageExportDiff godoc
+//
+//	@Summary		Export of Image Diff
+//	@Description	from_snapshot 부터 snapshot 까지 바뀐 영역을 스트리밍으로 내려받습니다. from_snapshot 을 비워 두면 이미지 생성 시점부터의 변경을 내려받습니다.
+//	@Tags			Image
+//	@param			pool_name		path	string	true	"Glue Pool Name"
+//	@param			image_name		path	string	true	"Glue Image Name"
+//	@param			snapshot		query	string	true	"End Snapshot Name"
+//	@param			from_snapshot	query	string	false	"Start Snapshot Name"
+//	@param			compress		query	string	false	"Compression"	Enums(none, gzip)	default(none)
+//	@Accept			x-www-form-urlencoded
+//	@Produce		application/octet-stream
+//	@Success		200	{file}		binary
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/image/{pool_name}/{image_name}/export-diff [get]
+func (c *Controller) ImageExportDiff(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	pool_name := ctx.Param("pool_name")
+	image_name := ctx.Param("image_name")
+	snapshot := ctx.Request.URL.Query().Get("snapshot")
+	from_snapshot := ctx.Request.URL.Query().Get("from_snapshot")
+	if snapshot == "" {
+		err := errors.New("snapshot is required")
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+	snaps, err := glue.SnapshotList(ctx.Request.Context(), pool_name, image_name)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusNotFound, err)
+		return
+	}
+	for _, name := range []string{from_snapshot, snapshot} {
+		found := name == ""
+		for _, snap := range snaps {
+			if snap.Name == name {
+				found = true
+			}
+		}
+		if !found {
+			err = errors.New("snapshot " + pool_name + "/" + image_name + "@" + name + " is not found")
+			utils.FancyHandleError(err)
+			httputil.NewError(ctx, http.StatusNotFound, err)
+			return
+		}
+	}
+	file_name := image_name + "@" + snapshot + ".diff"
+	if from_snapshot != "" {
+		file_name = image_name + "@" + from_snapshot + "-" + snapshot + ".diff"
+	}
+	imageExportStream(ctx, file_name, func(w io.Writer) error {
+		return glue.ExportDiff(ctx.Request.Context(), w, pool_name, image_name, from_snapshot, snapshot)
+	})
+}
+
+// ImageImportDiff godoc
+//
+//	@Summary		Import of Image Diff
+//	@Description	요청 본문으로 스트리밍한 변경분을 기존 이미지에 적용합니다. 변경분의 시작 스냅샷이 이미지에 있어야 하며, 적용 후 끝 스냅샷이 생성됩니다.
+//	@Tags			Image
+//	@param			pool_name	path	string	true	"Glue Pool Name"
+//	@param			image_name	path	string	true	"Glue Image Name"
+//	@param			compress	query	string	false	"Compression"	Enums(none, gzip)	default(none)
+//	@Accept			application/octet-stream
+//	@Produce		json
+//	@Success		200	{string}	string	"Success"
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/image/{pool_name}/{image_name}/import-diff [put]
+func (c *Controller) ImageImportDiff(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	pool_name := ctx.Param("pool_name")
+	image_name := ctx.Param("image_name")
+	if _, ok := imageDetail(ctx); !ok {
+		return
+	}
+	r, err := imageImportStream(ctx)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+	output, err := glue.ImportDiff(ctx.Request.Context(), r, pool_name, image_name)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, output)
+}
@@ -0,0 +1,338 @@
+package controller
+
+import (
+	"Glue-API/httputil"
+	"Glue-API/model"
+	"Glue-API/utils"
+	"Glue-API/utils/glue"
+	"errors"
+	"net/http"
+	"regexp"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// rbd trash purge schedule 은 분, 시간, 일 단위 주기를 받습니다.
+var trash_interval = regexp.MustCompile(`^[0-9]+[mhd]$`)
+
+// trashEntry 는 경로의 휴지통 이미지를 찾고, 없으면 404 를 응답합니다.
+func trashEntry(ctx *gin.Context) (entry model.ImageTrash, ok bool) {
+	pool_name := ctx.Param("pool_name")
+	image_id := ctx.Param("image_id")
+	dat, err := glue.TrashList(ctx.Request.Context(), pool_name)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	for _, item := range dat {
+		if item.Id == image_id {
+			return item, true
+		}
+	}
+	err = errors.New("image " + image_id + " is not found in trash of pool " + pool_name)
+	utils.FancyHandleError(err)
+	httputil.NewError(ctx, http.StatusNotFound, err)
+	return
+}
+
+// ImageTrashList godoc
+//
+//	@Summary		Show List of Trashed Images
+//	@Description	휴지통으로 옮겨진 이미지 목록과 삭제 시각, 유예 상태를 보여줍니다. 풀을 지정하지 않으면 모든 rbd 풀의 휴지통을 보여줍니다.
+//	@Tags			Image
+//	@param			pool_name	query	string	false	"Glue Pool Name"
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{object}	model.ImageTrashList
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/image/trash [get]
+func (c *Controller) ImageTrashList(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	pool_name := ctx.Request.URL.Query().Get("pool_name")
+	pools := []string{pool_name}
+	if pool_name == "" {
+		var err error
+		if pools, err = glue.RbdPool(ctx.Request.Context()); err != nil {
+			utils.FancyHandleError(err)
+			httputil.NewError(ctx, http.StatusInternalServerError, err)
+			return
+		}
+	}
+	dat := make(model.ImageTrashList, 0)
+	for _, pool := range pools {
+		items, err := glue.TrashList(ctx.Request.Context(), pool)
+		if err != nil {
+			utils.FancyHandleError(err)
+			httputil.NewError(ctx, http.StatusInternalServerError, err)
+			return
+		}
+		dat = append(dat, items...)
+	}
+	ctx.IndentedJSON(http.StatusOK, dat)
+}
+
+// ImageTrashRestore godoc
+//
+//	@Summary		Restore of Trashed Image
+//	@Description	휴지통의 이미지를 원래 이름 또는 새 이름으로 복구합니다.
+//	@Tags			Image
+//	@param			pool_name		path		string	true	"Glue Pool Name"
+//	@param			image_id		path		string	true	"Trashed Image ID"
+//	@param			new_image_name	formData	string	false	"Restored Glue Image Name"
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{string}	string	"Success"
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/image/trash/{pool_name}/{image_id}/restore [post]
+func (c *Controller) ImageTrashRestore(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	pool_name := ctx.Param("pool_name")
+	new_image_name, _ := ctx.GetPostForm("new_image_name")
+	entry, ok := trashEntry(ctx)
+	if !ok {
+		return
+	}
+	restore_name := entry.Name
+	if new_image_name != "" {
+		restore_name = new_image_name
+	}
+	if _, err := glue.ImageDetail(ctx.Request.Context(), pool_name, restore_name); err == nil {
+		err = errors.New("image " + pool_name + "/" + restore_name + " already exists")
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+	dat, err := glue.TrashRestore(ctx.Request.Context(), pool_name, entry.Id, new_image_name)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, dat)
+}
+
+// ImageTrashRemove godoc
+//
+//	@Summary		Delete of Trashed Image
+//	@Description	휴지통의 이미지를 영구 삭제합니다. 유예 기간이 남은 이미지는 force 를 지정해야 삭제됩니다.
+//	@Tags			Image
+//	@param			pool_name	path	string	true	"Glue Pool Name"
+//	@param			image_id	path	string	true	"Trashed Image ID"
+//	@param			force		query	bool	false	"Delete Before Deferment Ends"
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{string}	string	"Success"
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/image/trash/{pool_name}/{image_id} [delete]
+func (c *Controller) ImageTrashRemove(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	pool_name := ctx.Param("pool_name")
+	force, _ := strconv.ParseBool(ctx.Request.URL.Query().Get("force"))
+	entry, ok := trashEntry(ctx)
+	if !ok {
+		return
+	}
+	dat, err := glue.TrashRemove(ctx.Request.Context(), pool_name, entry.Id, force)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, dat)
+}
+
+// ImageTrashPurge godoc
+//
+//	@Summary		Purge of Pool Trash
+//	@Description	풀의 휴지통에서 유예 기간이 지난 이미지를 모두 영구 삭제합니다.
+//	@Tags			Image
+//	@param			pool_name	path	string	true	"Glue Pool Name"
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{string}	string	"Success"
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/image/trash/{pool_name} [delete]
+func (c *Controller) ImageTrashPurge(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	pool_name := ctx.Param("pool_name")
+	dat, err := glue.TrashPurge(ctx.Request.Context(), pool_name)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, dat)
+}
+
+// ImageTrashDeferment godoc
+//
+//	@Summary		Show Trash Deferment of Pool
+//	@Description	이미지를 삭제할 때 휴지통에 보관하는 풀의 유예 기간(초)을 보여줍니다.
+//	@Tags			Image
+//	@param			pool_name	path	string	true	"Glue Pool Name"
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{object}	model.ImageTrashDeferment
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/image/trash/{pool_name}/deferment [get]
+func (c *Controller) ImageTrashDeferment(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	pool_name := ctx.Param("pool_name")
+	seconds, err := glue.TrashDeferment(ctx.Request.Context(), pool_name)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, model.ImageTrashDeferment{Pool: pool_name, Seconds: seconds})
+}
+
+// ImageTrashDefermentSet godoc
+//
+//	@Summary		Update Trash Deferment of Pool
+//	@Description	이미지를 삭제할 때 휴지통에 보관하는 풀의 유예 기간(초)을 변경합니다. 이미 휴지통에 있는 이미지에는 적용되지 않습니다.
+//	@Tags			Image
+//	@param			pool_name	path		string	true	"Glue Pool Name"
+//	@param			seconds		formData	int		true	"Trash Deferment Seconds"
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{string}	string	"Success"
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/image/trash/{pool_name}/deferment [put]
+func (c *Controller) ImageTrashDefermentSet(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	pool_name := ctx.Param("pool_name")
+	seconds_str, _ := ctx.GetPostForm("seconds")
+	seconds, err := strconv.Atoi(seconds_str)
+	if err != nil || seconds < 0 {
+		err = errors.New("seconds must be a non-negative number")
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+	dat, err := glue.TrashDefermentSet(ctx.Request.Context(), pool_name, seconds)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, dat)
+}
+
+// ImageTrashScheduleList godoc
+//
+//	@Summary		Show List of Trash Purge Schedules
+//	@Description	유예 기간이 지난 이미지를 주기적으로 비우는 휴지통 자동 비우기 일정을 보여줍니다.
+//	@Tags			Image
+//	@param			pool_name	query	string	false	"Glue Pool Name"
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{object}	[]model.TrashPurgeSchedule
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/image/trash/schedule [get]
+func (c *Controller) ImageTrashScheduleList(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	pool_name := ctx.Request.URL.Query().Get("pool_name")
+	dat, err := glue.TrashScheduleList(ctx.Request.Context(), pool_name)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, dat)
+}
+
+// ImageTrashScheduleAdd godoc
+//
+//	@Summary		Create of Trash Purge Schedule
+//	@Description	휴지통 자동 비우기 일정을 등록합니다. 풀을 지정하지 않으면 모든 풀에 적용됩니다.
+//	@Tags			Image
+//	@param			pool_name	formData	string	false	"Glue Pool Name"
+//	@param			interval	formData	string	true	"Purge Interval (ex. 30m, 12h, 1d)"
+//	@param			start_time	formData	string	false	"Start Time (ISO 8601)"
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{string}	string	"Success"
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/image/trash/schedule [post]
+func (c *Controller) ImageTrashScheduleAdd(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	pool_name, _ := ctx.GetPostForm("pool_name")
+	interval, _ := ctx.GetPostForm("interval")
+	start_time, _ := ctx.GetPostForm("start_time")
+	if !trash_interval.MatchString(interval) {
+		err := errors.New("interval must be a number followed by m, h or d")
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+	dat, err := glue.TrashScheduleAdd(ctx.Request.Context(), pool_name, interval, start_time)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, dat)
+}
+
+// ImageTrashScheduleRemove godoc
+//
+//	@Summary		Delete of Trash Purge Schedule
+//	@Description	휴지통 자동 비우기 일정을 삭제합니다.
+//	@Tags			Image
+//	@param			pool_name	query	string	false	"Glue Pool Name"
+//	@param			interval	query	string	true	"Purge Interval"
+//	@param			start_time	query	string	false	"Start Time"
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{string}	string	"Success"
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/image/trash/schedule [delete]
+func (c *Controller) ImageTrashScheduleRemove(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	pool_name := ctx.Request.URL.Query().Get("pool_name")
+	interval := ctx.Request.URL.Query().Get("interval")
+	start_time := ctx.Request.URL.Query().Get("start_time")
+	if !trash_interval.MatchString(interval) {
+		err := errors.New("interval must be a number followed by m, h or d")
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+	dat, err := glue.TrashScheduleRemove(ctx.Request.Context(), pool_name, interval, start_time)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, dat)
+}
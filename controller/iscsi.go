@@ -9,6 +9,7 @@ import (
 	"bytes"
 	"crypto/tls"
 	"encoding/json"
+	"errors"
 	"io"
 	"net/http"
 	"os"
@@ -871,3 +872,163 @@ func (c *Controller) IscsiTargetPurge(ctx *gin.Context) {
 	}
 	ctx.IndentedJSON(http.StatusOK, dat)
 }
+
+// iscsiQosImages 는 Iscsi 타겟에 디스크로 노출된 이미지를 찾습니다. pool_name, image_name 을 지정하면 해당 디스크만 반환합니다.
+func iscsiQosImages(ctx *gin.Context) (images []model.ImageRef, ok bool) {
+	iqn_id := ctx.Request.URL.Query().Get("iqn_id")
+	pool_name := ctx.Request.URL.Query().Get("pool_name")
+	image_name := ctx.Request.URL.Query().Get("image_name")
+	if iqn_id == "" {
+		err := errors.New("iqn_id is required")
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+	responseBody, err := glueDashboardRequest(http.MethodGet, "api/iscsi/target/"+iqn_id, nil)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	var target struct {
+		Disks []model.Disks `json:"disks"`
+	}
+	if err = json.Unmarshal(responseBody, &target); err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	images = make([]model.ImageRef, 0)
+	for _, disk := range target.Disks {
+		if (pool_name == "" || disk.Pool == pool_name) && (image_name == "" || disk.Image == image_name) {
+			images = append(images, model.ImageRef{Pool: disk.Pool, Image: disk.Image})
+		}
+	}
+	if len(images) == 0 {
+		err = errors.New("no disk of iscsi target " + iqn_id + " matches")
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusNotFound, err)
+		return
+	}
+	return images, true
+}
+
+// IscsiTargetQos godoc
+//
+//	@Summary		Show QoS of Iscsi Target Disks
+//	@Description	Iscsi 타겟의 디스크로 노출된 이미지에 실제로 적용되는 rbd QoS 설정을 보여줍니다.
+//	@Tags			IscsiTarget
+//	@param			iqn_id						query		string	true	"Iscsi Target IQN Name"
+//	@param			pool_name					query		string	false	"Glue Pool Name"
+//	@param			image_name					query		string	false	"Glue Image Name"
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{object}	[]model.ImageQos
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/iscsi/target/qos [get]
+func (c *Controller) IscsiTargetQos(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	images, ok := iscsiQosImages(ctx)
+	if !ok {
+		return
+	}
+	dat, err := imagesQos(ctx.Request.Context(), images)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, dat)
+}
+
+// IscsiTargetQosUpdate godoc
+//
+//	@Summary		Update QoS of Iscsi Target Disks
+//	@Description	Iscsi 타겟의 디스크로 노출된 이미지의 rbd QoS 설정을 변경합니다. 디스크를 지정하지 않으면 타겟의 모든 디스크에 적용합니다.
+//	@Tags			IscsiTarget
+//	@param			iqn_id						query		string	true	"Iscsi Target IQN Name"
+//	@param			pool_name					query		string	false	"Glue Pool Name"
+//	@param			image_name					query		string	false	"Glue Image Name"
+//	@param			iops_limit					formData	int		false	"IOPS Limit"
+//	@param			iops_burst					formData	int		false	"IOPS Burst"
+//	@param			iops_burst_seconds			formData	int		false	"IOPS Burst Seconds"
+//	@param			bps_limit					formData	int		false	"BPS Limit"
+//	@param			bps_burst					formData	int		false	"BPS Burst"
+//	@param			bps_burst_seconds			formData	int		false	"BPS Burst Seconds"
+//	@param			read_iops_limit				formData	int		false	"Read IOPS Limit"
+//	@param			read_iops_burst				formData	int		false	"Read IOPS Burst"
+//	@param			read_iops_burst_seconds		formData	int		false	"Read IOPS Burst Seconds"
+//	@param			write_iops_limit			formData	int		false	"Write IOPS Limit"
+//	@param			write_iops_burst			formData	int		false	"Write IOPS Burst"
+//	@param			write_iops_burst_seconds	formData	int		false	"Write IOPS Burst Seconds"
+//	@param			read_bps_limit				formData	int		false	"Read BPS Limit"
+//	@param			read_bps_burst				formData	int		false	"Read BPS Burst"
+//	@param			read_bps_burst_seconds		formData	int		false	"Read BPS Burst Seconds"
+//	@param			write_bps_limit				formData	int		false	"Write BPS Limit"
+//	@param			write_bps_burst				formData	int		false	"Write BPS Burst"
+//	@param			write_bps_burst_seconds		formData	int		false	"Write BPS Burst Seconds"
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{string}	string	"Success"
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/iscsi/target/qos [put]
+func (c *Controller) IscsiTargetQosUpdate(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	values, ok := qosValues(ctx)
+	if !ok {
+		return
+	}
+	images, ok := iscsiQosImages(ctx)
+	if !ok {
+		return
+	}
+	dat, err := imagesQosSet(ctx.Request.Context(), images, values)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, dat)
+}
+
+// IscsiTargetQosReset godoc
+//
+//	@Summary		Reset QoS of Iscsi Target Disks
+//	@Description	Iscsi 타겟의 디스크로 노출된 이미지에서 이미지 단계로 설정한 rbd QoS 옵션을 지워 풀 또는 전역 설정을 상속하도록 합니다.
+//	@Tags			IscsiTarget
+//	@param			iqn_id						query		string	true	"Iscsi Target IQN Name"
+//	@param			pool_name					query		string	false	"Glue Pool Name"
+//	@param			image_name					query		string	false	"Glue Image Name"
+//	@param			name						query		string	false	"QoS Option Name (ex. iops_limit)"
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{string}	string	"Success"
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/iscsi/target/qos [delete]
+func (c *Controller) IscsiTargetQosReset(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	name, ok := qosName(ctx)
+	if !ok {
+		return
+	}
+	images, ok := iscsiQosImages(ctx)
+	if !ok {
+		return
+	}
+	dat, err := imagesQosRemove(ctx.Request.Context(), images, name)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, dat)
+}
@@ -0,0 +1,173 @@
+package controller
+
+import (
+	"Glue-API/httputil"
+	"Glue-API/model"
+	"Glue-API/utils"
+	"Glue-API/utils/metric"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+func (c *Controller) MetricOption(ctx *gin.Context) {
+	SetOptionHeader(ctx)
+	ctx.IndentedJSON(http.StatusOK, nil)
+}
+
+// Unix 초 또는 RFC3339 형식의 시간을 읽습니다.
+func metricTime(value string, fallback time.Time) (output int64, err error) {
+	if value == "" {
+		output = fallback.Unix()
+		return
+	}
+	if output, err = strconv.ParseInt(value, 10, 64); err == nil {
+		return
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		err = errors.New("invalid time " + value)
+		return
+	}
+	output = t.Unix()
+	return
+}
+
+// 요청의 start, end 쿼리로 조회 구간을 정합니다. 기본값은 최근 1시간입니다.
+func metricRange(ctx *gin.Context) (start int64, end int64, err error) {
+	now := time.Now()
+	if end, err = metricTime(ctx.Request.URL.Query().Get("end"), now); err != nil {
+		return
+	}
+	if start, err = metricTime(ctx.Request.URL.Query().Get("start"), time.Unix(end, 0).Add(-time.Hour)); err != nil {
+		return
+	}
+	if start > end {
+		err = errors.New("start must be before end")
+	}
+	return
+}
+
+// MetricSeries godoc
+//
+//	@Summary		Show Time Series of Glue Status Metrics
+//	@Description	백그라운드에서 수집한 Glue 상태 지표를 조회 구간과 간격에 맞춰 시계열로 보여줍니다. pool_name 을 지정하면 풀 지표를 조회합니다.
+//	@Tags			Metric
+//	@param			metric		query	[]string	true	"Metric Name(health, data_bytes, bytes_used, bytes_avail, bytes_total, read_bytes_sec, write_bytes_sec, read_op_per_sec, write_op_per_sec, num_osds, num_up_osds, num_in_osds / pool: stored, bytes_used, max_avail, percent_used, objects)"	collectionFormat(multi)
+//	@param			pool_name	query	string		false	"Pool Name"
+//	@param			start		query	string		false	"Start Time(Unix Seconds or RFC3339)"
+//	@param			end			query	string		false	"End Time(Unix Seconds or RFC3339)"
+//	@param			step		query	int			false	"Step Seconds"
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{array}		model.MetricSeries
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/metric [get]
+func (c *Controller) MetricSeries(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	metrics := ctx.QueryArray("metric")
+	pool_name := ctx.Request.URL.Query().Get("pool_name")
+	step, _ := strconv.ParseInt(ctx.Request.URL.Query().Get("step"), 10, 64)
+	start, end, err := metricRange(ctx)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+	if len(metrics) == 0 {
+		err = errors.New("metric is required")
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+	samples, err := metric.Query(start, end)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	dat := make([]model.MetricSeries, 0)
+	for _, name := range metrics {
+		series, err := metric.Series(samples, name, pool_name, start, end, step)
+		if err != nil {
+			utils.FancyHandleError(err)
+			httputil.NewError(ctx, http.StatusBadRequest, err)
+			return
+		}
+		dat = append(dat, series)
+	}
+	ctx.IndentedJSON(http.StatusOK, dat)
+}
+
+// MetricSample godoc
+//
+//	@Summary		Show Raw Samples of Glue Status Metrics
+//	@Description	백그라운드에서 수집한 Glue 상태 샘플 원본을 조회 구간에 맞춰 보여줍니다.
+//	@Tags			Metric
+//	@param			start	query	string	false	"Start Time(Unix Seconds or RFC3339)"
+//	@param			end		query	string	false	"End Time(Unix Seconds or RFC3339)"
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{array}		model.MetricSample
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/metric/sample [get]
+func (c *Controller) MetricSample(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	start, end, err := metricRange(ctx)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+	dat, err := metric.Query(start, end)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	if dat == nil {
+		dat = make([]model.MetricSample, 0)
+	}
+	ctx.IndentedJSON(http.StatusOK, dat)
+}
+
+// MetricForecast godoc
+//
+//	@Summary		Show Capacity Forecast of Glue Cluster and Pools
+//	@Description	수집한 사용량 이력으로 클러스터와 각 풀이 nearfull, full 비율에 도달하는 시점을 선형 및 계절성 회귀로 예측합니다. 풀은 복제 수(size) 또는 EC 오버헤드((k+m)/k)를 반영하며, 수집한 샘플과 같이 항상 로컬 클러스터를 대상으로 합니다.
+//	@Tags			Metric
+//	@param			history_days	query	int	false	"History Days Used For Forecast" default(30)
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{object}	model.CapacityForecast
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/metric/forecast [get]
+func (c *Controller) MetricForecast(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	history_days, err := strconv.Atoi(ctx.DefaultQuery("history_days", "30"))
+	if err != nil || history_days <= 0 {
+		err = errors.New("history_days must be a positive number")
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+	dat, err := metric.Forecast(time.Duration(history_days) * 24 * time.Hour)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, dat)
+}
@@ -31,14 +31,14 @@ import (
 //	@Router			/api/v1/mirror/image/{mirrorPool} [get]
 func (c *Controller) MirrorImageList(ctx *gin.Context) {
 	pool := ctx.Param("mirrorPool")
-	mirrorStatus, err := mirror.GetConfigure()
+	mirrorStatus, err := mirror.GetConfigure(ctx.Request.Context())
 	if err != nil {
 		utils.FancyHandleError(err)
 		httputil.NewError(ctx, http.StatusInternalServerError, err)
 		return
 	}
 	if mirrorStatus.Mode != "disabled" {
-		dat, err := mirror.ImageList(pool)
+		dat, err := mirror.ImageList(ctx.Request.Context(), pool)
 		if err != nil {
 			utils.FancyHandleError(err)
 			httputil.NewError(ctx, http.StatusInternalServerError, err)
@@ -68,7 +68,7 @@ func (c *Controller) MirrorImageList(ctx *gin.Context) {
 func (c *Controller) MirrorImageInfo(ctx *gin.Context) {
 	pool := ctx.Param("mirrorPool")
 	image := ctx.Param("imageName")
-	dat2, err := mirror.ImageList(pool)
+	dat2, err := mirror.ImageList(ctx.Request.Context(), pool)
 	var dat model.MirrorListImages
 
 	for _, mirrorImage := range dat2.Images {
@@ -142,6 +142,9 @@ func (c *Controller) MirrorImageInfo(ctx *gin.Context) {
 //	@Failure		500	{object}	httputil.HTTP500InternalServerError
 //	@Router			/api/v1/mirror/image/{mirrorPool}/{imageName} [delete]
 func (c *Controller) MirrorImageScheduleDelete(ctx *gin.Context) {
+	if !localCluster(ctx) {
+		return
+	}
 	image := ctx.Param("imageName")
 	pool := ctx.Param("mirrorPool")
 	var output string
@@ -154,7 +157,7 @@ func (c *Controller) MirrorImageScheduleDelete(ctx *gin.Context) {
 		return
 	}
 
-	output, err = mirror.ImagePreDelete(pool, image)
+	output, err = mirror.ImagePreDelete(ctx.Request.Context(), pool, image)
 
 	if err != nil {
 		if output != "Success" {
@@ -189,7 +192,7 @@ func (c *Controller) MirrorImageScheduleDelete(ctx *gin.Context) {
 //	@Failure		500	{object}	httputil.HTTP500InternalServerError
 //	@Router			/api/v1/mirror [get]
 func (c *Controller) MirrorStatus(ctx *gin.Context) {
-	dat, err := mirror.Status()
+	dat, err := mirror.Status(ctx.Request.Context())
 	if err != nil {
 		httputil.NewError(ctx, http.StatusInternalServerError, err)
 		return
@@ -211,6 +214,9 @@ func (c *Controller) MirrorStatus(ctx *gin.Context) {
 //	@Failure		500	{object}	httputil.HTTP500InternalServerError
 //	@Router			/api/v1/mirror [POST]
 func (c *Controller) MirrorSetup(ctx *gin.Context) {
+	if !localCluster(ctx) {
+		return
+	}
 	var dat model.MirrorSetup
 
 	dat.LocalClusterName, _ = ctx.GetPostForm("localClusterName")
@@ -272,6 +278,9 @@ func (c *Controller) MirrorSetup(ctx *gin.Context) {
 //		@Failure		500	{object}	httputil.HTTP500InternalServerError
 //		@Router			/api/v1/mirror [put]
 func (c *Controller) MirrorUpdate(ctx *gin.Context) {
+	if !localCluster(ctx) {
+		return
+	}
 
 	var mold = model.Mold{}
 
@@ -287,7 +296,7 @@ func (c *Controller) MirrorUpdate(ctx *gin.Context) {
 		return
 	}
 
-	rbd_image, err := mirror.RbdImage("rbd")
+	rbd_image, err := mirror.RbdImage(ctx.Request.Context(), "rbd")
 	if err != nil {
 		utils.FancyHandleError(err)
 		httputil.NewError(ctx, http.StatusInternalServerError, err)
@@ -322,6 +331,9 @@ func (c *Controller) MirrorUpdate(ctx *gin.Context) {
 //	@Failure		500	{object}	httputil.HTTP500InternalServerError
 //	@Router			/api/v1/mirror [delete]
 func (c *Controller) MirrorDelete(ctx *gin.Context) {
+	if !localCluster(ctx) {
+		return
+	}
 	var dat model.MirrorSetup
 	var stdout []byte
 
@@ -345,9 +357,9 @@ func (c *Controller) MirrorDelete(ctx *gin.Context) {
 	}
 
 	// Get Mirroring Images
-	mirrorStatus, err := mirror.GetConfigure()
+	mirrorStatus, err := mirror.GetConfigure(ctx.Request.Context())
 	if mirrorStatus.Mode != "disabled" {
-		MirroredImage, err := mirror.ImageList(dat.MirrorPool)
+		MirroredImage, err := mirror.ImageList(ctx.Request.Context(), dat.MirrorPool)
 		if err != nil {
 			utils.FancyHandleError(err)
 			httputil.NewError(ctx, http.StatusInternalServerError, err)
@@ -358,7 +370,7 @@ func (c *Controller) MirrorDelete(ctx *gin.Context) {
 			if errt != nil {
 				err = errors.Join(err, errt)
 			}
-			output, errt = mirror.ImagePreDelete(dat.MirrorPool, image.Name)
+			output, errt = mirror.ImagePreDelete(ctx.Request.Context(), dat.MirrorPool, image.Name)
 			if errt != nil {
 				if output != "Success" {
 					err = errors.Join(err, errt)
@@ -664,6 +676,9 @@ func (c *Controller) MirrorDelete(ctx *gin.Context) {
 //		@Failure		500	{object}	httputil.HTTP500InternalServerError
 //		@Router			/api/v1/mirror/image/{mirrorPool}/{imageName}/{hostName}/{vmName} [post]
 func (c *Controller) MirrorImageScheduleSetup(ctx *gin.Context) {
+	if !localCluster(ctx) {
+		return
+	}
 	//var dat model.MirrorSetup
 	var dat = struct {
 		Message string
@@ -675,7 +690,7 @@ func (c *Controller) MirrorImageScheduleSetup(ctx *gin.Context) {
 	vmName := ctx.Param("vmName")
 	volType, _ := ctx.GetPostForm("volType")
 
-	message, err := mirror.ImagePreSetup(mirrorPool, imageName)
+	message, err := mirror.ImagePreSetup(ctx.Request.Context(), mirrorPool, imageName)
 	if err != nil {
 		utils.FancyHandleError(err)
 		httputil.NewError(ctx, http.StatusInternalServerError, err)
@@ -693,7 +708,7 @@ func (c *Controller) MirrorImageScheduleSetup(ctx *gin.Context) {
 		interval, err := mirror.ImageMetaGetInterval()
 		if err != nil {
 			mirror.ImageDeleteSchedule(mirrorPool, imageName)
-			mirror.ImagePreDelete(mirrorPool, imageName)
+			mirror.ImagePreDelete(ctx.Request.Context(), mirrorPool, imageName)
 			utils.FancyHandleError(err)
 			httputil.NewError(ctx, http.StatusInternalServerError, err)
 			return
@@ -701,7 +716,7 @@ func (c *Controller) MirrorImageScheduleSetup(ctx *gin.Context) {
 		_, err = mirror.ImageConfigSchedule(mirrorPool, imageName, hostName, vmName, interval)
 		if err != nil {
 			mirror.ImageDeleteSchedule(mirrorPool, imageName)
-			mirror.ImagePreDelete(mirrorPool, imageName)
+			mirror.ImagePreDelete(ctx.Request.Context(), mirrorPool, imageName)
 			utils.FancyHandleError(err)
 			httputil.NewError(ctx, http.StatusInternalServerError, err)
 			return
@@ -731,6 +746,9 @@ func (c *Controller) MirrorImageScheduleSetup(ctx *gin.Context) {
 //		@Failure		500	{object}	httputil.HTTP500InternalServerError
 //		@Router			/api/v1/mirror/image/snapshot/{mirrorPool}/{vmName} [post]
 func (c *Controller) MirrorImageSnap(ctx *gin.Context) {
+	if !localCluster(ctx) {
+		return
+	}
 
 	var dat = struct {
 		Message string
@@ -862,7 +880,7 @@ func (c *Controller) MirrorImageParentInfo(ctx *gin.Context) {
 	mirrorPool := ctx.Param("mirrorPool")
 	imageName := ctx.Param("imageName")
 
-	dat, err := mirror.ImageInfo(mirrorPool, imageName)
+	dat, err := mirror.ImageInfo(ctx.Request.Context(), mirrorPool, imageName)
 	if err != nil {
 		utils.FancyHandleError(err)
 		print(err)
@@ -893,7 +911,7 @@ func (c *Controller) MirrorImageStatus(ctx *gin.Context) {
 	mirrorPool := ctx.Param("mirrorPool")
 	imageName := ctx.Param("imageName")
 
-	dat, err := mirror.ImageStatus(mirrorPool, imageName)
+	dat, err := mirror.ImageStatus(ctx.Request.Context(), mirrorPool, imageName)
 	if err != nil {
 		utils.FancyHandleError(err)
 		print(err)
@@ -927,7 +945,7 @@ func (c *Controller) MirrorImagePromote(ctx *gin.Context) {
 	mirrorPool := ctx.Param("mirrorPool")
 	imageName := ctx.Param("imageName")
 
-	message, err := mirror.ImagePromote(mirrorPool, imageName)
+	message, err := mirror.ImagePromote(ctx.Request.Context(), mirrorPool, imageName)
 	if err != nil {
 		utils.FancyHandleError(err)
 		httputil.NewError(ctx, http.StatusInternalServerError, err)
@@ -953,6 +971,9 @@ func (c *Controller) MirrorImagePromote(ctx *gin.Context) {
 //	@Failure		500	{object}	httputil.HTTP500InternalServerError
 //	@Router			/api/v1/mirror/image/promote/peer/{mirrorPool}/{imageName} [post]
 func (c *Controller) MirrorImagePromotePeer(ctx *gin.Context) {
+	if !localCluster(ctx) {
+		return
+	}
 
 	var dat = struct {
 		Message string
@@ -961,7 +982,7 @@ func (c *Controller) MirrorImagePromotePeer(ctx *gin.Context) {
 	mirrorPool := ctx.Param("mirrorPool")
 	imageName := ctx.Param("imageName")
 
-	message, err := mirror.RemoteImagePromote(mirrorPool, imageName)
+	message, err := mirror.RemoteImagePromote(ctx.Request.Context(), mirrorPool, imageName)
 	if err != nil {
 		utils.FancyHandleError(err)
 		httputil.NewError(ctx, http.StatusInternalServerError, err)
@@ -995,7 +1016,7 @@ func (c *Controller) MirrorImageDemote(ctx *gin.Context) {
 	mirrorPool := ctx.Param("mirrorPool")
 	imageName := ctx.Param("imageName")
 
-	message, err := mirror.ImageDemote(mirrorPool, imageName)
+	message, err := mirror.ImageDemote(ctx.Request.Context(), mirrorPool, imageName)
 	if err != nil {
 		utils.FancyHandleError(err)
 		httputil.NewError(ctx, http.StatusInternalServerError, err)
@@ -1021,6 +1042,9 @@ func (c *Controller) MirrorImageDemote(ctx *gin.Context) {
 //	@Failure		500	{object}	httputil.HTTP500InternalServerError
 //	@Router			/api/v1/mirror/image/demote/{mirrorPool}/{imageName} [delete]
 func (c *Controller) MirrorImageDemotePeer(ctx *gin.Context) {
+	if !localCluster(ctx) {
+		return
+	}
 
 	var dat = struct {
 		Message string
@@ -1029,7 +1053,7 @@ func (c *Controller) MirrorImageDemotePeer(ctx *gin.Context) {
 	mirrorPool := ctx.Param("mirrorPool")
 	imageName := ctx.Param("imageName")
 
-	message, err := mirror.RemoteImageDemote(mirrorPool, imageName)
+	message, err := mirror.RemoteImageDemote(ctx.Request.Context(), mirrorPool, imageName)
 	if err != nil {
 		utils.FancyHandleError(err)
 		httputil.NewError(ctx, http.StatusInternalServerError, err)
@@ -1063,7 +1087,7 @@ func (c *Controller) MirrorImageResync(ctx *gin.Context) {
 	mirrorPool := ctx.Param("mirrorPool")
 	imageName := ctx.Param("imageName")
 
-	message, err := mirror.ImageResync(mirrorPool, imageName)
+	message, err := mirror.ImageResync(ctx.Request.Context(), mirrorPool, imageName)
 	if err != nil {
 		utils.FancyHandleError(err)
 		httputil.NewError(ctx, http.StatusInternalServerError, err)
@@ -1089,6 +1113,9 @@ func (c *Controller) MirrorImageResync(ctx *gin.Context) {
 //	@Failure		500	{object}	httputil.HTTP500InternalServerError
 //	@Router			/api/v1/mirror/image/resync/peer/{mirrorPool}/{imageName} [put]
 func (c *Controller) MirrorImageResyncPeer(ctx *gin.Context) {
+	if !localCluster(ctx) {
+		return
+	}
 
 	var dat = struct {
 		Message string
@@ -1097,7 +1124,7 @@ func (c *Controller) MirrorImageResyncPeer(ctx *gin.Context) {
 	mirrorPool := ctx.Param("mirrorPool")
 	imageName := ctx.Param("imageName")
 
-	message, err := mirror.RemoteImageResync(mirrorPool, imageName)
+	message, err := mirror.RemoteImageResync(ctx.Request.Context(), mirrorPool, imageName)
 	if err != nil {
 		utils.FancyHandleError(err)
 		httputil.NewError(ctx, http.StatusInternalServerError, err)
@@ -1124,6 +1151,9 @@ func (c *Controller) MirrorImageResyncPeer(ctx *gin.Context) {
 //	@Failure		500	{object}	httputil.HTTP500InternalServerError
 //	@Router			/api/v1/mirror/pool/{mirrorPool} [POST]
 func (c *Controller) MirrorPoolEnable(ctx *gin.Context) {
+	if !localCluster(ctx) {
+		return
+	}
 
 	var dat model.MirrorSetup
 
@@ -1175,6 +1205,9 @@ func (c *Controller) MirrorPoolEnable(ctx *gin.Context) {
 //	@Failure		500	{object}	httputil.HTTP500InternalServerError
 //	@Router			/api/v1/mirror/pool/{mirrorPool} [delete]
 func (c *Controller) MirrorPoolDisable(ctx *gin.Context) {
+	if !localCluster(ctx) {
+		return
+	}
 
 	var dat model.MirrorSetup
 	var EncodedLocalToken string
@@ -1201,7 +1234,7 @@ func (c *Controller) MirrorPoolDisable(ctx *gin.Context) {
 	}
 
 	// Get Mirroring Images
-	MirroredImage, err := mirror.ImageList(dat.MirrorPool)
+	MirroredImage, err := mirror.ImageList(ctx.Request.Context(), dat.MirrorPool)
 	if err != nil {
 		utils.FancyHandleError(err)
 		httputil.NewError(ctx, http.StatusInternalServerError, err)
@@ -1212,7 +1245,7 @@ func (c *Controller) MirrorPoolDisable(ctx *gin.Context) {
 		if errt != nil {
 			err = errors.Join(err, errt)
 		}
-		output, errt = mirror.ImagePreDelete(dat.MirrorPool, image.Name)
+		output, errt = mirror.ImagePreDelete(ctx.Request.Context(), dat.MirrorPool, image.Name)
 		if errt != nil {
 			if output != "Success" {
 				err = errors.Join(err, errt)
@@ -1226,7 +1259,7 @@ func (c *Controller) MirrorPoolDisable(ctx *gin.Context) {
 	}
 
 	//remote local peer
-	mirrorStatus, err := mirror.GetConfigure()
+	mirrorStatus, err := mirror.GetConfigure(ctx.Request.Context())
 
 	if len(mirrorStatus.Peers) > 0 {
 		peerUUID := mirrorStatus.Peers[0].Uuid
@@ -1327,12 +1360,15 @@ func (c *Controller) MirrorPoolDisable(ctx *gin.Context) {
 //	@Failure		500	{object}	httputil.HTTP500InternalServerError
 //	@Router			/api/v1/mirror/garbage [delete]
 func (c *Controller) MirrorDeleteGarbage(ctx *gin.Context) {
+	if !localCluster(ctx) {
+		return
+	}
 
 	var stdout []byte
 	var out strings.Builder
 
 	mirrorPool := ctx.Param("mirrorPool")
-	mirrorStatus, err := mirror.GetConfigure()
+	mirrorStatus, err := mirror.GetConfigure(ctx.Request.Context())
 
 	// Mirror Peer Remove
 	if len(mirrorStatus.Peers) > 0 {
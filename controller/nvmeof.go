@@ -6,6 +6,7 @@ import (
 	"Glue-API/utils"
 	"Glue-API/utils/glue"
 	"Glue-API/utils/nvmeof"
+	"errors"
 	"net/http"
 	"os"
 	"strconv"
@@ -125,6 +126,62 @@ func (c *Controller) NvmeOfImageDownload(ctx *gin.Context) {
 	ctx.IndentedJSON(http.StatusOK, dat)
 }
 
+// NvmeOfImage godoc
+//
+//	@Summary		Show NVMe-OF Images
+//	@Description	NVMe-OF CLI 명령에 사용하는 이미지와 게이트웨이 이미지를 보여줍니다.
+//	@Tags			NVMe-OF
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{object}	model.NvmeOfImage
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/nvmeof/image [get]
+func (c *Controller) NvmeOfImage(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	dat, err := nvmeof.Image()
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, dat)
+}
+
+// NvmeOfImageUpdate godoc
+//
+//	@Summary		Update of NVMe-OF CLI Image
+//	@Description	Glue 업그레이드 후 게이트웨이 버전에 맞춰 NVMe-OF CLI 이미지를 변경합니다. 변경 후 이미지 다운로드를 다시 실행해야 합니다.
+//	@param			image	formData	string	true	"NVMe-OF CLI Image(quay.io/ceph/nvmeof-cli:x.y.z)"
+//	@Tags			NVMe-OF
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{string}	string	"Success"
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/nvmeof/image [put]
+func (c *Controller) NvmeOfImageUpdate(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	image, _ := ctx.GetPostForm("image")
+	if image == "" || !strings.Contains(image, ":") {
+		err := errors.New("image with tag is required")
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+	dat, err := nvmeof.ImageUpdate(image)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, dat)
+}
+
 // // NvmeOfTargetCreate godoc
 // //
 // //	@Summary		Create of NVMe-OF Target
@@ -266,7 +323,7 @@ func (c *Controller) NvmeOfTargetCreate(ctx *gin.Context) {
 			return
 		}
 		if size != "0" {
-			image, _ := glue.ListAndInfoImage(image_name, pool_name)
+			image, _ := glue.ListAndInfoImage(ctx.Request.Context(), image_name, pool_name)
 			if image != nil {
 				ctx.IndentedJSON(http.StatusOK, "The Image Name exists. Please Check.")
 			} else {
@@ -295,7 +352,7 @@ func (c *Controller) NvmeOfTargetCreate(ctx *gin.Context) {
 							httputil.NewError(ctx, http.StatusInternalServerError, err)
 							return
 						} else {
-							_, err = glue.CreateImage(image_name, pool_name, size)
+							_, err = glue.CreateImage(ctx.Request.Context(), image_name, pool_name, size)
 							if err != nil {
 								utils.FancyHandleError(err)
 								httputil.NewError(ctx, http.StatusInternalServerError, err)
@@ -591,7 +648,7 @@ func (c *Controller) NvmeOfNameSpaceCreate(ctx *gin.Context) {
 	if server_gateway_ip == "not" {
 		ctx.IndentedJSON(http.StatusOK, make([]string, 0))
 	} else {
-		_, err = glue.CreateImage(image_name, pool_name, size)
+		_, err = glue.CreateImage(ctx.Request.Context(), image_name, pool_name, size)
 		if err != nil {
 			utils.FancyHandleError(err)
 			httputil.NewError(ctx, http.StatusInternalServerError, err)
@@ -653,7 +710,7 @@ func (c *Controller) NvmeOfNameSpaceDelete(ctx *gin.Context) {
 					httputil.NewError(ctx, http.StatusInternalServerError, err)
 					return
 				} else {
-					_, err = glue.DeleteImage(image_name, pool_name)
+					_, err = glue.DeleteImage(ctx.Request.Context(), image_name, pool_name)
 					if err != nil {
 						utils.FancyHandleError(err)
 						httputil.NewError(ctx, http.StatusInternalServerError, err)
@@ -738,3 +795,163 @@ func (c *Controller) NvmeOfTargetList(ctx *gin.Context) {
 		ctx.IndentedJSON(http.StatusOK, list)
 	}
 }
+
+// nvmeofQosImages 는 NVMe-OF 서브시스템의 네임스페이스로 노출된 이미지를 찾습니다. namespace_uuid 를 지정하면 해당 네임스페이스만 반환합니다.
+func nvmeofQosImages(ctx *gin.Context) (images []model.ImageRef, ok bool) {
+	subsystem_nqn_id := ctx.Request.URL.Query().Get("subsystem_nqn_id")
+	namespace_uuid := ctx.Request.URL.Query().Get("namespace_uuid")
+	if subsystem_nqn_id == "" {
+		err := errors.New("subsystem_nqn_id is required")
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+	server_gateway_ip, port, err := NvmeOfServerIPandPort()
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	if server_gateway_ip == "not" {
+		err = errors.New("nvmeof gateway is not found")
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusNotFound, err)
+		return
+	}
+	dat, err := nvmeof.NvmeOfNameSpaceList(server_gateway_ip, server_gateway_ip, port, subsystem_nqn_id)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	images = make([]model.ImageRef, 0)
+	for _, namespace := range dat.Namespaces {
+		if namespace_uuid == "" || namespace.UUID == namespace_uuid {
+			images = append(images, model.ImageRef{Pool: namespace.RbdPoolName, Image: namespace.RbdImageName})
+		}
+	}
+	if len(images) == 0 {
+		err = errors.New("no namespace of subsystem " + subsystem_nqn_id + " matches")
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusNotFound, err)
+		return
+	}
+	return images, true
+}
+
+// NvmeOfNameSpaceQos godoc
+//
+//	@Summary		Show QoS of NVMe-OF NameSpaces
+//	@Description	NVMe-OF 네임스페이스로 노출된 이미지에 실제로 적용되는 rbd QoS 설정을 보여줍니다.
+//	@param			subsystem_nqn_id			query		string	true	"Glue NVMe-OF Sub System NQN ID"
+//	@param			namespace_uuid				query		string	false	"Glue NVMe-OF NameSpace UUID"
+//	@Tags			NVMe-OF-NameSpace
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{object}	[]model.ImageQos
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/nvmeof/namespace/qos [get]
+func (c *Controller) NvmeOfNameSpaceQos(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	images, ok := nvmeofQosImages(ctx)
+	if !ok {
+		return
+	}
+	dat, err := imagesQos(ctx.Request.Context(), images)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, dat)
+}
+
+// NvmeOfNameSpaceQosUpdate godoc
+//
+//	@Summary		Update QoS of NVMe-OF NameSpaces
+//	@Description	NVMe-OF 네임스페이스로 노출된 이미지의 rbd QoS 설정을 변경합니다. 네임스페이스를 지정하지 않으면 서브시스템의 모든 네임스페이스에 적용합니다.
+//	@param			subsystem_nqn_id			query		string	true	"Glue NVMe-OF Sub System NQN ID"
+//	@param			namespace_uuid				query		string	false	"Glue NVMe-OF NameSpace UUID"
+//	@param			iops_limit					formData	int		false	"IOPS Limit"
+//	@param			iops_burst					formData	int		false	"IOPS Burst"
+//	@param			iops_burst_seconds			formData	int		false	"IOPS Burst Seconds"
+//	@param			bps_limit					formData	int		false	"BPS Limit"
+//	@param			bps_burst					formData	int		false	"BPS Burst"
+//	@param			bps_burst_seconds			formData	int		false	"BPS Burst Seconds"
+//	@param			read_iops_limit				formData	int		false	"Read IOPS Limit"
+//	@param			read_iops_burst				formData	int		false	"Read IOPS Burst"
+//	@param			read_iops_burst_seconds		formData	int		false	"Read IOPS Burst Seconds"
+//	@param			write_iops_limit			formData	int		false	"Write IOPS Limit"
+//	@param			write_iops_burst			formData	int		false	"Write IOPS Burst"
+//	@param			write_iops_burst_seconds	formData	int		false	"Write IOPS Burst Seconds"
+//	@param			read_bps_limit				formData	int		false	"Read BPS Limit"
+//	@param			read_bps_burst				formData	int		false	"Read BPS Burst"
+//	@param			read_bps_burst_seconds		formData	int		false	"Read BPS Burst Seconds"
+//	@param			write_bps_limit				formData	int		false	"Write BPS Limit"
+//	@param			write_bps_burst				formData	int		false	"Write BPS Burst"
+//	@param			write_bps_burst_seconds		formData	int		false	"Write BPS Burst Seconds"
+//	@Tags			NVMe-OF-NameSpace
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{string}	string	"Success"
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/nvmeof/namespace/qos [put]
+func (c *Controller) NvmeOfNameSpaceQosUpdate(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	values, ok := qosValues(ctx)
+	if !ok {
+		return
+	}
+	images, ok := nvmeofQosImages(ctx)
+	if !ok {
+		return
+	}
+	dat, err := imagesQosSet(ctx.Request.Context(), images, values)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, dat)
+}
+
+// NvmeOfNameSpaceQosReset godoc
+//
+//	@Summary		Reset QoS of NVMe-OF NameSpaces
+//	@Description	NVMe-OF 네임스페이스로 노출된 이미지에서 이미지 단계로 설정한 rbd QoS 옵션을 지워 풀 또는 전역 설정을 상속하도록 합니다.
+//	@param			subsystem_nqn_id			query		string	true	"Glue NVMe-OF Sub System NQN ID"
+//	@param			namespace_uuid				query		string	false	"Glue NVMe-OF NameSpace UUID"
+//	@param			name						query		string	false	"QoS Option Name (ex. iops_limit)"
+//	@Tags			NVMe-OF-NameSpace
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{string}	string	"Success"
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/nvmeof/namespace/qos [delete]
+func (c *Controller) NvmeOfNameSpaceQosReset(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	name, ok := qosName(ctx)
+	if !ok {
+		return
+	}
+	images, ok := nvmeofQosImages(ctx)
+	if !ok {
+		return
+	}
+	dat, err := imagesQosRemove(ctx.Request.Context(), images, name)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, dat)
+}
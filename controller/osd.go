@@ -0,0 +1,530 @@
+package controller
+
+import (
+	"Glue-API/httputil"
+	"Glue-API/model"
+	"Glue-API/utils"
+	"Glue-API/utils/osd"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+func (c *Controller) OsdOption(ctx *gin.Context) {
+	SetOptionHeader(ctx)
+	ctx.IndentedJSON(http.StatusOK, nil)
+}
+
+func osdId(ctx *gin.Context) (osd_id int, err error) {
+	if osd_id, err = strconv.Atoi(ctx.Param("osd_id")); err != nil || osd_id < 0 {
+		err = errors.New("osd_id must be a number")
+	}
+	return
+}
+
+// OsdList godoc
+//
+//	@Summary		Show List or Info of OSDs
+//	@Description	Glue OSD 목록 또는 상세정보(호스트, 디바이스, 클래스, 사용률, 상태)를 보여줍니다.
+//	@Tags			Osd
+//	@param			osd_id	query	int	false	"OSD ID"
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{array}		model.Osd
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/osd [get]
+func (c *Controller) OsdList(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	osd_id := ctx.Request.URL.Query().Get("osd_id")
+	dat, err := osd.List()
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	if osd_id != "" {
+		for _, item := range dat {
+			if strconv.Itoa(item.Id) == osd_id {
+				ctx.IndentedJSON(http.StatusOK, item)
+				return
+			}
+		}
+		err = errors.New("osd " + osd_id + " is not found")
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusNotFound, err)
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, dat)
+}
+
+// OsdMark godoc
+//
+//	@Summary		Mark OSD In or Out
+//	@Description	Glue OSD 를 out 으로 표시하여 데이터를 다른 OSD 로 옮기거나 다시 in 으로 표시합니다.
+//	@Tags			Osd
+//	@param			osd_id	path		int		true	"OSD ID"
+//	@param			state	formData	string	true	"OSD State"	Enums(in, out)
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{string}	string	"Success"
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/osd/{osd_id} [put]
+func (c *Controller) OsdMark(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	osd_id, err := osdId(ctx)
+	state, _ := ctx.GetPostForm("state")
+	if err == nil && state != "in" && state != "out" {
+		err = errors.New("state must be in or out")
+	}
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+	output, err := osd.Mark(osd_id, state)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, output)
+}
+
+// OsdSafety godoc
+//
+//	@Summary		Show Safety of Stopping or Destroying OSD
+//	@Description	ceph osd ok-to-stop, safe-to-destroy 결과로 OSD 를 중지하거나 제거해도 데이터 가용성과 내구성이 유지되는지 보여줍니다.
+//	@Tags			Osd
+//	@param			osd_id	path	int	true	"OSD ID"
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{object}	model.OsdSafety
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/osd/{osd_id}/safety [get]
+func (c *Controller) OsdSafety(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	osd_id, err := osdId(ctx)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+	dat, err := osd.Safety(osd_id)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, dat)
+}
+
+// OsdFlagList godoc
+//
+//	@Summary		Show List of OSD Flags
+//	@Description	noout, norebalance 등 클러스터 OSD 플래그의 설정 상태를 보여줍니다.
+//	@Tags			Osd
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{array}		model.OsdFlag
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/osd/flag [get]
+func (c *Controller) OsdFlagList(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	dat, err := osd.FlagList()
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, dat)
+}
+
+// OsdFlagSet godoc
+//
+//	@Summary		Set or Unset OSD Flag
+//	@Description	클러스터 OSD 플래그를 설정하거나 해제합니다. 유지보수 중에는 noout, norebalance 를 설정하여 불필요한 데이터 이동을 막습니다.
+//	@Tags			Osd
+//	@param			flag	formData	string	true	"OSD Flag"	Enums(noout, norebalance, nobackfill, norecover, noscrub, nodeep-scrub, noup, nodown, noin)
+//	@param			enabled	formData	bool	true	"Set(true) or Unset(false)"
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{string}	string	"Success"
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/osd/flag [put]
+func (c *Controller) OsdFlagSet(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	flag, _ := ctx.GetPostForm("flag")
+	value, _ := ctx.GetPostForm("enabled")
+	enabled, err := strconv.ParseBool(value)
+	if err != nil {
+		err = errors.New("enabled must be true or false")
+	} else {
+		err = errors.New("invalid flag " + flag)
+		for _, allowed := range osd.Flags {
+			if flag == allowed {
+				err = nil
+			}
+		}
+	}
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+	output, err := osd.FlagSet(flag, enabled)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, output)
+}
+
+// OsdRemove godoc
+//
+//	@Summary		Remove or Replace OSD
+//	@Description	ceph orch osd rm 으로 OSD 의 PG 를 비운 뒤 제거합니다. ok-to-stop 또는 safe-to-destroy 검사를 통과하지 못하면 제거하지 않으므로, 사용 중인 OSD 는 먼저 out 으로 표시하고 데이터 이동이 끝난 뒤 제거합니다. 진행 상황은 /api/v1/osd/remove 에서 확인합니다.
+//	@Tags			Osd
+//	@param			osd_id	path	int		true	"OSD ID"
+//	@param			replace	query	bool	false	"Keep OSD ID For Replacement Disk"
+//	@param			zap		query	bool	false	"Zap Device After Removal"
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{string}	string	"Success"
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/osd/{osd_id} [delete]
+func (c *Controller) OsdRemove(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	osd_id, err := osdId(ctx)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+	replace, _ := strconv.ParseBool(ctx.Request.URL.Query().Get("replace"))
+	zap, _ := strconv.ParseBool(ctx.Request.URL.Query().Get("zap"))
+
+	safety, err := osd.Safety(osd_id)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	if !safety.OkToStop {
+		err = errors.New("osd " + strconv.Itoa(osd_id) + " is not ok to stop: " + safety.OkToStopMessage)
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+	if !safety.SafeToDestroy {
+		err = errors.New("osd " + strconv.Itoa(osd_id) + " is not safe to destroy: " + safety.SafeToDestroyMessage)
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+	output, err := osd.Remove(osd_id, replace, zap)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, output)
+}
+
+// OsdRemoveStatus godoc
+//
+//	@Summary		Show Progress of OSD Removal
+//	@Description	제거 중인 OSD 의 PG 비우기 진행 상황과 남은 PG 수를 보여줍니다.
+//	@Tags			Osd
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{array}		model.OsdRmStatus
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/osd/remove [get]
+func (c *Controller) OsdRemoveStatus(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	dat, err := osd.RemoveStatus()
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	if len(dat) > 0 {
+		df, err := osd.Df()
+		if err != nil {
+			utils.FancyHandleError(err)
+			httputil.NewError(ctx, http.StatusInternalServerError, err)
+			return
+		}
+		for i := range dat {
+			for _, node := range df.Nodes {
+				if node.Id == dat[i].OsdId {
+					dat[i].Pgs = node.Pgs
+				}
+			}
+		}
+	}
+	ctx.IndentedJSON(http.StatusOK, dat)
+}
+
+// OsdRemoveStop godoc
+//
+//	@Summary		Stop OSD Removal
+//	@Description	진행 중인 OSD 제거를 중단합니다.
+//	@Tags			Osd
+//	@param			osd_id	path	int	true	"OSD ID"
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{string}	string	"Success"
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/osd/remove/{osd_id} [delete]
+func (c *Controller) OsdRemoveStop(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	osd_id, err := osdId(ctx)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+	output, err := osd.RemoveStop(osd_id)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, output)
+}
+
+// DeviceList godoc
+//
+//	@Summary		Show List of Disk Devices
+//	@Description	호스트의 디스크 목록(크기, 종류, 회전 여부, 상태)과 OSD 로 사용할 수 없는 이유를 보여줍니다.
+//	@Tags			Osd
+//	@param			hostname	query	string	false	"Host Name"
+//	@param			available	query	bool	false	"Show Only Available Devices"	default(false)
+//	@param			refresh		query	bool	false	"Refresh Device Inventory"	default(false)
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{object}	model.DeviceList
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/osd/device [get]
+func (c *Controller) DeviceList(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	hostname := ctx.Request.URL.Query().Get("hostname")
+	available, _ := strconv.ParseBool(ctx.Request.URL.Query().Get("available"))
+	refresh, _ := strconv.ParseBool(ctx.Request.URL.Query().Get("refresh"))
+	devices, err := osd.DeviceList(hostname, refresh)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	dat := make(model.DeviceList, 0)
+	for _, device := range devices {
+		if !available || device.Available {
+			dat = append(dat, device)
+		}
+	}
+	ctx.IndentedJSON(http.StatusOK, dat)
+}
+
+// 지정한 디스크가 모두 호스트에 있고 OSD 로 사용할 수 있는지 확인합니다.
+func osdDeviceCheck(hostname string, paths []string) (err error) {
+	devices, err := osd.DeviceList(hostname, false)
+	if err != nil {
+		return
+	}
+	for _, path := range paths {
+		found := false
+		for _, device := range devices {
+			if device.Hostname != hostname || device.Path != path {
+				continue
+			}
+			found = true
+			if !device.Available {
+				return errors.New("device " + hostname + ":" + path + " is not available: " + strings.Join(device.RejectedReasons, ", "))
+			}
+		}
+		if !found {
+			return errors.New("device " + hostname + ":" + path + " is not found")
+		}
+	}
+	return nil
+}
+
+// OsdCreate godoc
+//
+//	@Summary		Create of OSDs On Devices
+//	@Description	호스트에서 선택한 디스크에 OSD 를 생성합니다. db_devices, wal_devices 를 지정하면 BlueStore DB/WAL 을 NVMe 등 빠른 디스크에 둡니다.
+//	@Tags			Osd
+//	@param			hostname		formData	string		true	"Host Name"
+//	@param			data_devices	formData	[]string	true	"Data Device Paths"	collectionFormat(multi)
+//	@param			db_devices		formData	[]string	false	"DB Device Paths"	collectionFormat(multi)
+//	@param			wal_devices		formData	[]string	false	"WAL Device Paths"	collectionFormat(multi)
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{string}	string	"Success"
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/osd [post]
+func (c *Controller) OsdCreate(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	hostname, _ := ctx.GetPostForm("hostname")
+	data_devices, _ := ctx.GetPostFormArray("data_devices")
+	db_devices, _ := ctx.GetPostFormArray("db_devices")
+	wal_devices, _ := ctx.GetPostFormArray("wal_devices")
+	if hostname == "" || len(data_devices) == 0 {
+		err := errors.New("hostname and data_devices are required")
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+	paths := append(append(append([]string{}, data_devices...), db_devices...), wal_devices...)
+	if err := osdDeviceCheck(hostname, paths); err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+	output, err := osd.Create(hostname, data_devices, db_devices, wal_devices)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, output)
+}
+
+// 디스크 종류를 OSD 서비스 명세의 디스크 선택 조건으로 바꿉니다.
+func osdDeviceSelection(paths []string, device_type string) (dat *model.OsdDeviceSelection, err error) {
+	rotational := 0
+	switch {
+	case len(paths) > 0:
+		return &model.OsdDeviceSelection{Paths: paths}, nil
+	case device_type == "":
+		return nil, nil
+	case device_type == "all":
+		return &model.OsdDeviceSelection{All: true}, nil
+	case device_type == "hdd":
+		rotational = 1
+	case device_type != "ssd":
+		return nil, errors.New("device type must be all, hdd or ssd")
+	}
+	return &model.OsdDeviceSelection{Rotational: &rotational}, nil
+}
+
+// OsdSpecApply godoc
+//
+//	@Summary		Apply of OSD Service Spec
+//	@Description	OSD 서비스 명세로 호스트의 디스크에 OSD 를 생성합니다. 경로 또는 디스크 종류로 데이터와 DB/WAL 디스크를 선택하며, dry_run 이면 생성될 OSD 미리보기만 보여줍니다.
+//	@Tags			Osd
+//	@param			service_id		formData	string		true	"OSD Service ID"
+//	@param			hosts			formData	[]string	false	"Placement Hosts"	collectionFormat(multi)
+//	@param			label			formData	string		false	"Placement Host Label"
+//	@param			data_devices	formData	[]string	false	"Data Device Paths"	collectionFormat(multi)
+//	@param			data_type		formData	string		false	"Data Device Type"	Enums(all, hdd, ssd)	default(all)
+//	@param			db_devices		formData	[]string	false	"DB Device Paths"	collectionFormat(multi)
+//	@param			db_type			formData	string		false	"DB Device Type"	Enums(hdd, ssd)
+//	@param			wal_devices		formData	[]string	false	"WAL Device Paths"	collectionFormat(multi)
+//	@param			wal_type		formData	string		false	"WAL Device Type"	Enums(hdd, ssd)
+//	@param			encrypted		formData	bool		false	"Encrypt OSDs"	default(false)
+//	@param			osds_per_device	formData	int			false	"OSDs Per Device"	default(1)
+//	@param			dry_run			formData	bool		false	"Only Preview OSDs"	default(false)
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{string}	string	"Success"
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/osd/spec [post]
+func (c *Controller) OsdSpecApply(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	service_id, _ := ctx.GetPostForm("service_id")
+	hosts, _ := ctx.GetPostFormArray("hosts")
+	label, _ := ctx.GetPostForm("label")
+	data_devices, _ := ctx.GetPostFormArray("data_devices")
+	db_devices, _ := ctx.GetPostFormArray("db_devices")
+	wal_devices, _ := ctx.GetPostFormArray("wal_devices")
+	encrypted, _ := strconv.ParseBool(ctx.PostForm("encrypted"))
+	dry_run, _ := strconv.ParseBool(ctx.PostForm("dry_run"))
+	osds_per_device, err := strconv.Atoi(ctx.DefaultPostForm("osds_per_device", "1"))
+	if err != nil || osds_per_device < 1 {
+		err = errors.New("osds_per_device must be a positive number")
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+	if service_id == "" || (len(hosts) == 0 && label == "") {
+		err = errors.New("service_id and hosts or label are required")
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	dat := model.OsdSpec{
+		ServiceType: "osd",
+		ServiceId:   service_id,
+		Placement:   model.OsdPlacement{Hosts: hosts, Label: label},
+		Spec:        model.OsdSpecDetail{Encrypted: encrypted},
+	}
+	if osds_per_device > 1 {
+		dat.Spec.OsdsPerDevice = osds_per_device
+	}
+	// data_type 을 빈 값으로 보내도 데이터 디스크는 반드시 선택해야 하므로 all 로 봅니다.
+	data_type := ctx.PostForm("data_type")
+	if data_type == "" {
+		data_type = "all"
+	}
+	data, err := osdDeviceSelection(data_devices, data_type)
+	if err == nil {
+		dat.Spec.DataDevices = *data
+		dat.Spec.DbDevices, err = osdDeviceSelection(db_devices, ctx.PostForm("db_type"))
+	}
+	if err == nil {
+		dat.Spec.WalDevices, err = osdDeviceSelection(wal_devices, ctx.PostForm("wal_type"))
+	}
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+	output, err := osd.SpecApply(dat, dry_run)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, output)
+}
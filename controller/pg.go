@@ -0,0 +1,245 @@
+package controller
+
+import (
+	"Glue-API/httputil"
+	"Glue-API/model"
+	"Glue-API/utils"
+	"Glue-API/utils/pg"
+	"errors"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+var pg_id_format = regexp.MustCompile(`^[0-9]+\.[0-9a-f]+$`)
+
+// 이 상태들로만 이루어진 PG 는 정상으로 봅니다.
+var pg_healthy_states = []string{"active", "clean", "scrubbing", "deep", "snaptrim", "snaptrim_wait"}
+
+func (c *Controller) PgOption(ctx *gin.Context) {
+	SetOptionHeader(ctx)
+	ctx.IndentedJSON(http.StatusOK, nil)
+}
+
+func pgHealthy(state string) bool {
+	for _, item := range strings.Split(state, "+") {
+		healthy := false
+		for _, healthy_state := range pg_healthy_states {
+			if item == healthy_state {
+				healthy = true
+			}
+		}
+		if !healthy {
+			return false
+		}
+	}
+	return true
+}
+
+func pgScrubAction(action string) (err error) {
+	if action != "scrub" && action != "deep-scrub" && action != "repair" {
+		err = errors.New("action must be scrub, deep-scrub or repair")
+	}
+	return
+}
+
+// PgList godoc
+//
+//	@Summary		Show List of Placement Groups
+//	@Description	PG 목록과 acting set, 풀, 객체 상태를 보여줍니다. state 를 지정하지 않으면 active+clean 이 아닌 PG 만 보여줍니다.
+//	@Tags			Pg
+//	@param			pool	query	string		false	"Pool Name"
+//	@param			state	query	[]string	false	"PG States(inconsistent, degraded, undersized, ...)"	collectionFormat(multi)
+//	@param			all		query	bool		false	"Show All PGs"	default(false)
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{object}	model.PgList
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/pg [get]
+func (c *Controller) PgList(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	pool_name := ctx.Request.URL.Query().Get("pool")
+	states := ctx.QueryArray("state")
+	all, _ := strconv.ParseBool(ctx.Request.URL.Query().Get("all"))
+	pgs, err := pg.List(pool_name, states)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	dat := make(model.PgList, 0)
+	for _, item := range pgs {
+		if all || len(states) > 0 || !pgHealthy(item.State) {
+			dat = append(dat, item)
+		}
+	}
+	ctx.IndentedJSON(http.StatusOK, dat)
+}
+
+// PgStuckList godoc
+//
+//	@Summary		Show List of Stuck Placement Groups
+//	@Description	inactive, unclean, stale, undersized, degraded 상태로 멈춰 있는 PG 목록을 보여줍니다. type 을 지정하지 않으면 모든 상태를 조회합니다.
+//	@Tags			Pg
+//	@param			type	query	string	false	"Stuck Type"	Enums(inactive, unclean, stale, undersized, degraded)
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{object}	model.PgList
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/pg/stuck [get]
+func (c *Controller) PgStuckList(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	stuck_type := ctx.Request.URL.Query().Get("type")
+	types := pg.StuckTypes
+	if stuck_type != "" {
+		valid := false
+		for _, item := range pg.StuckTypes {
+			if item == stuck_type {
+				valid = true
+			}
+		}
+		if !valid {
+			err := errors.New("type must be one of " + strings.Join(pg.StuckTypes, ", "))
+			utils.FancyHandleError(err)
+			httputil.NewError(ctx, http.StatusBadRequest, err)
+			return
+		}
+		types = []string{stuck_type}
+	}
+	// 여러 상태로 멈춘 PG 는 하나로 합치고 멈춘 상태를 모두 표시합니다.
+	stuck := make(map[string]*model.Pg)
+	for _, item := range types {
+		pgs, err := pg.Stuck(item)
+		if err != nil {
+			utils.FancyHandleError(err)
+			httputil.NewError(ctx, http.StatusInternalServerError, err)
+			return
+		}
+		for i := range pgs {
+			if _, ok := stuck[pgs[i].PgId]; !ok {
+				stuck[pgs[i].PgId] = &pgs[i]
+			}
+			stuck[pgs[i].PgId].Stuck = append(stuck[pgs[i].PgId].Stuck, item)
+		}
+	}
+	dat := make(model.PgList, 0)
+	for _, item := range stuck {
+		dat = append(dat, *item)
+	}
+	sort.Slice(dat, func(i, j int) bool {
+		return dat[i].PgId < dat[j].PgId
+	})
+	ctx.IndentedJSON(http.StatusOK, dat)
+}
+
+// PgInconsistentObjects godoc
+//
+//	@Summary		Show Inconsistent Objects of Placement Group
+//	@Description	scrub 에서 발견된 PG 의 불일치 객체와 OSD 샤드별 오류를 보여줍니다.
+//	@Tags			Pg
+//	@param			pg_id	path	string	true	"PG ID"
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{object}	model.PgInconsistent
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/pg/{pg_id}/inconsistent [get]
+func (c *Controller) PgInconsistentObjects(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	pg_id := ctx.Param("pg_id")
+	if !pg_id_format.MatchString(pg_id) {
+		err := errors.New("pg_id must be in pool_id.pg_num format")
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+	dat, err := pg.InconsistentObjects(pg_id)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusNotFound, err)
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, dat)
+}
+
+// PgScrub godoc
+//
+//	@Summary		Scrub or Repair of Placement Group
+//	@Description	PG 에 scrub, deep-scrub, repair 를 요청합니다. repair 는 불일치 객체를 정상 샤드로 복구합니다.
+//	@Tags			Pg
+//	@param			pg_id	path	string	true	"PG ID"
+//	@param			action	query	string	true	"Scrub Action"	Enums(scrub, deep-scrub, repair)
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{string}	string	"Success"
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/pg/{pg_id} [post]
+func (c *Controller) PgScrub(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	pg_id := ctx.Param("pg_id")
+	action := ctx.Request.URL.Query().Get("action")
+	err := pgScrubAction(action)
+	if err == nil && !pg_id_format.MatchString(pg_id) {
+		err = errors.New("pg_id must be in pool_id.pg_num format")
+	}
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+	output, err := pg.Scrub(action, pg_id)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, output)
+}
+
+// PoolScrub godoc
+//
+//	@Summary		Scrub or Repair of Pool
+//	@Description	풀의 모든 PG 에 scrub, deep-scrub, repair 를 요청합니다.
+//	@Tags			Pg
+//	@param			pool_name	path	string	true	"Pool Name"
+//	@param			action		query	string	true	"Scrub Action"	Enums(scrub, deep-scrub, repair)
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{string}	string	"Success"
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/pool/{pool_name}/scrub [post]
+func (c *Controller) PoolScrub(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	pool_name := ctx.Param("pool_name")
+	action := ctx.Request.URL.Query().Get("action")
+	if err := pgScrubAction(action); err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+	output, err := pg.PoolScrub(ctx.Request.Context(), action, pool_name)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, output)
+}
@@ -0,0 +1,588 @@
+package controller
+
+import (
+	"Glue-API/httputil"
+	"Glue-API/model"
+	"Glue-API/utils"
+	"Glue-API/utils/cluster"
+	"Glue-API/utils/fs"
+	"Glue-API/utils/glue"
+	"Glue-API/utils/metric"
+	"Glue-API/utils/mirror"
+	"Glue-API/utils/rgw"
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/gin-gonic/gin"
+)
+
+// 풀 삭제 확인 토큰은 발급 후 5분 동안 한 번만 사용할 수 있습니다.
+var pool_delete_ttl = 5 * time.Minute
+var pool_delete_lock sync.Mutex
+var pool_delete_tokens = make(map[string]model.PoolDeleteConfirm)
+
+// PoolCreate godoc
+//
+//	@Summary		Create of Pool
+//	@Description	Glue 스토리지 풀을 생성합니다. 복제(replicated) 또는 EC(erasure) 풀을 생성할 수 있으며 rbd 애플리케이션은 rbd pool init 으로 초기화합니다.
+//	@Tags			Pool
+//	@param			pool_name				formData	string	true	"Pool Name"
+//	@param			pool_type				formData	string	false	"Pool Type"	Enums(replicated, erasure)	default(replicated)
+//	@param			application				formData	string	true	"Pool Application"	Enums(rbd, cephfs, rgw)
+//	@param			pg_num					formData	int		false	"Placement Group Count"
+//	@param			pg_autoscale_mode		formData	string	false	"PG Autoscale Mode"	Enums(on, off, warn)
+//	@param			crush_rule				formData	string	false	"CRUSH Rule Name"
+//	@param			erasure_code_profile	formData	string	false	"Erasure Code Profile Name"	default(default)
+//	@param			size					formData	int		false	"Replica Size"
+//	@param			min_size				formData	int		false	"Replica Min Size"
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{string}	string	"Success"
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/pool [post]
+func (c *Controller) PoolCreate(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	var dat model.PoolSpec
+	var err error
+	dat.Name, _ = ctx.GetPostForm("pool_name")
+	dat.Type, _ = ctx.GetPostForm("pool_type")
+	dat.Application, _ = ctx.GetPostForm("application")
+	dat.PgAutoscaleMode, _ = ctx.GetPostForm("pg_autoscale_mode")
+	dat.CrushRule, _ = ctx.GetPostForm("crush_rule")
+	dat.ErasureCodeProfile, _ = ctx.GetPostForm("erasure_code_profile")
+	if dat.PgNum, err = poolFormInt(ctx, "pg_num"); err == nil {
+		if dat.Size, err = poolFormInt(ctx, "size"); err == nil {
+			dat.MinSize, err = poolFormInt(ctx, "min_size")
+		}
+	}
+	if err == nil {
+		err = poolValidate(dat)
+	}
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+	// EC 풀은 참조한 프로파일이 있어야 생성할 수 있습니다.
+	if dat.Type == "erasure" && dat.ErasureCodeProfile != "" {
+		if _, err = glue.ErasureCodeProfileGet(ctx.Request.Context(), dat.ErasureCodeProfile); err != nil {
+			err = errors.New("erasure code profile " + dat.ErasureCodeProfile + " is not found")
+			utils.FancyHandleError(err)
+			httputil.NewError(ctx, http.StatusNotFound, err)
+			return
+		}
+	}
+	output, err := glue.PoolCreate(ctx.Request.Context(), dat)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, output)
+}
+
+// PoolUpdate godoc
+//
+//	@Summary		Update of Pool Properties
+//	@Description	Glue 스토리지 풀의 복제 수, 할당량, 압축, PG 자동 조정 설정, CRUSH 규칙을 변경합니다. 입력한 항목만 변경합니다.
+//	@Tags			Pool
+//	@param			pool_name				path		string	true	"Pool Name"
+//	@param			size					formData	int		false	"Replica Size"
+//	@param			min_size				formData	int		false	"Replica Min Size"
+//	@param			max_bytes				formData	int		false	"Quota Max Bytes(0 is unlimited)"
+//	@param			max_objects				formData	int		false	"Quota Max Objects(0 is unlimited)"
+//	@param			compression_mode		formData	string	false	"Compression Mode"	Enums(none, passive, aggressive, force)
+//	@param			compression_algorithm	formData	string	false	"Compression Algorithm"	Enums(snappy, zlib, zstd, lz4)
+//	@param			pg_autoscale_mode		formData	string	false	"PG Autoscale Mode"	Enums(on, off, warn)
+//	@param			pg_num					formData	int		false	"Placement Group Count"
+//	@param			target_size_ratio		formData	number	false	"Target Size Ratio For PG Autoscale"
+//	@param			crush_rule				formData	string	false	"CRUSH Rule Name"
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{string}	string	"Success"
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/pool/{pool_name} [put]
+func (c *Controller) PoolUpdate(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	pool_name := ctx.Param("pool_name")
+	pools, err := glue.PoolDetail(ctx.Request.Context())
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	found := false
+	erasure := false
+	for _, pool := range pools {
+		if pool.PoolName == pool_name {
+			found = true
+			// type 3 은 EC 풀입니다.
+			erasure = pool.Type == 3
+		}
+	}
+	if !found {
+		err = errors.New("pool " + pool_name + " is not found")
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusNotFound, err)
+		return
+	}
+
+	size, err := poolFormInt(ctx, "size")
+	if err == nil {
+		var min_size int
+		if min_size, err = poolFormInt(ctx, "min_size"); err == nil && min_size > 0 && size > 0 && min_size > size {
+			err = errors.New("min_size must not be greater than size")
+		}
+	}
+	if err == nil && erasure && size > 0 {
+		err = errors.New("size of erasure coded pool is decided by erasure code profile")
+	}
+	for _, key := range []string{"max_bytes", "max_objects"} {
+		if err == nil {
+			_, err = poolFormInt(ctx, key)
+		}
+	}
+	if value, ok := ctx.GetPostForm("pg_num"); err == nil && ok && value != "" {
+		if pg_num, parse_err := strconv.Atoi(value); parse_err != nil || pg_num <= 0 {
+			err = errors.New("pg_num must be a positive number")
+		}
+	}
+	if value, ok := ctx.GetPostForm("target_size_ratio"); err == nil && ok && value != "" {
+		if ratio, parse_err := strconv.ParseFloat(value, 64); parse_err != nil || ratio < 0 {
+			err = errors.New("target_size_ratio must be a positive number")
+		}
+	}
+	if err == nil {
+		err = poolFormEnum(ctx, "compression_mode", "none", "passive", "aggressive", "force")
+	}
+	if err == nil {
+		err = poolFormEnum(ctx, "compression_algorithm", "snappy", "zlib", "zstd", "lz4")
+	}
+	if err == nil {
+		err = poolFormEnum(ctx, "pg_autoscale_mode", "on", "off", "warn")
+	}
+	if crush_rule, ok := ctx.GetPostForm("crush_rule"); err == nil && ok && crush_rule != "" {
+		err = poolCrushRule(ctx.Request.Context(), crush_rule, erasure)
+	}
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	// 모든 입력을 검증한 뒤에 적용하여 일부 항목만 변경된 채로 실패하지 않도록 합니다.
+	output := "Success"
+	// min_size 는 변경된 size 를 기준으로 검증되므로 size 를 먼저 적용합니다.
+	for _, key := range []string{"size", "min_size", "pg_num", "target_size_ratio", "pg_autoscale_mode", "compression_mode", "compression_algorithm", "crush_rule"} {
+		value, ok := ctx.GetPostForm(key)
+		if !ok || value == "" {
+			continue
+		}
+		if output, err = glue.PoolSet(ctx.Request.Context(), pool_name, key, value); err != nil {
+			utils.FancyHandleError(err)
+			httputil.NewError(ctx, http.StatusInternalServerError, err)
+			return
+		}
+	}
+	for _, key := range []string{"max_bytes", "max_objects"} {
+		value, ok := ctx.GetPostForm(key)
+		if !ok || value == "" {
+			continue
+		}
+		if output, err = glue.PoolQuota(ctx.Request.Context(), pool_name, key, value); err != nil {
+			utils.FancyHandleError(err)
+			httputil.NewError(ctx, http.StatusInternalServerError, err)
+			return
+		}
+	}
+	ctx.IndentedJSON(http.StatusOK, output)
+}
+
+// 폼 값을 정수로 읽습니다. 값이 없으면 0 을 반환합니다.
+func poolFormInt(ctx *gin.Context, key string) (output int, err error) {
+	value, ok := ctx.GetPostForm(key)
+	if !ok || value == "" {
+		return
+	}
+	if output, err = strconv.Atoi(value); err != nil || output < 0 {
+		err = errors.New(key + " must be a positive number")
+	}
+	return
+}
+
+// 폼 값이 허용된 값 중 하나인지 확인합니다.
+func poolFormEnum(ctx *gin.Context, key string, values ...string) (err error) {
+	value, ok := ctx.GetPostForm(key)
+	if !ok || value == "" {
+		return
+	}
+	for _, allowed := range values {
+		if value == allowed {
+			return
+		}
+	}
+	return errors.New("invalid " + key + " " + value)
+}
+
+// 규칙이 있고 풀 종류(복제 또는 EC)와 같은 종류의 규칙인지 확인합니다.
+func poolCrushRule(ctx context.Context, rule_name string, erasure bool) (err error) {
+	rules, err := glue.CrushRuleDump(ctx)
+	if err != nil {
+		return
+	}
+	for _, rule := range rules {
+		if rule.RuleName != rule_name {
+			continue
+		}
+		// type 3 은 EC 규칙입니다.
+		if (rule.Type == 3) != erasure {
+			return errors.New("crush rule " + rule_name + " does not match the pool type")
+		}
+		return
+	}
+	return errors.New("crush rule " + rule_name + " is not found")
+}
+
+func poolValidate(dat model.PoolSpec) (err error) {
+	if dat.Name == "" {
+		return errors.New("pool_name is required")
+	}
+	if dat.Type != "" && dat.Type != "replicated" && dat.Type != "erasure" {
+		return errors.New("pool_type must be replicated or erasure")
+	}
+	if dat.Application != "rbd" && dat.Application != "cephfs" && dat.Application != "rgw" {
+		return errors.New("application must be rbd, cephfs or rgw")
+	}
+	if dat.PgAutoscaleMode != "" && dat.PgAutoscaleMode != "on" && dat.PgAutoscaleMode != "off" && dat.PgAutoscaleMode != "warn" {
+		return errors.New("pg_autoscale_mode must be on, off or warn")
+	}
+	if dat.Type == "erasure" && (dat.Size > 0 || dat.MinSize > 0) {
+		return errors.New("size of erasure coded pool is decided by erasure code profile")
+	}
+	if dat.Type != "erasure" && dat.ErasureCodeProfile != "" {
+		return errors.New("erasure_code_profile is only for erasure coded pool")
+	}
+	if dat.Size > 0 && dat.MinSize > dat.Size {
+		return errors.New("min_size must not be greater than size")
+	}
+	return
+}
+
+// PoolStatsList godoc
+//
+//	@Summary		Show Statistics of All Pools
+//	@Description	모든 Glue 스토리지 풀의 사용량, 할당량 사용률, 복제 및 EC 설정, PG 수와 자동 조정 권장값, 클라이언트 I/O 를 보여줍니다.
+//	@Tags			Pool
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{array}		model.PoolStats
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/pool/stats [get]
+func (c *Controller) PoolStatsList(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	dat, err := poolStats(ctx.Request.Context(), "")
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, dat)
+}
+
+// PoolStats godoc
+//
+//	@Summary		Show Statistics of Pool
+//	@Description	Glue 스토리지 풀의 사용량, 할당량 사용률, 복제 및 EC 설정, PG 수와 자동 조정 권장값, 클라이언트 I/O 를 보여줍니다.
+//	@Tags			Pool
+//	@param			pool_name	path	string	true	"Pool Name"
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{object}	model.PoolStats
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/pool/{pool_name}/stats [get]
+func (c *Controller) PoolStats(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	pool_name := ctx.Param("pool_name")
+	dat, err := poolStats(ctx.Request.Context(), pool_name)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	if len(dat) == 0 {
+		err = errors.New("pool " + pool_name + " is not found")
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusNotFound, err)
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, dat[0])
+}
+
+// poolStats 는 풀 상세정보, ceph df, PG 자동 조정 상태, 풀 I/O 통계를 합쳐 보여줍니다. pool_name 이 비어 있으면 모든 풀을 반환합니다.
+func poolStats(ctx context.Context, pool_name string) (dat []model.PoolStats, err error) {
+	pools, err := glue.PoolDetail(ctx)
+	if err != nil {
+		return
+	}
+	df, err := metric.Df(ctx)
+	if err != nil {
+		return
+	}
+	io_stats, err := glue.PoolIoStats(ctx)
+	if err != nil {
+		return
+	}
+	// pg_autoscaler 모듈이 꺼져 있으면 조회할 수 없으므로 권장값 없이 보여줍니다.
+	autoscale, _ := glue.PoolAutoscaleStatus(ctx)
+
+	dat = make([]model.PoolStats, 0)
+	for _, pool := range pools {
+		if pool_name != "" && pool.PoolName != pool_name {
+			continue
+		}
+		item := model.PoolStats{
+			PoolName:           pool.PoolName,
+			PoolId:             pool.PoolId,
+			Type:               "replicated",
+			Size:               pool.Size,
+			MinSize:            pool.MinSize,
+			ErasureCodeProfile: pool.ErasureCodeProfile,
+			CrushRule:          pool.CrushRule,
+			Applications:       make([]string, 0),
+			QuotaMaxBytes:      pool.QuotaMaxBytes,
+			QuotaMaxObjects:    pool.QuotaMaxObjects,
+			PgNum:              pool.PgNum,
+			PgAutoscaleMode:    pool.PgAutoscaleMode,
+		}
+		// type 3 은 EC 풀입니다.
+		if pool.Type == 3 {
+			item.Type = "erasure"
+		} else {
+			item.ErasureCodeProfile = ""
+		}
+		for application := range pool.ApplicationMetadata {
+			item.Applications = append(item.Applications, application)
+		}
+		for _, current := range df.Pools {
+			if current.Name == pool.PoolName {
+				item.Stored = current.Stats.Stored
+				item.BytesUsed = current.Stats.BytesUsed
+				item.MaxAvail = current.Stats.MaxAvail
+				item.PercentUsed = current.Stats.PercentUsed
+				item.Objects = current.Stats.Objects
+			}
+		}
+		if item.QuotaMaxBytes > 0 {
+			item.QuotaBytesPercent = float64(item.Stored) / float64(item.QuotaMaxBytes) * 100
+		}
+		if item.QuotaMaxObjects > 0 {
+			item.QuotaObjectsPercent = float64(item.Objects) / float64(item.QuotaMaxObjects) * 100
+		}
+		for _, current := range autoscale {
+			if current.PoolName == pool.PoolName {
+				item.PgNumRecommended = current.PgNumFinal
+				item.PgWouldAdjust = current.WouldAdjust
+			}
+		}
+		for _, current := range io_stats {
+			if current.PoolName == pool.PoolName {
+				item.ReadBytesSec = current.ClientIoRate.ReadBytesSec
+				item.WriteBytesSec = current.ClientIoRate.WriteBytesSec
+				item.ReadOpPerSec = current.ClientIoRate.ReadOpPerSec
+				item.WriteOpPerSec = current.ClientIoRate.WriteOpPerSec
+			}
+		}
+		dat = append(dat, item)
+	}
+	return
+}
+
+// PoolProtect godoc
+//
+//	@Summary		Protect of Pool
+//	@Description	Glue 스토리지 풀에 nodelete 플래그를 설정하여 삭제를 막거나 보호를 해제합니다.
+//	@Tags			Pool
+//	@param			pool_name	path		string	true	"Pool Name"
+//	@param			protected	formData	bool	true	"Protect Pool From Deletion"
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{string}	string	"Success"
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/pool/{pool_name}/protect [put]
+func (c *Controller) PoolProtect(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	pool_name := ctx.Param("pool_name")
+	value, _ := ctx.GetPostForm("protected")
+	protected, err := strconv.ParseBool(value)
+	if err != nil {
+		err = errors.New("protected must be true or false")
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+	output, err := glue.PoolProtect(ctx.Request.Context(), pool_name, protected)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, output)
+}
+
+// poolDeleteCheck 는 풀의 보호 여부와 RBD 이미지, GlueFS, RGW 버킷, 미러링 이미지 사용 현황을 확인합니다.
+func poolDeleteCheck(ctx context.Context, pool_name string, force bool) (dat model.PoolDeleteConfirm, status int, err error) {
+	status = http.StatusInternalServerError
+	dat = model.PoolDeleteConfirm{PoolName: pool_name, Cluster: poolDeleteCluster(ctx), Force: force, FsNames: make([]string, 0)}
+	pools, err := glue.PoolDetail(ctx)
+	if err != nil {
+		return
+	}
+	found := false
+	protected := false
+	applications := make(map[string]interface{})
+	for _, pool := range pools {
+		if pool.PoolName == pool_name {
+			found = true
+			protected = strings.Contains(","+pool.FlagsNames+",", ",nodelete,")
+			applications = pool.ApplicationMetadata
+		}
+	}
+	if !found {
+		status = http.StatusNotFound
+		err = errors.New("pool " + pool_name + " is not found")
+		return
+	}
+	if protected {
+		status = http.StatusBadRequest
+		err = errors.New("pool " + pool_name + " is protected, unprotect it before deletion")
+		return
+	}
+
+	if _, ok := applications["rbd"]; ok {
+		images, err := mirror.RbdImage(ctx, pool_name)
+		if err != nil {
+			return dat, status, err
+		}
+		dat.RbdImages = len(images)
+		// 휴지통의 이미지는 유예 기간 동안 복구할 수 있으므로 사용 중으로 봅니다.
+		trash, err := glue.TrashList(ctx, pool_name)
+		if err != nil {
+			return dat, status, err
+		}
+		dat.TrashImages = len(trash)
+		// 미러링이 활성화되지 않은 풀은 오류를 반환하므로 미러링 이미지가 없는 것으로 봅니다.
+		if mirror_list, err := mirror.ImageList(ctx, pool_name); err == nil {
+			dat.MirrorImages = len(mirror_list.Images)
+		}
+	}
+	if _, ok := applications["cephfs"]; ok {
+		fs_list, err := fs.FsList(ctx)
+		if err != nil {
+			return dat, status, err
+		}
+		for _, fs_info := range fs_list {
+			used := fs_info.MetadataPool == pool_name
+			for _, data_pool := range fs_info.DataPools {
+				used = used || data_pool == pool_name
+			}
+			if used {
+				dat.FsNames = append(dat.FsNames, fs_info.Name)
+			}
+		}
+	}
+	if _, ok := applications["rgw"]; ok {
+		buckets, err := rgw.PoolBuckets(ctx, pool_name)
+		if err != nil {
+			return dat, status, err
+		}
+		dat.RgwBuckets = len(buckets)
+	}
+
+	if force {
+		return
+	}
+	var usage []string
+	if dat.RbdImages > 0 {
+		usage = append(usage, strconv.Itoa(dat.RbdImages)+" rbd images")
+	}
+	if dat.TrashImages > 0 {
+		usage = append(usage, strconv.Itoa(dat.TrashImages)+" trashed rbd images")
+	}
+	if dat.MirrorImages > 0 {
+		usage = append(usage, strconv.Itoa(dat.MirrorImages)+" mirror enabled images")
+	}
+	if len(dat.FsNames) > 0 {
+		usage = append(usage, "gluefs "+strings.Join(dat.FsNames, ", "))
+	}
+	if dat.RgwBuckets > 0 {
+		usage = append(usage, strconv.Itoa(dat.RgwBuckets)+" rgw buckets")
+	}
+	if len(usage) > 0 {
+		status = http.StatusBadRequest
+		err = errors.New("pool " + pool_name + " is in use by " + strings.Join(usage, ", ") + ", set force to delete it")
+	}
+	return
+}
+
+// poolDeleteToken 은 삭제 확인 토큰을 발급합니다.
+func poolDeleteToken(dat model.PoolDeleteConfirm) model.PoolDeleteConfirm {
+	pool_delete_lock.Lock()
+	defer pool_delete_lock.Unlock()
+
+	now := time.Now()
+	for token, current := range pool_delete_tokens {
+		if expires_at, err := time.Parse(time.RFC3339, current.ExpiresAt); err != nil || now.After(expires_at) {
+			delete(pool_delete_tokens, token)
+		}
+	}
+	dat.ConfirmToken = uuid.New().String()
+	dat.ExpiresAt = now.Add(pool_delete_ttl).Format(time.RFC3339)
+	pool_delete_tokens[dat.ConfirmToken] = dat
+	return dat
+}
+
+// poolDeleteCluster 는 삭제 확인 토큰에 기록할 대상 클러스터 이름을 반환합니다.
+func poolDeleteCluster(ctx context.Context) string {
+	if dat, remote := cluster.FromContext(ctx); remote {
+		return dat.Name
+	}
+	return "local"
+}
+
+// poolDeleteConfirm 은 첫 번째 요청에서 발급한 토큰이 같은 클러스터, 풀, force 값으로 발급되었는지 확인하고 토큰을 소모합니다.
+func poolDeleteConfirm(ctx context.Context, pool_name string, force bool, confirm_token string) (err error) {
+	pool_delete_lock.Lock()
+	defer pool_delete_lock.Unlock()
+
+	dat, ok := pool_delete_tokens[confirm_token]
+	if !ok || dat.PoolName != pool_name || dat.Cluster != poolDeleteCluster(ctx) || dat.Force != force {
+		return errors.New("confirm_token is invalid for pool " + pool_name)
+	}
+	delete(pool_delete_tokens, confirm_token)
+	if expires_at, err := time.Parse(time.RFC3339, dat.ExpiresAt); err != nil || time.Now().After(expires_at) {
+		return errors.New("confirm_token is expired")
+	}
+	return
+}
@@ -0,0 +1,346 @@
+package controller
+
+import (
+	"Glue-API/httputil"
+	"Glue-API/model"
+	"Glue-API/utils"
+	"Glue-API/utils/glue"
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// qosValues 는 폼에서 지정한 QoS 옵션 값을 읽습니다. 값은 0 이상의 정수여야 하며 0 은 제한하지 않음을 뜻합니다.
+func qosValues(ctx *gin.Context) (values map[string]string, ok bool) {
+	values = make(map[string]string)
+	for _, option := range glue.QosOptions {
+		value, exists := ctx.GetPostForm(option)
+		if !exists || value == "" {
+			continue
+		}
+		if _, err := strconv.ParseUint(value, 10, 64); err != nil {
+			err = errors.New(option + " must be a non-negative integer")
+			utils.FancyHandleError(err)
+			httputil.NewError(ctx, http.StatusBadRequest, err)
+			return
+		}
+		values[option] = value
+	}
+	if len(values) == 0 {
+		err := errors.New("at least one qos option is required")
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+	return values, true
+}
+
+// qosName 은 초기화할 QoS 옵션 이름을 검사합니다. 비어 있으면 해당 단계에서 설정한 모든 옵션을 초기화합니다.
+func qosName(ctx *gin.Context) (name string, ok bool) {
+	name = ctx.Request.URL.Query().Get("name")
+	if name == "" {
+		return name, true
+	}
+	for _, option := range glue.QosOptions {
+		if option == name {
+			return name, true
+		}
+	}
+	err := errors.New("name " + name + " is not a qos option")
+	utils.FancyHandleError(err)
+	httputil.NewError(ctx, http.StatusBadRequest, err)
+	return
+}
+
+// qosOverrides 는 상속받지 않고 level 단계에서 직접 설정한 QoS 옵션을 반환합니다.
+func qosOverrides(dat model.ImageQos, level string, name string) (options []string) {
+	for _, config := range dat.Configs {
+		if config.Source == level && (name == "" || config.Name == name) {
+			options = append(options, config.Name)
+		}
+	}
+	return
+}
+
+// imagesQos 는 iSCSI 디스크나 NVMe-OF 네임스페이스로 노출된 여러 이미지의 QoS 를 조회합니다.
+func imagesQos(ctx context.Context, images []model.ImageRef) (dat []model.ImageQos, err error) {
+	dat = make([]model.ImageQos, 0)
+	for _, image := range images {
+		qos, err := glue.ImageQos(ctx, image.Pool, image.Image)
+		if err != nil {
+			return dat, err
+		}
+		dat = append(dat, qos)
+	}
+	return
+}
+func imagesQosSet(ctx context.Context, images []model.ImageRef, values map[string]string) (output string, err error) {
+	for _, image := range images {
+		if output, err = glue.QosSet(ctx, "image", image.Pool+"/"+image.Image, values); err != nil {
+			return
+		}
+	}
+	output = "Success"
+	return
+}
+func imagesQosRemove(ctx context.Context, images []model.ImageRef, name string) (output string, err error) {
+	for _, image := range images {
+		qos, err := glue.ImageQos(ctx, image.Pool, image.Image)
+		if err != nil {
+			return output, err
+		}
+		if output, err = glue.QosRemove(ctx, "image", image.Pool+"/"+image.Image, qosOverrides(qos, "image", name)); err != nil {
+			return output, err
+		}
+	}
+	output = "Success"
+	return
+}
+
+// ImageQos godoc
+//
+//	@Summary		Show QoS of Image
+//	@Description	이미지에 실제로 적용되는 rbd QoS 설정(IOPS, BPS, 읽기/쓰기별 제한과 버스트)을 보여줍니다. source 로 전역, 풀, 이미지 중 어느 단계의 값인지 알 수 있습니다.
+//	@Tags			Image
+//	@param			pool_name					path		string	true	"Glue Pool Name"
+//	@param			image_name					path		string	true	"Glue Image Name"
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{object}	model.ImageQos
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/image/{pool_name}/{image_name}/qos [get]
+func (c *Controller) ImageQos(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	if _, ok := imageDetail(ctx); !ok {
+		return
+	}
+	dat, err := glue.ImageQos(ctx.Request.Context(), ctx.Param("pool_name"), ctx.Param("image_name"))
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, dat)
+}
+
+// ImageQosUpdate godoc
+//
+//	@Summary		Update QoS of Image
+//	@Description	이미지 단계의 rbd QoS 설정을 변경합니다. 지정한 옵션만 변경하며, 값이 0 이면 풀이나 전역 설정과 관계없이 제한하지 않습니다.
+//	@Tags			Image
+//	@param			pool_name					path		string	true	"Glue Pool Name"
+//	@param			image_name					path		string	true	"Glue Image Name"
+//	@param			iops_limit					formData	int		false	"IOPS Limit"
+//	@param			iops_burst					formData	int		false	"IOPS Burst"
+//	@param			iops_burst_seconds			formData	int		false	"IOPS Burst Seconds"
+//	@param			bps_limit					formData	int		false	"BPS Limit"
+//	@param			bps_burst					formData	int		false	"BPS Burst"
+//	@param			bps_burst_seconds			formData	int		false	"BPS Burst Seconds"
+//	@param			read_iops_limit				formData	int		false	"Read IOPS Limit"
+//	@param			read_iops_burst				formData	int		false	"Read IOPS Burst"
+//	@param			read_iops_burst_seconds		formData	int		false	"Read IOPS Burst Seconds"
+//	@param			write_iops_limit			formData	int		false	"Write IOPS Limit"
+//	@param			write_iops_burst			formData	int		false	"Write IOPS Burst"
+//	@param			write_iops_burst_seconds	formData	int		false	"Write IOPS Burst Seconds"
+//	@param			read_bps_limit				formData	int		false	"Read BPS Limit"
+//	@param			read_bps_burst				formData	int		false	"Read BPS Burst"
+//	@param			read_bps_burst_seconds		formData	int		false	"Read BPS Burst Seconds"
+//	@param			write_bps_limit				formData	int		false	"Write BPS Limit"
+//	@param			write_bps_burst				formData	int		false	"Write BPS Burst"
+//	@param			write_bps_burst_seconds		formData	int		false	"Write BPS Burst Seconds"
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{string}	string	"Success"
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/image/{pool_name}/{image_name}/qos [put]
+func (c *Controller) ImageQosUpdate(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	values, ok := qosValues(ctx)
+	if !ok {
+		return
+	}
+	if _, ok = imageDetail(ctx); !ok {
+		return
+	}
+	dat, err := glue.QosSet(ctx.Request.Context(), "image", ctx.Param("pool_name")+"/"+ctx.Param("image_name"), values)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, dat)
+}
+
+// ImageQosReset godoc
+//
+//	@Summary		Reset QoS of Image
+//	@Description	이미지 단계에서 설정한 rbd QoS 옵션을 지워 풀 또는 전역 설정을 상속하도록 합니다. 이름을 지정하지 않으면 모든 옵션을 초기화합니다.
+//	@Tags			Image
+//	@param			pool_name					path		string	true	"Glue Pool Name"
+//	@param			image_name					path		string	true	"Glue Image Name"
+//	@param			name						query		string	false	"QoS Option Name (ex. iops_limit)"
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{string}	string	"Success"
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/image/{pool_name}/{image_name}/qos [delete]
+func (c *Controller) ImageQosReset(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	name, ok := qosName(ctx)
+	if !ok {
+		return
+	}
+	if _, ok = imageDetail(ctx); !ok {
+		return
+	}
+	pool_name := ctx.Param("pool_name")
+	image_name := ctx.Param("image_name")
+	qos, err := glue.ImageQos(ctx.Request.Context(), pool_name, image_name)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	options := qosOverrides(qos, "image", name)
+	if name != "" && len(options) == 0 {
+		err = errors.New(name + " is not set on image " + pool_name + "/" + image_name)
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusNotFound, err)
+		return
+	}
+	dat, err := glue.QosRemove(ctx.Request.Context(), "image", pool_name+"/"+image_name, options)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, dat)
+}
+
+// PoolQos godoc
+//
+//	@Summary		Show QoS of Pool
+//	@Description	풀의 이미지가 상속받는 rbd QoS 설정을 보여줍니다. source 로 전역 또는 풀 중 어느 단계의 값인지 알 수 있습니다.
+//	@Tags			Pool
+//	@param			pool_name					path		string	true	"Glue Pool Name"
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{object}	model.ImageQos
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/pool/{pool_name}/qos [get]
+func (c *Controller) PoolQos(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	dat, err := glue.PoolQos(ctx.Request.Context(), ctx.Param("pool_name"))
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, dat)
+}
+
+// PoolQosUpdate godoc
+//
+//	@Summary		Update QoS of Pool
+//	@Description	풀 단계의 rbd QoS 설정을 변경합니다. 이미지 단계에서 따로 설정하지 않은 풀의 모든 이미지에 적용됩니다.
+//	@Tags			Pool
+//	@param			pool_name					path		string	true	"Glue Pool Name"
+//	@param			iops_limit					formData	int		false	"IOPS Limit"
+//	@param			iops_burst					formData	int		false	"IOPS Burst"
+//	@param			iops_burst_seconds			formData	int		false	"IOPS Burst Seconds"
+//	@param			bps_limit					formData	int		false	"BPS Limit"
+//	@param			bps_burst					formData	int		false	"BPS Burst"
+//	@param			bps_burst_seconds			formData	int		false	"BPS Burst Seconds"
+//	@param			read_iops_limit				formData	int		false	"Read IOPS Limit"
+//	@param			read_iops_burst				formData	int		false	"Read IOPS Burst"
+//	@param			read_iops_burst_seconds		formData	int		false	"Read IOPS Burst Seconds"
+//	@param			write_iops_limit			formData	int		false	"Write IOPS Limit"
+//	@param			write_iops_burst			formData	int		false	"Write IOPS Burst"
+//	@param			write_iops_burst_seconds	formData	int		false	"Write IOPS Burst Seconds"
+//	@param			read_bps_limit				formData	int		false	"Read BPS Limit"
+//	@param			read_bps_burst				formData	int		false	"Read BPS Burst"
+//	@param			read_bps_burst_seconds		formData	int		false	"Read BPS Burst Seconds"
+//	@param			write_bps_limit				formData	int		false	"Write BPS Limit"
+//	@param			write_bps_burst				formData	int		false	"Write BPS Burst"
+//	@param			write_bps_burst_seconds		formData	int		false	"Write BPS Burst Seconds"
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{string}	string	"Success"
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/pool/{pool_name}/qos [put]
+func (c *Controller) PoolQosUpdate(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	values, ok := qosValues(ctx)
+	if !ok {
+		return
+	}
+	dat, err := glue.QosSet(ctx.Request.Context(), "pool", ctx.Param("pool_name"), values)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, dat)
+}
+
+// PoolQosReset godoc
+//
+//	@Summary		Reset QoS of Pool
+//	@Description	풀 단계에서 설정한 rbd QoS 옵션을 지워 전역 설정을 상속하도록 합니다. 이름을 지정하지 않으면 모든 옵션을 초기화합니다.
+//	@Tags			Pool
+//	@param			pool_name					path		string	true	"Glue Pool Name"
+//	@param			name						query		string	false	"QoS Option Name (ex. iops_limit)"
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{string}	string	"Success"
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/pool/{pool_name}/qos [delete]
+func (c *Controller) PoolQosReset(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	name, ok := qosName(ctx)
+	if !ok {
+		return
+	}
+	pool_name := ctx.Param("pool_name")
+	qos, err := glue.PoolQos(ctx.Request.Context(), pool_name)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	options := qosOverrides(qos, "pool", name)
+	if name != "" && len(options) == 0 {
+		err = errors.New(name + " is not set on pool " + pool_name)
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusNotFound, err)
+		return
+	}
+	dat, err := glue.QosRemove(ctx.Request.Context(), "pool", pool_name, options)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, dat)
+}
@@ -33,7 +33,7 @@ func (c *Controller) SubVolumeGroupList(ctx *gin.Context) {
 	ctx.Header("Access-Control-Allow-Origin", "*")
 
 	vol_name := ctx.Request.URL.Query().Get("vol_name")
-	ls_data, err := fs.SubVolumeGroupLs(vol_name)
+	ls_data, err := fs.SubVolumeGroupLs(ctx.Request.Context(), vol_name)
 	if err != nil {
 		utils.FancyHandleError(err)
 		httputil.NewError(ctx, http.StatusInternalServerError, err)
@@ -41,19 +41,19 @@ func (c *Controller) SubVolumeGroupList(ctx *gin.Context) {
 	}
 	var value []model.SubVolumeGroupList
 	for i := 0; i < len(ls_data); i++ {
-		info_data, err := fs.SubVolumeGroupInfo(vol_name, ls_data[i].Name)
+		info_data, err := fs.SubVolumeGroupInfo(ctx.Request.Context(), vol_name, ls_data[i].Name)
 		if err != nil {
 			utils.FancyHandleError(err)
 			httputil.NewError(ctx, http.StatusInternalServerError, err)
 			return
 		}
-		path_data, err := fs.SubVolumeGroupGetPath(vol_name, ls_data[i].Name)
+		path_data, err := fs.SubVolumeGroupGetPath(ctx.Request.Context(), vol_name, ls_data[i].Name)
 		if err != nil {
 			utils.FancyHandleError(err)
 			httputil.NewError(ctx, http.StatusInternalServerError, err)
 			return
 		}
-		snap_data, err := fs.SubVolumeGroupSnapLs(vol_name, ls_data[i].Name)
+		snap_data, err := fs.SubVolumeGroupSnapLs(ctx.Request.Context(), vol_name, ls_data[i].Name)
 		if err != nil {
 			utils.FancyHandleError(err)
 			httputil.NewError(ctx, http.StatusInternalServerError, err)
@@ -117,7 +117,7 @@ func (c *Controller) SubVolumeGroupCreate(ctx *gin.Context) {
 	size_int := size_data * 1024 * 1024 * 1024
 	size_str := strconv.Itoa(size_int)
 
-	dat, err := fs.SubVolumeGroupCreate(vol_name, group_name, size_str, data_pool_name, mode)
+	dat, err := fs.SubVolumeGroupCreate(ctx.Request.Context(), vol_name, group_name, size_str, data_pool_name, mode)
 	if err != nil {
 		utils.FancyHandleError(err)
 		httputil.NewError(ctx, http.StatusInternalServerError, err)
@@ -144,6 +144,10 @@ func (c *Controller) SubVolumeGroupCreate(ctx *gin.Context) {
 //	@Router			/api/v1/gluefs/subvolume/group  [delete]
 func (c *Controller) SubVolumeGroupDelete(ctx *gin.Context) {
 	ctx.Header("Access-Control-Allow-Origin", "*")
+	// 하위 볼륨 그룹의 데이터를 지우기 위해 로컬 호스트에 파일시스템을 마운트합니다.
+	if !localCluster(ctx) {
+		return
+	}
 
 	vol_name := ctx.Request.URL.Query().Get("vol_name")
 	group_name := ctx.Request.URL.Query().Get("group_name")
@@ -184,7 +188,7 @@ func (c *Controller) SubVolumeGroupResize(ctx *gin.Context) {
 	size_int := new_size_data * 1024 * 1024 * 1024
 	new_size_str := strconv.Itoa(size_int)
 
-	dat, err := fs.SubVolumeGroupResize(vol_name, group_name, new_size_str)
+	dat, err := fs.SubVolumeGroupResize(ctx.Request.Context(), vol_name, group_name, new_size_str)
 	if err != nil {
 		utils.FancyHandleError(err)
 		httputil.NewError(ctx, http.StatusInternalServerError, err)
@@ -0,0 +1,225 @@
+package controller
+
+import (
+	"Glue-API/httputil"
+	_ "Glue-API/model" // swag 이 godoc 의 model 타입을 찾을 수 있도록 가져옵니다.
+	"Glue-API/utils"
+	"Glue-API/utils/nvmeof"
+	"Glue-API/utils/upgrade"
+	"errors"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+var upgrade_version = regexp.MustCompile(`^[0-9]+\.[0-9]+\.[0-9]+$`)
+
+func (c *Controller) UpgradeOption(ctx *gin.Context) {
+	SetOptionHeader(ctx)
+	ctx.IndentedJSON(http.StatusOK, nil)
+}
+
+// 업그레이드 대상은 버전 또는 이미지 중 하나로 지정합니다.
+func upgradeTarget(version string, image string) (err error) {
+	if (version == "") == (image == "") {
+		return errors.New("either version or image is required")
+	}
+	if version != "" && !upgrade_version.MatchString(version) {
+		return errors.New("version must be in x.y.z format")
+	}
+	return nil
+}
+
+// UpgradeVersions godoc
+//
+//	@Summary		Show List of Available Glue Versions
+//	@Description	레지스트리에서 업그레이드할 수 있는 Glue 버전 목록을 보여줍니다.
+//	@Tags			Upgrade
+//	@param			image	query	string	false	"Container Image Without Tag"
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{object}	model.UpgradeVersions
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/upgrade/versions [get]
+func (c *Controller) UpgradeVersions(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	image := ctx.Request.URL.Query().Get("image")
+	dat, err := upgrade.Versions(image)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, dat)
+}
+
+// UpgradePreflight godoc
+//
+//	@Summary		Pre-flight Check of Glue Upgrade
+//	@Description	대상 버전의 이미지를 확인하고 클러스터 상태(HEALTH_OK), 재동기화 중인 미러링 이미지, 진행 중인 작업 등 업그레이드를 막는 항목을 보여줍니다.
+//	@Tags			Upgrade
+//	@param			version	query	string	false	"Target Glue Version(x.y.z)"
+//	@param			image	query	string	false	"Target Container Image"
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{object}	model.UpgradePreflight
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/upgrade/check [get]
+func (c *Controller) UpgradePreflight(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	version := ctx.Request.URL.Query().Get("version")
+	image := ctx.Request.URL.Query().Get("image")
+	if err := upgradeTarget(version, image); err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+	dat, err := upgrade.Preflight(version, image)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, dat)
+}
+
+// UpgradeStart godoc
+//
+//	@Summary		Start of Glue Upgrade
+//	@Description	사전 점검을 통과하면 Glue 업그레이드를 시작합니다. force 를 지정하면 점검 결과와 관계없이 시작합니다. nvmeof_cli_image 를 지정하면 NVMe-OF CLI 이미지도 함께 변경합니다.
+//	@Tags			Upgrade
+//	@param			version				formData	string	false	"Target Glue Version(x.y.z)"
+//	@param			image				formData	string	false	"Target Container Image"
+//	@param			force				formData	bool	false	"Skip Pre-flight Blockers"	default(false)
+//	@param			nvmeof_cli_image	formData	string	false	"NVMe-OF CLI Image For Target Version"
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{string}	string	"Success"
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/upgrade [post]
+func (c *Controller) UpgradeStart(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	version, _ := ctx.GetPostForm("version")
+	image, _ := ctx.GetPostForm("image")
+	force, _ := strconv.ParseBool(ctx.PostForm("force"))
+	nvmeof_cli_image, _ := ctx.GetPostForm("nvmeof_cli_image")
+	if err := upgradeTarget(version, image); err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+	preflight, err := upgrade.Preflight(version, image)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	if !preflight.Ready && !force {
+		err = errors.New("upgrade is blocked: " + strings.Join(preflight.Blockers, "; "))
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+	// 업그레이드가 시작된 뒤 실패하지 않도록 NVMe-OF CLI 이미지를 먼저 변경하고, 시작에 실패하면 되돌립니다.
+	// 게이트웨이 이미지 조회 실패와 관계없이 CLI 이미지는 항상 채워집니다.
+	previous, _ := nvmeof.Image()
+	if nvmeof_cli_image != "" {
+		if _, err = nvmeof.ImageUpdate(nvmeof_cli_image); err != nil {
+			utils.FancyHandleError(err)
+			httputil.NewError(ctx, http.StatusInternalServerError, err)
+			return
+		}
+	}
+	output, err := upgrade.Start(version, image, preflight.Check.TargetVersion)
+	if err != nil {
+		if nvmeof_cli_image != "" {
+			if _, rollback_err := nvmeof.ImageUpdate(previous.CliImage); rollback_err != nil {
+				err = errors.Join(err, errors.New("failed to restore nvmeof cli image "+previous.CliImage+": "+rollback_err.Error()))
+			}
+		}
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, output)
+}
+
+// UpgradeControl godoc
+//
+//	@Summary		Control of Glue Upgrade
+//	@Description	진행 중인 Glue 업그레이드를 일시 중지, 재개, 중단합니다.
+//	@Tags			Upgrade
+//	@param			control	query	string	true	"Upgrade Control"	Enums(pause, resume, stop)
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{string}	string	"Success"
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/upgrade [put]
+func (c *Controller) UpgradeControl(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	control := ctx.Request.URL.Query().Get("control")
+	if control != "pause" && control != "resume" && control != "stop" {
+		err := errors.New("control must be pause, resume or stop")
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+	status, err := upgrade.Status()
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	if !status.InProgress {
+		err = errors.New("no upgrade is in progress")
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusNotFound, err)
+		return
+	}
+	output, err := upgrade.Control(control)
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, output)
+}
+
+// UpgradeProgress godoc
+//
+//	@Summary		Show Progress of Glue Upgrade
+//	@Description	Glue 업그레이드 상태와 데몬 종류별로 대상 버전으로 업그레이드된 데몬 수를 보여줍니다.
+//	@Tags			Upgrade
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{object}	model.UpgradeProgress
+//	@Failure		400	{object}	httputil.HTTP400BadRequest
+//	@Failure		404	{object}	httputil.HTTP404NotFound
+//	@Failure		500	{object}	httputil.HTTP500InternalServerError
+//	@Router			/api/v1/upgrade [get]
+func (c *Controller) UpgradeProgress(ctx *gin.Context) {
+	ctx.Header("Access-Control-Allow-Origin", "*")
+
+	dat, err := upgrade.Progress()
+	if err != nil {
+		utils.FancyHandleError(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, dat)
+}
@@ -24,9 +24,9 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/api/v1/glue": {
+        "/api/v1/alerts": {
             "get": {
-                "description": "Glue 의 상태값을 보여줍니다.",
+                "description": "경고 규칙을 평가하여 발생한 경고 목록을 보여줍니다. 해제된 경고는 24시간 동안 보여줍니다.",
                 "consumes": [
                     "application/x-www-form-urlencoded"
                 ],
@@ -34,14 +34,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Glue"
+                    "Alert"
+                ],
+                "summary": "Show List of Glue Alerts",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Alert State(pending, firing, resolved)",
+                        "name": "state",
+                        "in": "query"
+                    }
                 ],
-                "summary": "Show Status of Glue",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/GlueStatus"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/Alert"
+                            }
                         }
                     },
                     "400": {
@@ -65,9 +76,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/glue/hosts": {
+        "/api/v1/alerts/rule": {
             "get": {
-                "description": "Glue 호스트 리스트를 보여줍니다.",
+                "description": "등록된 경고 규칙 목록을 보여줍니다.",
                 "consumes": [
                     "application/x-www-form-urlencoded"
                 ],
@@ -75,30 +86,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Hosts"
+                    "Alert"
                 ],
-                "summary": "Show List of Glue Hosts",
+                "summary": "Show List of Glue Alert Rules",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "type": "object",
-                                "properties": {
-                                    "addr": {
-                                        "type": "string"
-                                    },
-                                    "hostname": {
-                                        "type": "string"
-                                    },
-                                    "ip_address": {
-                                        "type": "string"
-                                    },
-                                    "status": {
-                                        "type": "string"
-                                    }
-                                }
+                                "$ref": "#/definitions/AlertRule"
                             }
                         }
                     },
@@ -121,11 +118,9 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/api/v1/glue/pw": {
-            "get": {
-                "description": "Glue 사용자의 비밀번호 암호화 값을 생성합니다.",
+            },
+            "post": {
+                "description": "경고 규칙을 등록합니다. 헬스 체크 코드, 클러스터 및 풀 사용률, 미러링 이미지 상태, SMB 호스트 상태를 조건으로 사용할 수 있습니다.",
                 "consumes": [
                     "application/x-www-form-urlencoded"
                 ],
@@ -133,31 +128,67 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Passwd"
+                    "Alert"
                 ],
-                "summary": "Creates the glue user's password encryption value.",
+                "summary": "Create of Glue Alert Rule",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Glue User's Password",
-                        "name": "pass_word",
-                        "in": "query",
+                        "description": "Alert Rule Name",
+                        "name": "rule_name",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "health_check",
+                            "cluster_usage",
+                            "pool_usage",
+                            "mirror_image",
+                            "smb_host"
+                        ],
+                        "type": "string",
+                        "description": "Alert Rule Type",
+                        "name": "type",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Health Check Code(* is all), Pool Name or Mirror Pool Name",
+                        "name": "target",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Usage Threshold(%)",
+                        "name": "threshold",
+                        "in": "formData"
+                    },
+                    {
+                        "enum": [
+                            "warning",
+                            "critical"
+                        ],
+                        "type": "string",
+                        "description": "Alert Severity",
+                        "name": "severity",
+                        "in": "formData",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Duration Seconds Before Firing",
+                        "name": "duration",
+                        "in": "formData"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Success",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "type": "object",
-                                "properties": {
-                                    "pass_word": {
-                                        "type": "string"
-                                    }
-                                }
-                            }
+                            "type": "string"
                         }
                     },
                     "400": {
@@ -181,9 +212,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/glue/version": {
-            "get": {
-                "description": "Glue 의 버전을 보여줍니다.",
+        "/api/v1/alerts/rule/{rule_name}": {
+            "delete": {
+                "description": "경고 규칙과 해당 규칙으로 발생한 경고를 삭제합니다.",
                 "consumes": [
                     "application/x-www-form-urlencoded"
                 ],
@@ -191,14 +222,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Glue"
+                    "Alert"
+                ],
+                "summary": "Delete of Glue Alert Rule",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Alert Rule Name",
+                        "name": "rule_name",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Show Versions of Glue",
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Success",
                         "schema": {
-                            "$ref": "#/definitions/GlueVersion"
+                            "type": "string"
                         }
                     },
                     "400": {
@@ -222,9 +262,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/gluefs": {
+        "/api/v1/alerts/silence": {
             "get": {
-                "description": "GlueFS의 상태값과 리스트를 보여줍니다.",
+                "description": "등록된 경고 무시 설정 목록을 보여줍니다.",
                 "consumes": [
                     "application/x-www-form-urlencoded"
                 ],
@@ -232,14 +272,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "GlueFS"
+                    "Alert"
                 ],
-                "summary": "Show Status and List of Glue FS",
+                "summary": "Show List of Glue Alert Silences",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/FsStatus"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/AlertSilence"
+                            }
                         }
                     },
                     "400": {
@@ -262,8 +305,8 @@ const docTemplate = `{
                     }
                 }
             },
-            "put": {
-                "description": "GlueFS를 수정합니다.",
+            "post": {
+                "description": "지정한 시각까지 규칙의 경고를 무시합니다. instance 를 비워 두면 규칙의 모든 경고를 무시합니다.",
                 "consumes": [
                     "application/x-www-form-urlencoded"
                 ],
@@ -271,39 +314,40 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "GlueFS"
+                    "Alert"
                 ],
-                "summary": "Update of Glue FS",
+                "summary": "Create of Glue Alert Silence",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Glue FS Old Name",
-                        "name": "old_name",
+                        "description": "Alert Rule Name",
+                        "name": "rule_name",
                         "in": "formData",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "Glue FS New Name",
-                        "name": "new_name",
-                        "in": "formData",
-                        "required": true
+                        "description": "Alert Instance",
+                        "name": "instance",
+                        "in": "formData"
                     },
                     {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        },
-                        "collectionFormat": "multi",
-                        "description": "Glue FS Service Host Name",
-                        "name": "hosts",
+                        "type": "string",
+                        "description": "Silence End Time(RFC3339)",
+                        "name": "ends_at",
                         "in": "formData",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comment",
+                        "name": "comment",
+                        "in": "formData"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Success",
+                        "description": "Silence ID",
                         "schema": {
                             "type": "string"
                         }
@@ -329,9 +373,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/gluefs/info/{fs_name}": {
-            "get": {
-                "description": "GlueFS의 상세 정보를 보여줍니다.",
+        "/api/v1/alerts/silence/{silence_id}": {
+            "delete": {
+                "description": "경고 무시 설정을 삭제합니다.",
                 "consumes": [
                     "application/x-www-form-urlencoded"
                 ],
@@ -339,23 +383,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "GlueFS"
+                    "Alert"
                 ],
-                "summary": "Detail Info of Glue FS",
+                "summary": "Delete of Glue Alert Silence",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Glue FS Name",
-                        "name": "fs_name",
+                        "description": "Silence ID",
+                        "name": "silence_id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Success",
                         "schema": {
-                            "$ref": "#/definitions/FsGetInfo"
+                            "type": "string"
                         }
                     },
                     "400": {
@@ -379,9 +423,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/gluefs/subvolume/group": {
-            "get": {
-                "description": "GlueFS볼륨의 그룹에 대한 상세 정보 및 리스트를 보여줍니다.",
+        "/api/v1/apply": {
+            "post": {
+                "description": "YAML 로 작성된 원하는 상태(풀, 이미지, GlueFS, 서브 볼륨 그룹, NFS, SMB, iSCSI, NVMe-oF, RGW)를 현재 상태와 비교하여 계획을 보여주고 의존성 순서대로 적용합니다.",
                 "consumes": [
                     "application/x-www-form-urlencoded"
                 ],
@@ -389,23 +433,30 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "GlueFS-SubVolume-Group"
+                    "Apply"
                 ],
-                "summary": "Detail Info and List of Glue FS Volume Groups",
+                "summary": "Apply of Declarative Desired State",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Glue FS Volume Name",
-                        "name": "vol_name",
-                        "in": "query",
+                        "description": "Desired State YAML",
+                        "name": "spec",
+                        "in": "formData",
                         "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Only Show Plan",
+                        "name": "dry_run",
+                        "in": "formData"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/SubVolumeGroupList"
+                            "$ref": "#/definitions/ApplyResult"
                         }
                     },
                     "400": {
@@ -427,47 +478,35 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
-                "description": "GlueFS 볼륨의 그룹의 할당된 사이즈를 수정합니다.",
+            }
+        },
+        "/api/v1/backup": {
+            "get": {
+                "description": "NFS, SMB, iSCSI, NVMe-oF, RGW, GlueFS, 미러링 설정을 tar.gz 백업 번들로 내려받습니다. RGW 키, 미러링 피어 키, iSCSI 인증 비밀번호는 include_secrets 를 지정한 경우에만 평문으로 포함되므로 번들을 안전하게 보관해야 합니다.",
                 "consumes": [
                     "application/x-www-form-urlencoded"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/gzip"
                 ],
                 "tags": [
-                    "GlueFS-SubVolume-Group"
+                    "Backup"
                 ],
-                "summary": "Update Size of Glue FS Volume Group",
+                "summary": "Export of Glue Gateway Configuration Backup",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Glue FS Volume Name",
-                        "name": "vol_name",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Glue FS Volume Group Name",
-                        "name": "group_name",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Glue FS Volume Group New Size(default GB)",
-                        "name": "new_size",
-                        "in": "formData",
-                        "required": true
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Include RGW Keys, Mirror Peer Keys and iSCSI Passwords",
+                        "name": "include_secrets",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Success",
+                        "description": "OK",
                         "schema": {
-                            "type": "string"
+                            "type": "file"
                         }
                     },
                     "400": {
@@ -489,61 +528,48 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/api/v1/backup/restore": {
             "post": {
-                "description": "GlueFS 볼륨의 그룹을 생성합니다.",
+                "description": "백업 번들을 현재 클러스터에 복원합니다. 이미 존재하는 리소스는 충돌로 보고하고 변경하지 않습니다. 복원되는 모든 SMB 사용자에게는 같은 smb_password 가 설정되므로 복원 후 사용자별로 비밀번호를 변경해야 합니다. 비밀 정보 없이 만든 번들은 RGW 키를 새로 발급하고, 미러링 피어와 CHAP 인증을 사용하는 iSCSI 설정은 충돌로 보고합니다.",
                 "consumes": [
-                    "application/x-www-form-urlencoded"
+                    "multipart/form-data"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "GlueFS-SubVolume-Group"
+                    "Backup"
                 ],
-                "summary": "Create of Glue FS Volume Group",
+                "summary": "Restore of Glue Gateway Configuration Backup",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Glue FS Volume Name",
-                        "name": "vol_name",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Glue FS Volume Group Name",
-                        "name": "group_name",
+                        "type": "file",
+                        "description": "Glue Backup Bundle(tar.gz)",
+                        "name": "backup_file",
                         "in": "formData",
                         "required": true
                     },
                     {
-                        "type": "integer",
-                        "description": "Glue FS Volume Group Size(default GB)",
-                        "name": "size",
-                        "in": "formData",
-                        "required": true
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Only Report What Would Be Restored",
+                        "name": "dry_run",
+                        "in": "formData"
                     },
                     {
                         "type": "string",
-                        "description": "Glue FS Volume Group Data Pool Name",
-                        "name": "data_pool_name",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Glue FS Volume Group Permissions",
-                        "name": "mode",
-                        "in": "formData",
-                        "required": true
+                        "description": "Password For All Restored SMB Users",
+                        "name": "smb_password",
+                        "in": "formData"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Success",
+                        "description": "OK",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/BackupRestoreResult"
                         }
                     },
                     "400": {
@@ -565,9 +591,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "GlueFS 볼륨의 그룹을 삭제합니다.",
+            }
+        },
+        "/api/v1/cluster": {
+            "get": {
+                "description": "Glue API 에 등록된 클러스터 목록을 보여줍니다.",
                 "consumes": [
                     "application/x-www-form-urlencoded"
                 ],
@@ -575,37 +603,26 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "GlueFS-SubVolume-Group"
+                    "Cluster"
                 ],
-                "summary": "Delete of Glue FS Volume Group",
+                "summary": "Show List of Managed Clusters",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Glue FS Volume Name",
-                        "name": "vol_name",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Glue FS Volume Group Name",
-                        "name": "group_name",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Glue FS Volume Group Path",
-                        "name": "path",
-                        "in": "query",
-                        "required": true
+                        "description": "Cluster Name",
+                        "name": "cluster_name",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Success",
+                        "description": "OK",
                         "schema": {
-                            "type": "string"
+                            "description": "Glue API 에 등록된 클러스터 리스트 구조체",
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/Cluster"
+                            }
                         }
                     },
                     "400": {
@@ -627,11 +644,9 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/api/v1/gluefs/{fs_name}": {
+            },
             "post": {
-                "description": "GlueFS를 생성합니다.",
+                "description": "Glue API 에서 관리할 클러스터를 등록합니다. ceph.conf/keyring 경로 또는 SSH 접속 정보가 필요합니다.",
                 "consumes": [
                     "application/x-www-form-urlencoded"
                 ],
@@ -639,27 +654,54 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "GlueFS"
+                    "Cluster"
                 ],
-                "summary": "Create of Glue FS",
+                "summary": "Register of Managed Cluster",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Glue FS Name",
-                        "name": "fs_name",
-                        "in": "path",
+                        "description": "Cluster Name",
+                        "name": "cluster_name",
+                        "in": "formData",
                         "required": true
                     },
                     {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        },
-                        "collectionFormat": "multi",
-                        "description": "Glue FS Service Host Name",
-                        "name": "hosts",
-                        "in": "formData",
-                        "required": true
+                        "type": "string",
+                        "description": "ceph.conf Path",
+                        "name": "conf_path",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Keyring Path",
+                        "name": "keyring_path",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "default": "client.admin",
+                        "description": "Ceph Client Name",
+                        "name": "client_name",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "SSH Target Host",
+                        "name": "ssh_host",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "default": "/root/.ssh/id_rsa",
+                        "description": "SSH Private Key Path",
+                        "name": "ssh_key_path",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Glue Dashboard URL",
+                        "name": "dashboard_url",
+                        "in": "formData"
                     }
                 ],
                 "responses": {
@@ -688,9 +730,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/api/v1/cluster/{cluster_name}": {
             "delete": {
-                "description": "GlueFS를 삭제합니다.",
+                "description": "Glue API 에 등록된 클러스터를 삭제합니다.",
                 "consumes": [
                     "application/x-www-form-urlencoded"
                 ],
@@ -698,14 +742,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "GlueFS"
+                    "Cluster"
                 ],
-                "summary": "Delete of Glue FS",
+                "summary": "Unregister of Managed Cluster",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Glue FS Name",
-                        "name": "fs_name",
+                        "description": "Cluster Name",
+                        "name": "cluster_name",
                         "in": "path",
                         "required": true
                     }
@@ -738,33 +782,41 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/gwvm/cleanup/{hypervisorType}": {
-            "put": {
-                "description": "Gateway VM Pcs cluster를 Cleanup 합니다.",
+        "/api/v1/config": {
+            "get": {
+                "description": "ceph config dump 로 중앙 설정 목록을 보여줍니다. diff 를 지정하면 기본값과 다른 설정만 기본값과 함께 보여줍니다.",
                 "consumes": [
-                    "multipart/form-data"
+                    "application/x-www-form-urlencoded"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Gwvm"
+                    "Config"
                 ],
-                "summary": "Cleanup to Gateway VM",
+                "summary": "Show Central Configuration of Glue",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Hypervisor Type",
-                        "name": "hypervisorType",
-                        "in": "path",
-                        "required": true
+                        "description": "Config Section(global, mon, osd, osd.0, client.rgw ...)",
+                        "name": "section",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Show Only Values Different From Defaults",
+                        "name": "diff",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.GwvmMgmt"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/ConfigOption"
+                            }
                         }
                     },
                     "400": {
@@ -788,33 +840,66 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/gwvm/delete/{hypervisorType}": {
-            "delete": {
-                "description": "Gateway VM을 삭제합니다.",
+        "/api/v1/config/history": {
+            "get": {
+                "description": "모니터에 기록된 중앙 설정 변경 이력을 최신 순으로 보여줍니다.",
                 "consumes": [
-                    "multipart/form-data"
+                    "application/x-www-form-urlencoded"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Gwvm"
+                    "Config"
                 ],
-                "summary": "Delete to Gateway VM",
+                "summary": "Show Change History of Configuration",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Hypervisor Type",
-                        "name": "hypervisorType",
-                        "in": "path",
-                        "required": true
+                        "type": "integer",
+                        "default": 50,
+                        "description": "Number of History Entries",
+                        "name": "num",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.GwvmMgmt"
+                            "description": "ceph config log 설정 변경 이력 구조체",
+                            "type": "array",
+                            "items": {
+                                "type": "object",
+                                "properties": {
+                                    "changes": {
+                                        "type": "array",
+                                        "items": {
+                                            "type": "object",
+                                            "properties": {
+                                                "name": {
+                                                    "description": "who/option 형식입니다.",
+                                                    "type": "string"
+                                                },
+                                                "new_value": {
+                                                    "type": "string"
+                                                },
+                                                "previous_value": {
+                                                    "type": "string"
+                                                }
+                                            }
+                                        }
+                                    },
+                                    "name": {
+                                        "type": "string"
+                                    },
+                                    "timestamp": {
+                                        "type": "string"
+                                    },
+                                    "version": {
+                                        "type": "integer"
+                                    }
+                                }
+                            }
                         }
                     },
                     "400": {
@@ -838,9 +923,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/gwvm/detail/{hypervisorType}": {
+        "/api/v1/config/option": {
             "get": {
-                "description": "gwvm의 상세정보 상태를 보여줍니다.",
+                "description": "섹션 또는 데몬에 적용되는 설정 값과 타입, 범위, 기본값 등 설정 설명을 보여줍니다.",
                 "consumes": [
                     "application/x-www-form-urlencoded"
                 ],
@@ -848,15 +933,22 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Gwvm"
+                    "Config"
                 ],
-                "summary": "Detail of Gateway VM",
+                "summary": "Show Value of Configuration Option",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Hypervisor Type",
-                        "name": "hypervisorType",
-                        "in": "path",
+                        "description": "Config Section or Daemon(global, mon, osd, osd.0 ...)",
+                        "name": "who",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Config Option Name",
+                        "name": "name",
+                        "in": "query",
                         "required": true
                     }
                 ],
@@ -864,7 +956,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.GwvmMgmt"
+                            "$ref": "#/definitions/ConfigValue"
                         }
                     },
                     "400": {
@@ -886,42 +978,47 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/api/v1/gwvm/migrate/{hypervisorType}": {
+            },
             "put": {
-                "description": "Gateway VM을 Pcs cluster내 다른 호스트로 마이그레이션 합니다.",
+                "description": "섹션 또는 데몬의 설정 값을 변경합니다. ceph config help 의 타입, 범위, 허용 값으로 값을 검증합니다.",
                 "consumes": [
-                    "multipart/form-data"
+                    "application/x-www-form-urlencoded"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Gwvm"
+                    "Config"
                 ],
-                "summary": "VmMigrate to Gateway VM",
+                "summary": "Set Value of Configuration Option",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Hypervisor Type",
-                        "name": "hypervisorType",
-                        "in": "path",
+                        "description": "Config Section or Daemon(global, mon, osd, osd.0 ...)",
+                        "name": "who",
+                        "in": "formData",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "Migration Target Host",
-                        "name": "target",
+                        "description": "Config Option Name",
+                        "name": "name",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Config Option Value",
+                        "name": "value",
                         "in": "formData",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Success",
                         "schema": {
-                            "$ref": "#/definitions/model.GwvmMgmt"
+                            "type": "string"
                         }
                     },
                     "400": {
@@ -943,35 +1040,40 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/api/v1/gwvm/start/{hypervisorType}": {
-            "put": {
-                "description": "Gateway VM을 실행합니다.",
+            },
+            "delete": {
+                "description": "중앙 설정에서 섹션 또는 데몬의 설정을 지워 기본값으로 되돌립니다.",
                 "consumes": [
-                    "multipart/form-data"
+                    "application/x-www-form-urlencoded"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Gwvm"
+                    "Config"
                 ],
-                "summary": "Start to Gateway VM",
+                "summary": "Reset Configuration Option To Default",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Hypervisor Type",
-                        "name": "hypervisorType",
-                        "in": "path",
+                        "description": "Config Section or Daemon(global, mon, osd, osd.0 ...)",
+                        "name": "who",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Config Option Name",
+                        "name": "name",
+                        "in": "query",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Success",
                         "schema": {
-                            "$ref": "#/definitions/model.GwvmMgmt"
+                            "type": "string"
                         }
                     },
                     "400": {
@@ -995,33 +1097,40 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/gwvm/stop/{hypervisorType}": {
-            "put": {
-                "description": "Gateway VM을 정지합니다.",
+        "/api/v1/config/revert": {
+            "post": {
+                "description": "설정 변경 이력의 한 버전에서 바뀐 설정을 이전 값으로 되돌립니다. 이전 값이 없던 설정은 중앙 설정에서 지웁니다.",
                 "consumes": [
-                    "multipart/form-data"
+                    "application/x-www-form-urlencoded"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Gwvm"
+                    "Config"
                 ],
-                "summary": "Stop to Gateway VM",
+                "summary": "Revert Configuration Option Change",
                 "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Config Version From History",
+                        "name": "version",
+                        "in": "formData",
+                        "required": true
+                    },
                     {
                         "type": "string",
-                        "description": "Hypervisor Type",
-                        "name": "hypervisorType",
-                        "in": "path",
+                        "description": "Changed Option(who/name)",
+                        "name": "name",
+                        "in": "formData",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Success",
                         "schema": {
-                            "$ref": "#/definitions/model.GwvmMgmt"
+                            "type": "string"
                         }
                     },
                     "400": {
@@ -1045,9 +1154,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/gwvm/{hypervisorType}": {
+        "/api/v1/crash": {
             "get": {
-                "description": "gwvm의 상태를 보여줍니다.",
+                "description": "crash 모듈에 수집된 데몬 장애 보고서 목록을 보여줍니다. 기본으로 보관되지 않은 보고서만 보여줍니다.",
                 "consumes": [
                     "application/x-www-form-urlencoded"
                 ],
@@ -1055,23 +1164,26 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Gwvm"
+                    "Crash"
                 ],
-                "summary": "State of Gateway VM",
+                "summary": "Show List of Glue Daemon Crash Reports",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Hypervisor Type",
-                        "name": "hypervisorType",
-                        "in": "path",
-                        "required": true
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Include Archived Crash Reports",
+                        "name": "archived",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.GwvmMgmt"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/Crash"
+                            }
                         }
                     },
                     "400": {
@@ -1094,61 +1206,24 @@ const docTemplate = `{
                     }
                 }
             },
-            "post": {
-                "description": "gwvm을 생성합니다.",
+            "put": {
+                "description": "모든 데몬 장애 보고서를 확인 처리하여 RECENT_CRASH 경고를 해소합니다.",
                 "consumes": [
-                    "multipart/form-data"
+                    "application/x-www-form-urlencoded"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Gwvm"
+                    "Crash"
                 ],
-                "summary": "Setup Gateway Vm",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Hypervisor Type",
-                        "name": "hypervisorType",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Gwvm Management Nic Parent",
-                        "name": "gwvmMngtNicParent",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Gwvm Management Nic Ip",
-                        "name": "gwvmMngtNicIp",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Gwvm Storage Nic Parent",
-                        "name": "gwvmStorageNicParent",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Gwvm Storage Nic Ip",
-                        "name": "gwvmStorageNicIp",
-                        "in": "formData",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/model.GwvmMgmt"
-                        }
+                "summary": "Archive of All Glue Daemon Crash Reports",
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "type": "string"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -1171,9 +1246,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/image": {
+        "/api/v1/crash/{crash_id}": {
             "get": {
-                "description": "Glue 스토리지 풀의 이미지 목록을 보여줍니다.",
+                "description": "데몬 장애 보고서의 assert 정보와 backtrace 를 보여줍니다.",
                 "consumes": [
                     "application/x-www-form-urlencoded"
                 ],
@@ -1181,27 +1256,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Image"
+                    "Crash"
                 ],
-                "summary": "Show List or Info Images of Pool",
+                "summary": "Show Detail of Glue Daemon Crash Report",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Glue Pool Name",
-                        "name": "pool_name",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Glue Image Name",
-                        "name": "image_name",
-                        "in": "query"
+                        "description": "Crash ID",
+                        "name": "crash_id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
-                        "schema": {}
+                        "schema": {
+                            "$ref": "#/definitions/Crash"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -1223,8 +1295,8 @@ const docTemplate = `{
                     }
                 }
             },
-            "post": {
-                "description": "Glue 스토리지 풀의 이미지를 생성합니다.",
+            "put": {
+                "description": "데몬 장애 보고서를 확인 처리하여 RECENT_CRASH 경고에서 제외합니다. 보고서는 삭제되지 않습니다.",
                 "consumes": [
                     "application/x-www-form-urlencoded"
                 ],
@@ -1232,29 +1304,15 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Image"
+                    "Crash"
                 ],
-                "summary": "Create Images of Pool",
+                "summary": "Archive of Glue Daemon Crash Report",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Glue Image Name",
-                        "name": "image_name",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Glue Pool Name",
-                        "name": "pool_name",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Image Size(default:GB)",
-                        "name": "size",
-                        "in": "formData",
+                        "description": "Crash ID",
+                        "name": "crash_id",
+                        "in": "path",
                         "required": true
                     }
                 ],
@@ -1284,9 +1342,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Glue 스토리지 풀의 이미지를 삭제합니다.",
+            }
+        },
+        "/api/v1/crush": {
+            "get": {
+                "description": "Glue 의 CRUSH 트리(root, 호스트, OSD)와 디바이스 클래스 목록을 보여줍니다.",
                 "consumes": [
                     "application/x-www-form-urlencoded"
                 ],
@@ -1294,30 +1354,58 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Image"
+                    "Crush"
                 ],
-                "summary": "Delete Images of Pool",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Glue Image Name",
-                        "name": "image_name",
-                        "in": "query",
-                        "required": true
+                "summary": "Show CRUSH Tree of Glue",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/CrushTree"
+                        }
                     },
-                    {
-                        "type": "string",
-                        "description": "Glue Pool Name",
-                        "name": "pool_name",
-                        "in": "query",
-                        "required": true
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
                     }
+                }
+            }
+        },
+        "/api/v1/crush/rule": {
+            "get": {
+                "description": "Glue 의 CRUSH 규칙 목록과 각 규칙의 root, 장애 도메인, 디바이스 클래스, 사용하는 풀을 보여줍니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Crush"
                 ],
+                "summary": "Show List of CRUSH Rules",
                 "responses": {
                     "200": {
-                        "description": "Success",
+                        "description": "OK",
                         "schema": {
-                            "type": "string"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/CrushRule"
+                            }
                         }
                     },
                     "400": {
@@ -1339,11 +1427,9 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/api/v1/ingress": {
-            "put": {
-                "description": "Glue Ingress Service를 수정합니다.",
+            },
+            "post": {
+                "description": "root 아래에서 장애 도메인 단위로 복제본을 배치하는 CRUSH 규칙을 생성합니다. 디바이스 클래스를 지정하면 해당 클래스(ssd, hdd 등)의 OSD 만 사용합니다.",
                 "consumes": [
                     "application/x-www-form-urlencoded"
                 ],
@@ -1351,66 +1437,35 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Ingress"
+                    "Crush"
                 ],
-                "summary": "Update of Glue Ingress Service",
+                "summary": "Create of CRUSH Rule",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "NFS or RGW Ingress Service Name",
-                        "name": "service_id",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        },
-                        "collectionFormat": "multi",
-                        "description": "NFS or RGW Ingress Host Name",
-                        "name": "hosts",
+                        "description": "CRUSH Rule Name",
+                        "name": "rule_name",
                         "in": "formData",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "NFS or RGW Cluster Type",
-                        "name": "backend_service",
-                        "in": "formData",
-                        "required": true
+                        "default": "default",
+                        "description": "CRUSH Root",
+                        "name": "root",
+                        "in": "formData"
                     },
                     {
                         "type": "string",
-                        "description": "NFS or RGW Ingress Virtual Ip",
-                        "name": "virtual_ip",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "maximum": 65535,
-                        "type": "integer",
-                        "description": "NFS or RGW Ingress Access Port",
-                        "name": "frontend_port",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "maximum": 65535,
-                        "type": "integer",
-                        "description": "NFS or RGW Ingress HA Proxy for Load Balancer Port",
-                        "name": "monitor_port",
-                        "in": "formData",
-                        "required": true
+                        "default": "host",
+                        "description": "Failure Domain Type",
+                        "name": "failure_domain",
+                        "in": "formData"
                     },
                     {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        },
-                        "collectionFormat": "multi",
-                        "description": "NFS or RGW Ingress Vitual IP of CIDR Networks",
-                        "name": "virtual_interface_networks",
+                        "type": "string",
+                        "description": "Device Class",
+                        "name": "device_class",
                         "in": "formData"
                     }
                 ],
@@ -1440,9 +1495,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
-                "description": "Glue Ingress Service를 생성합니다.",
+            }
+        },
+        "/api/v1/crush/rule/{rule_name}": {
+            "delete": {
+                "description": "풀에서 사용하지 않는 CRUSH 규칙을 삭제합니다.",
                 "consumes": [
                     "application/x-www-form-urlencoded"
                 ],
@@ -1450,67 +1507,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Ingress"
+                    "Crush"
                 ],
-                "summary": "Create of Glue Ingress Service",
+                "summary": "Delete of CRUSH Rule",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "NFS or RGW Ingress Service Name",
-                        "name": "service_id",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        },
-                        "collectionFormat": "multi",
-                        "description": "NFS or RGW Ingress Host Name",
-                        "name": "hosts",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "NFS or RGW Cluster Type",
-                        "name": "backend_service",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "NFS or RGW Ingress Virtual Ip",
-                        "name": "virtual_ip",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "maximum": 65535,
-                        "type": "integer",
-                        "description": "NFS or RGW Ingress Access Port",
-                        "name": "frontend_port",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "maximum": 65535,
-                        "type": "integer",
-                        "description": "NFS or RGW Ingress HA Proxy for Load Balancer Port",
-                        "name": "monitor_port",
-                        "in": "formData",
+                        "description": "CRUSH Rule Name",
+                        "name": "rule_name",
+                        "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        },
-                        "collectionFormat": "multi",
-                        "description": "NFS or RGW Ingress Vitual IP of CIDR Networks",
-                        "name": "virtual_interface_networks",
-                        "in": "formData"
                     }
                 ],
                 "responses": {
@@ -1541,9 +1547,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/iscsi": {
-            "put": {
-                "description": "Iscsi 서비스 데몬을 수정합니다.",
+        "/api/v1/daemon": {
+            "get": {
+                "description": "ceph orch ps 로 Glue 데몬 목록(호스트, 상태, 버전, 메모리, 컨테이너 이미지)을 보여줍니다. 서비스, 호스트, 데몬 종류로 필터링할 수 있습니다.",
                 "consumes": [
                     "application/x-www-form-urlencoded"
                 ],
@@ -1551,69 +1557,37 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Iscsi"
+                    "Daemon"
                 ],
-                "summary": "Update of Iscsi Servcie Daemon",
+                "summary": "Show List of Glue Daemons",
                 "parameters": [
-                    {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        },
-                        "collectionFormat": "multi",
-                        "description": "Host Name",
-                        "name": "hosts",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "ISCSI Service Name",
-                        "name": "service_id",
-                        "in": "formData",
-                        "required": true
-                    },
                     {
                         "type": "string",
-                        "description": "Pool Name",
-                        "name": "pool",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "maximum": 65535,
-                        "type": "integer",
-                        "description": "ISCSI API Port",
-                        "name": "api_port",
-                        "in": "formData",
-                        "required": true
+                        "description": "Glue Service Name",
+                        "name": "service_name",
+                        "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "ISCSI API User",
-                        "name": "api_user",
-                        "in": "formData",
-                        "required": true
+                        "description": "Host Name",
+                        "name": "hostname",
+                        "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "ISCSI API Password",
-                        "name": "api_password",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Iscsi Service Daemon Count",
-                        "name": "count",
-                        "in": "formData"
+                        "description": "Daemon Type",
+                        "name": "daemon_type",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Success",
+                        "description": "OK",
                         "schema": {
-                            "type": "string"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/Daemon"
+                            }
                         }
                     },
                     "400": {
@@ -1635,9 +1609,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/api/v1/daemon/{daemon_name}": {
             "post": {
-                "description": "Iscsi 서비스 데몬을 생성합니다.",
+                "description": "서비스 전체가 아닌 Glue 데몬 하나를 시작, 중지, 재시작하거나 다시 배포합니다.",
                 "consumes": [
                     "application/x-www-form-urlencoded"
                 ],
@@ -1645,62 +1621,29 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Iscsi"
+                    "Daemon"
                 ],
-                "summary": "Create of Iscsi Servcie Daemon",
+                "summary": "Control of Glue Daemon",
                 "parameters": [
-                    {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        },
-                        "collectionFormat": "multi",
-                        "description": "Host Name",
-                        "name": "hosts",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "ISCSI Service Name",
-                        "name": "service_id",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Pool Name",
-                        "name": "pool",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "maximum": 65535,
-                        "type": "integer",
-                        "description": "ISCSI API Port",
-                        "name": "api_port",
-                        "in": "formData",
-                        "required": true
-                    },
                     {
                         "type": "string",
-                        "description": "ISCSI API User",
-                        "name": "api_user",
-                        "in": "formData",
+                        "description": "Daemon Name",
+                        "name": "daemon_name",
+                        "in": "path",
                         "required": true
                     },
                     {
+                        "enum": [
+                            "start",
+                            "stop",
+                            "restart",
+                            "redeploy"
+                        ],
                         "type": "string",
-                        "description": "ISCSI API Password",
-                        "name": "api_password",
-                        "in": "formData",
+                        "description": "Daemon Control",
+                        "name": "control",
+                        "in": "query",
                         "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Iscsi Service Daemon Count",
-                        "name": "count",
-                        "in": "formData"
                     }
                 ],
                 "responses": {
@@ -1731,9 +1674,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/iscsi/discovery": {
+        "/api/v1/ecprofile": {
             "get": {
-                "description": "Iscsi 계정 정보를 가져옵니다.",
+                "description": "Glue EC 프로파일 목록 또는 상세정보와 이를 사용하는 풀을 보여줍니다.",
                 "consumes": [
                     "application/x-www-form-urlencoded"
                 ],
@@ -1741,14 +1684,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Iscsi"
+                    "ErasureCodeProfile"
+                ],
+                "summary": "Show List or Info of Erasure Code Profiles",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Erasure Code Profile Name",
+                        "name": "profile_name",
+                        "in": "query"
+                    }
                 ],
-                "summary": "Show of Iscsi Discovery Auth Details",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/Auth"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/ErasureCodeProfile"
+                            }
                         }
                     },
                     "400": {
@@ -1771,8 +1725,8 @@ const docTemplate = `{
                     }
                 }
             },
-            "put": {
-                "description": "Iscsi 계정 정보를 수정합니다.",
+            "post": {
+                "description": "Glue EC 프로파일을 생성합니다. k+m 이 장애 도메인(host)의 호스트 수 또는 OSD 수보다 크면 생성하지 않습니다.",
                 "consumes": [
                     "application/x-www-form-urlencoded"
                 ],
@@ -1780,48 +1734,74 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Iscsi"
+                    "ErasureCodeProfile"
                 ],
-                "summary": "Update of Iscsi Discovery Auth Details",
+                "summary": "Create of Erasure Code Profile",
                 "parameters": [
                     {
-                        "maxLength": 64,
-                        "minLength": 8,
                         "type": "string",
-                        "description": "Iscsi Discovery Authorization Username",
-                        "name": "user",
+                        "description": "Erasure Code Profile Name",
+                        "name": "profile_name",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Data Chunks",
+                        "name": "k",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Coding Chunks",
+                        "name": "m",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "jerasure",
+                            "isa",
+                            "clay"
+                        ],
+                        "type": "string",
+                        "default": "jerasure",
+                        "description": "Erasure Code Plugin",
+                        "name": "plugin",
                         "in": "formData"
                     },
                     {
-                        "maxLength": 16,
-                        "minLength": 12,
                         "type": "string",
-                        "description": "Iscsi Discovery Authorization Password",
-                        "name": "password",
+                        "description": "Erasure Code Technique",
+                        "name": "technique",
                         "in": "formData"
                     },
                     {
-                        "maxLength": 64,
-                        "minLength": 8,
                         "type": "string",
-                        "description": "Iscsi Discovery Authorization Mutual Username",
-                        "name": "mutual_user",
+                        "default": "host",
+                        "description": "CRUSH Failure Domain",
+                        "name": "crush_failure_domain",
                         "in": "formData"
                     },
                     {
-                        "maxLength": 16,
-                        "minLength": 12,
                         "type": "string",
-                        "description": "Iscsi Discovery Authorization Mutual Password",
-                        "name": "mutual_password",
+                        "description": "CRUSH Device Class",
+                        "name": "crush_device_class",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "CRUSH Root",
+                        "name": "crush_root",
                         "in": "formData"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Success",
                         "schema": {
-                            "$ref": "#/definitions/Auth"
+                            "type": "string"
                         }
                     },
                     "400": {
@@ -1845,9 +1825,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/iscsi/target": {
-            "get": {
-                "description": "Iscsi 타겟 리스트를 가져옵니다.",
+        "/api/v1/ecprofile/{profile_name}": {
+            "put": {
+                "description": "풀에서 사용하지 않는 Glue EC 프로파일을 변경합니다. 풀이 생성된 뒤에는 EC 설정을 바꿀 수 없으므로 사용 중인 프로파일은 변경하지 않습니다.",
                 "consumes": [
                     "application/x-www-form-urlencoded"
                 ],
@@ -1855,21 +1835,75 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "IscsiTarget"
+                    "ErasureCodeProfile"
                 ],
-                "summary": "Show List of Iscsi Target",
+                "summary": "Update of Erasure Code Profile",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Iscsi Target IQN Name",
-                        "name": "iqn_id",
-                        "in": "query"
+                        "description": "Erasure Code Profile Name",
+                        "name": "profile_name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Data Chunks",
+                        "name": "k",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Coding Chunks",
+                        "name": "m",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "jerasure",
+                            "isa",
+                            "clay"
+                        ],
+                        "type": "string",
+                        "default": "jerasure",
+                        "description": "Erasure Code Plugin",
+                        "name": "plugin",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Erasure Code Technique",
+                        "name": "technique",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "default": "host",
+                        "description": "CRUSH Failure Domain",
+                        "name": "crush_failure_domain",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "CRUSH Device Class",
+                        "name": "crush_device_class",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "CRUSH Root",
+                        "name": "crush_root",
+                        "in": "formData"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
-                        "schema": {}
+                        "description": "Success",
+                        "schema": {
+                            "type": "string"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -1891,8 +1925,8 @@ const docTemplate = `{
                     }
                 }
             },
-            "put": {
-                "description": "Iscsi 타겟을 수정합니다.",
+            "delete": {
+                "description": "풀에서 사용하지 않는 Glue EC 프로파일을 삭제합니다.",
                 "consumes": [
                     "application/x-www-form-urlencoded"
                 ],
@@ -1900,111 +1934,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "IscsiTarget"
+                    "ErasureCodeProfile"
                 ],
-                "summary": "Update of Iscsi Target",
+                "summary": "Delete of Erasure Code Profile",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Iscsi Target Old IQN Name",
-                        "name": "iqn_id",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Iscsi Target New IQN Name",
-                        "name": "new_iqn_id",
-                        "in": "formData",
+                        "description": "Erasure Code Profile Name",
+                        "name": "profile_name",
+                        "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "array",
-                        "items": {
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
                             "type": "string"
-                        },
-                        "collectionFormat": "multi",
-                        "description": "Gateway Host Name",
-                        "name": "hosts",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        },
-                        "collectionFormat": "multi",
-                        "description": "Gateway Host IP Address",
-                        "name": "ip_address",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        },
-                        "collectionFormat": "multi",
-                        "description": "Glue Pool Name",
-                        "name": "pool_name",
-                        "in": "formData"
-                    },
-                    {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        },
-                        "collectionFormat": "multi",
-                        "description": "Glue Image Name",
-                        "name": "image_name",
-                        "in": "formData"
-                    },
-                    {
-                        "type": "boolean",
-                        "default": false,
-                        "description": "Iscsi Authentication",
-                        "name": "acl_enabled",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "maxLength": 64,
-                        "minLength": 8,
-                        "type": "string",
-                        "description": "Iscsi Auth User",
-                        "name": "username",
-                        "in": "formData"
-                    },
-                    {
-                        "maxLength": 16,
-                        "minLength": 12,
-                        "type": "string",
-                        "description": "Iscsi Auth Password",
-                        "name": "password",
-                        "in": "formData"
-                    },
-                    {
-                        "maxLength": 64,
-                        "minLength": 8,
-                        "type": "string",
-                        "description": "Iscsi Auth Mutual User",
-                        "name": "mutual_username",
-                        "in": "formData"
-                    },
-                    {
-                        "maxLength": 16,
-                        "minLength": 12,
-                        "type": "string",
-                        "description": "Iscsi Auth Mutaul Password",
-                        "name": "mutual_password",
-                        "in": "formData"
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {}
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -2025,9 +1972,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
-                "description": "Iscsi 타겟을 생성합니다.",
+            }
+        },
+        "/api/v1/glue": {
+            "get": {
+                "description": "Glue 의 상태값을 보여줍니다.",
                 "consumes": [
                     "application/x-www-form-urlencoded"
                 ],
@@ -2035,105 +1984,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "IscsiTarget"
-                ],
-                "summary": "Create of Iscsi Target",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Iscsi Target IQN Name",
-                        "name": "iqn_id",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        },
-                        "collectionFormat": "multi",
-                        "description": "Gateway Host Name",
-                        "name": "hosts",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        },
-                        "collectionFormat": "multi",
-                        "description": "Gateway Host IP Address",
-                        "name": "ip_address",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        },
-                        "collectionFormat": "multi",
-                        "description": "Glue Pool Name",
-                        "name": "pool_name",
-                        "in": "formData"
-                    },
-                    {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        },
-                        "collectionFormat": "multi",
-                        "description": "Glue Image Name",
-                        "name": "image_name",
-                        "in": "formData"
-                    },
-                    {
-                        "type": "boolean",
-                        "default": false,
-                        "description": "Iscsi Authentication",
-                        "name": "acl_enabled",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "maxLength": 64,
-                        "minLength": 8,
-                        "type": "string",
-                        "description": "Iscsi Auth User",
-                        "name": "username",
-                        "in": "formData"
-                    },
-                    {
-                        "maxLength": 16,
-                        "minLength": 12,
-                        "type": "string",
-                        "description": "Iscsi Auth Password",
-                        "name": "password",
-                        "in": "formData"
-                    },
-                    {
-                        "maxLength": 64,
-                        "minLength": 8,
-                        "type": "string",
-                        "description": "Iscsi Auth Mutual User",
-                        "name": "mutual_username",
-                        "in": "formData"
-                    },
-                    {
-                        "maxLength": 16,
-                        "minLength": 12,
-                        "type": "string",
-                        "description": "Iscsi Auth Mutaul Password",
-                        "name": "mutual_password",
-                        "in": "formData"
-                    }
+                    "Glue"
                 ],
+                "summary": "Show Status of Glue",
                 "responses": {
                     "200": {
-                        "description": "Success",
+                        "description": "OK",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/GlueStatus"
                         }
                     },
                     "400": {
@@ -2155,9 +2013,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Iscsi 타겟을 삭제합니다.",
+            }
+        },
+        "/api/v1/glue/health/mute": {
+            "get": {
+                "description": "무시 중인 Glue 상태 점검 항목 목록을 보여줍니다.",
                 "consumes": [
                     "application/x-www-form-urlencoded"
                 ],
@@ -2165,23 +2025,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "IscsiTarget"
-                ],
-                "summary": "Delete of Iscsi Target",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Iscsi Target IQN Name",
-                        "name": "iqn_id",
-                        "in": "query",
-                        "required": true
-                    }
+                    "Glue"
                 ],
+                "summary": "Show List of Muted Health Checks",
                 "responses": {
                     "200": {
-                        "description": "Success",
+                        "description": "OK",
                         "schema": {
-                            "type": "string"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/HealthMute"
+                            }
                         }
                     },
                     "400": {
@@ -2203,11 +2057,9 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/api/v1/iscsi/target/purge": {
-            "delete": {
-                "description": "Iscsi 타겟을 파괴합니다.",
+            },
+            "post": {
+                "description": "장애 조치 중 반복되는 상태 점검 항목을 무시합니다. ttl 을 지정하면 해당 기간이 지난 뒤 자동으로 해제됩니다.",
                 "consumes": [
                     "application/x-www-form-urlencoded"
                 ],
@@ -2215,16 +2067,29 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "IscsiTarget"
+                    "Glue"
                 ],
-                "summary": "Purge of Iscsi Target",
+                "summary": "Mute of Health Check",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Iscsi Target IQN Name",
-                        "name": "iqn_id",
-                        "in": "query",
+                        "description": "Health Check Code",
+                        "name": "code",
+                        "in": "formData",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Mute Duration(30m, 2h, 1d, 1w ...)",
+                        "name": "ttl",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Keep Mute After Health Check Is Cleared",
+                        "name": "sticky",
+                        "in": "formData"
                     }
                 ],
                 "responses": {
@@ -2255,9 +2120,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/mirror": {
-            "get": {
-                "description": "Glue 의 미러링 상태를 보여줍니다.",
+        "/api/v1/glue/health/mute/{code}": {
+            "delete": {
+                "description": "상태 점검 항목의 무시를 해제합니다.",
                 "consumes": [
                     "application/x-www-form-urlencoded"
                 ],
@@ -2265,14 +2130,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Mirror"
+                    "Glue"
+                ],
+                "summary": "Unmute of Health Check",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Health Check Code",
+                        "name": "code",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Show Status of Mirror",
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Success",
                         "schema": {
-                            "$ref": "#/definitions/MirrorStatus"
+                            "type": "string"
                         }
                     },
                     "400": {
@@ -2294,82 +2168,43 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
-                "description": "Glue 의 미러링 클러스터를 설정합니다.",
+            }
+        },
+        "/api/v1/glue/hosts": {
+            "get": {
+                "description": "Glue 호스트 리스트를 보여줍니다.",
                 "consumes": [
-                    "multipart/form-data"
+                    "application/x-www-form-urlencoded"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Mirror"
-                ],
-                "summary": "Setup Mirroring Cluster",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Local Cluster Name",
-                        "name": "localClusterName",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Remote Cluster Name",
-                        "name": "remoteClusterName",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Remote Cluster Host Address",
-                        "name": "host",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "file",
-                        "description": "Remote Cluster PrivateKey",
-                        "name": "privateKeyFile",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Pool Name for Mirroring",
-                        "name": "mirrorPool",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Mold Url",
-                        "name": "moldUrl",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Mold Api Key",
-                        "name": "moldApiKey",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Mold Secret Key",
-                        "name": "moldSecretKey",
-                        "in": "formData",
-                        "required": true
-                    }
+                    "Hosts"
                 ],
+                "summary": "Show List of Glue Hosts",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/MirrorSetup"
+                            "type": "array",
+                            "items": {
+                                "type": "object",
+                                "properties": {
+                                    "addr": {
+                                        "type": "string"
+                                    },
+                                    "hostname": {
+                                        "type": "string"
+                                    },
+                                    "ip_address": {
+                                        "type": "string"
+                                    },
+                                    "status": {
+                                        "type": "string"
+                                    }
+                                }
+                            }
                         }
                     },
                     "400": {
@@ -2391,9 +2226,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
-                "description": "Glue 의 미러링 클러스터 설정을 변경합니다.",
+            }
+        },
+        "/api/v1/glue/pw": {
+            "get": {
+                "description": "Glue 사용자의 비밀번호 암호화 값을 생성합니다.",
                 "consumes": [
                     "application/x-www-form-urlencoded"
                 ],
@@ -2401,36 +2238,15 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Mirror"
+                    "Passwd"
                 ],
-                "summary": "Put Mirroring Cluster",
+                "summary": "Creates the glue user's password encryption value.",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Mirroring Schedule Interval",
-                        "name": "interval",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Mold API request URL",
-                        "name": "moldUrl",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Mold Admin Api Key",
-                        "name": "moldApiKey",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Mold Admin Secret Key",
-                        "name": "moldSecretKey",
-                        "in": "formData",
+                        "description": "Glue User's Password",
+                        "name": "pass_word",
+                        "in": "query",
                         "required": true
                     }
                 ],
@@ -2438,7 +2254,15 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/Mold"
+                            "type": "array",
+                            "items": {
+                                "type": "object",
+                                "properties": {
+                                    "pass_word": {
+                                        "type": "string"
+                                    }
+                                }
+                            }
                         }
                     },
                     "400": {
@@ -2460,47 +2284,26 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Glue 의 미러링 클러스터를 제거합니다.",
+            }
+        },
+        "/api/v1/glue/version": {
+            "get": {
+                "description": "Glue 의 버전을 보여줍니다.",
                 "consumes": [
-                    "multipart/form-data"
+                    "application/x-www-form-urlencoded"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Mirror"
-                ],
-                "summary": "Delete Mirroring Cluster",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Remote Cluster Host Address",
-                        "name": "host",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "file",
-                        "description": "Remote Cluster PrivateKey",
-                        "name": "privateKeyFile",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Pool Name for Mirroring",
-                        "name": "mirrorPool",
-                        "in": "formData",
-                        "required": true
-                    }
+                    "Glue"
                 ],
+                "summary": "Show Versions of Glue",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/MirrorSetup"
+                            "$ref": "#/definitions/GlueVersion"
                         }
                     },
                     "400": {
@@ -2524,9 +2327,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/mirror/{mirrorPool}": {
-            "post": {
-                "description": "Glue 의 미러링 클러스터를 활성화합니다.",
+        "/api/v1/gluefs": {
+            "get": {
+                "description": "GlueFS의 상태값과 리스트를 보여줍니다.",
                 "consumes": [
                     "application/x-www-form-urlencoded"
                 ],
@@ -2534,51 +2337,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Mirror"
-                ],
-                "summary": "Enable Mirroring Cluster",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Local Cluster Name",
-                        "name": "localClusterName",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Remote Cluster Name",
-                        "name": "remoteClusterName",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Remote Cluster Host Address",
-                        "name": "host",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "file",
-                        "description": "Remote Cluster PrivateKey",
-                        "name": "privateKeyFile",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Pool Name for Mirroring",
-                        "name": "mirrorPool",
-                        "in": "formData",
-                        "required": true
-                    }
+                    "GlueFS"
                 ],
+                "summary": "Show Status and List of Glue FS",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/MirrorSetup"
+                            "$ref": "#/definitions/FsStatus"
                         }
                     },
                     "400": {
@@ -2601,8 +2367,8 @@ const docTemplate = `{
                     }
                 }
             },
-            "delete": {
-                "description": "Glue 의 미러링 클러스터를 비활성화합니다.",
+            "put": {
+                "description": "GlueFS를 수정합니다.",
                 "consumes": [
                     "application/x-www-form-urlencoded"
                 ],
@@ -2610,37 +2376,41 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Mirror"
+                    "GlueFS"
                 ],
-                "summary": "Disable Mirroring Cluster",
+                "summary": "Update of Glue FS",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Remote Cluster Host Address",
-                        "name": "host",
+                        "description": "Glue FS Old Name",
+                        "name": "old_name",
                         "in": "formData",
                         "required": true
                     },
                     {
-                        "type": "file",
-                        "description": "Remote Cluster PrivateKey",
-                        "name": "privateKeyFile",
+                        "type": "string",
+                        "description": "Glue FS New Name",
+                        "name": "new_name",
                         "in": "formData",
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "Pool Name for Mirroring",
-                        "name": "mirrorPool",
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Glue FS Service Host Name",
+                        "name": "hosts",
                         "in": "formData",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Success",
                         "schema": {
-                            "$ref": "#/definitions/MirrorSetup"
+                            "type": "string"
                         }
                     },
                     "400": {
@@ -2664,9 +2434,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/mirror/garbage": {
-            "delete": {
-                "description": "Glue 의 미러링 클러스터 가비지를 삭제합니다.",
+        "/api/v1/gluefs/info/{fs_name}": {
+            "get": {
+                "description": "GlueFS의 상세 정보를 보여줍니다.",
                 "consumes": [
                     "application/x-www-form-urlencoded"
                 ],
@@ -2674,14 +2444,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Mirror"
+                    "GlueFS"
                 ],
-                "summary": "Delete Garbage Mirroring Cluster",
+                "summary": "Detail Info of Glue FS",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Pool Name for Mirroring",
-                        "name": "mirrorPool",
+                        "description": "Glue FS Name",
+                        "name": "fs_name",
                         "in": "path",
                         "required": true
                     }
@@ -2690,7 +2460,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/Message"
+                            "$ref": "#/definitions/FsGetInfo"
                         }
                     },
                     "400": {
@@ -2714,9 +2484,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/mirror/image/{mirrorPool}": {
+        "/api/v1/gluefs/subvolume/group": {
             "get": {
-                "description": "미러링중인 이미지의 목록과 상태를 보여줍니다.",
+                "description": "GlueFS볼륨의 그룹에 대한 상세 정보 및 리스트를 보여줍니다.",
                 "consumes": [
                     "application/x-www-form-urlencoded"
                 ],
@@ -2724,15 +2494,15 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Mirror"
+                    "GlueFS-SubVolume-Group"
                 ],
-                "summary": "Show List of Mirrored Snapshot",
+                "summary": "Detail Info and List of Glue FS Volume Groups",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Pool Name for Mirroring",
-                        "name": "mirrorPool",
-                        "in": "path",
+                        "description": "Glue FS Volume Name",
+                        "name": "vol_name",
+                        "in": "query",
                         "required": true
                     }
                 ],
@@ -2740,7 +2510,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/MirrorList"
+                            "$ref": "#/definitions/SubVolumeGroupList"
                         }
                     },
                     "400": {
@@ -2762,11 +2532,9 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/api/v1/mirror/image/info/{mirrorPool}/{imageName}": {
-            "get": {
-                "description": "Glue 의 이미지에 미러링 정보를 확인합니다.",
+            },
+            "put": {
+                "description": "GlueFS 볼륨의 그룹의 할당된 사이즈를 수정합니다.",
                 "consumes": [
                     "application/x-www-form-urlencoded"
                 ],
@@ -2774,30 +2542,37 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Mirror"
+                    "GlueFS-SubVolume-Group"
                 ],
-                "summary": "Show Mirroring Image Parent Info",
+                "summary": "Update Size of Glue FS Volume Group",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Pool Name for Mirroring",
-                        "name": "mirrorPool",
-                        "in": "path",
+                        "description": "Glue FS Volume Name",
+                        "name": "vol_name",
+                        "in": "formData",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "Image Name for Mirroring",
-                        "name": "imageName",
-                        "in": "path",
+                        "description": "Glue FS Volume Group Name",
+                        "name": "group_name",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Glue FS Volume Group New Size(default GB)",
+                        "name": "new_size",
+                        "in": "formData",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Success",
                         "schema": {
-                            "$ref": "#/definitions/ImageInfo"
+                            "type": "string"
                         }
                     },
                     "400": {
@@ -2819,11 +2594,9 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/api/v1/mirror/image/status/{mirrorPool}/{imageName}": {
-            "get": {
-                "description": "Glue 의 이미지에 미러링상태를 확인합니다.",
+            },
+            "post": {
+                "description": "GlueFS 볼륨의 그룹을 생성합니다.",
                 "consumes": [
                     "application/x-www-form-urlencoded"
                 ],
@@ -2831,30 +2604,51 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Mirror"
+                    "GlueFS-SubVolume-Group"
                 ],
-                "summary": "Show Mirroring Image Status",
+                "summary": "Create of Glue FS Volume Group",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Pool Name for Mirroring",
-                        "name": "mirrorPool",
-                        "in": "path",
+                        "description": "Glue FS Volume Name",
+                        "name": "vol_name",
+                        "in": "formData",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "Image Name for Mirroring",
-                        "name": "imageName",
-                        "in": "path",
+                        "description": "Glue FS Volume Group Name",
+                        "name": "group_name",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Glue FS Volume Group Size(default GB)",
+                        "name": "size",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Glue FS Volume Group Data Pool Name",
+                        "name": "data_pool_name",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Glue FS Volume Group Permissions",
+                        "name": "mode",
+                        "in": "formData",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Success",
                         "schema": {
-                            "$ref": "#/definitions/ImageStatus"
+                            "type": "string"
                         }
                     },
                     "400": {
@@ -2876,11 +2670,9 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/api/v1/mirror/image/promote/{mirrorPool}/{imageName}": {
-            "post": {
-                "description": "Glue 의 이미지를 Promote 합니다.",
+            },
+            "delete": {
+                "description": "GlueFS 볼륨의 그룹을 삭제합니다.",
                 "consumes": [
                     "application/x-www-form-urlencoded"
                 ],
@@ -2888,30 +2680,37 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Mirror"
+                    "GlueFS-SubVolume-Group"
                 ],
-                "summary": "Promote Image Mirroring",
+                "summary": "Delete of Glue FS Volume Group",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Pool Name for Mirroring",
-                        "name": "mirrorPool",
-                        "in": "path",
+                        "description": "Glue FS Volume Name",
+                        "name": "vol_name",
+                        "in": "query",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "Image Name for Mirroring",
-                        "name": "imageName",
-                        "in": "path",
+                        "description": "Glue FS Volume Group Name",
+                        "name": "group_name",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Glue FS Volume Group Path",
+                        "name": "path",
+                        "in": "query",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Success",
                         "schema": {
-                            "$ref": "#/definitions/ImageStatus"
+                            "type": "string"
                         }
                     },
                     "400": {
@@ -2935,9 +2734,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/mirror/image/promote/peer/{mirrorPool}/{imageName}": {
+        "/api/v1/gluefs/{fs_name}": {
             "post": {
-                "description": "Peer Glue 의 이미지를 Promote 합니다.",
+                "description": "GlueFS를 생성합니다.",
                 "consumes": [
                     "application/x-www-form-urlencoded"
                 ],
@@ -2945,30 +2744,34 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Mirror"
+                    "GlueFS"
                 ],
-                "summary": "Promote Peer Image Mirroring",
+                "summary": "Create of Glue FS",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Pool Name for Mirroring",
-                        "name": "mirrorPool",
+                        "description": "Glue FS Name",
+                        "name": "fs_name",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "Image Name for Mirroring",
-                        "name": "imageName",
-                        "in": "path",
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Glue FS Service Host Name",
+                        "name": "hosts",
+                        "in": "formData",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Success",
                         "schema": {
-                            "$ref": "#/definitions/ImageStatus"
+                            "type": "string"
                         }
                     },
                     "400": {
@@ -2990,11 +2793,9 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/api/v1/mirror/image/demote/{mirrorPool}/{imageName}": {
+            },
             "delete": {
-                "description": "Glue 의 이미지를 Demote 합니다.",
+                "description": "GlueFS를 삭제합니다.",
                 "consumes": [
                     "application/x-www-form-urlencoded"
                 ],
@@ -3002,30 +2803,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Mirror"
+                    "GlueFS"
                 ],
-                "summary": "Demote Image Mirroring",
+                "summary": "Delete of Glue FS",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Pool Name for Mirroring",
-                        "name": "mirrorPool",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Image Name for Mirroring",
-                        "name": "imageName",
+                        "description": "Glue FS Name",
+                        "name": "fs_name",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Success",
                         "schema": {
-                            "$ref": "#/definitions/ImageStatus"
+                            "type": "string"
                         }
                     },
                     "400": {
@@ -3049,31 +2843,24 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/mirror/image/demote/peer/{mirrorPool}/{imageName}": {
-            "delete": {
-                "description": "Peer Glue 의 이미지를 Demote 합니다.",
+        "/api/v1/gwvm/cleanup/{hypervisorType}": {
+            "put": {
+                "description": "Gateway VM Pcs cluster를 Cleanup 합니다.",
                 "consumes": [
-                    "application/x-www-form-urlencoded"
+                    "multipart/form-data"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Mirror"
+                    "Gwvm"
                 ],
-                "summary": "Demote Peer Image Mirroring",
+                "summary": "Cleanup to Gateway VM",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Pool Name for Mirroring",
-                        "name": "mirrorPool",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Image Name for Mirroring",
-                        "name": "imageName",
+                        "description": "Hypervisor Type",
+                        "name": "hypervisorType",
                         "in": "path",
                         "required": true
                     }
@@ -3082,7 +2869,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/ImageStatus"
+                            "$ref": "#/definitions/model.GwvmMgmt"
                         }
                     },
                     "400": {
@@ -3106,31 +2893,24 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/mirror/image/resync/{mirrorPool}/{imageName}": {
-            "put": {
-                "description": "Glue 의 이미지를 Resync 합니다.",
+        "/api/v1/gwvm/delete/{hypervisorType}": {
+            "delete": {
+                "description": "Gateway VM을 삭제합니다.",
                 "consumes": [
-                    "application/x-www-form-urlencoded"
+                    "multipart/form-data"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Mirror"
+                    "Gwvm"
                 ],
-                "summary": "Resync Image Mirroring",
+                "summary": "Delete to Gateway VM",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Pool Name for Mirroring",
-                        "name": "mirrorPool",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Image Name for Mirroring",
-                        "name": "imageName",
+                        "description": "Hypervisor Type",
+                        "name": "hypervisorType",
                         "in": "path",
                         "required": true
                     }
@@ -3139,7 +2919,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/ImageStatus"
+                            "$ref": "#/definitions/model.GwvmMgmt"
                         }
                     },
                     "400": {
@@ -3163,9 +2943,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/mirror/image/resync/peer/{mirrorPool}/{imageName}": {
-            "put": {
-                "description": "Peer Glue 의 이미지를 Resync 합니다.",
+        "/api/v1/gwvm/detail/{hypervisorType}": {
+            "get": {
+                "description": "gwvm의 상세정보 상태를 보여줍니다.",
                 "consumes": [
                     "application/x-www-form-urlencoded"
                 ],
@@ -3173,21 +2953,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Mirror"
+                    "Gwvm"
                 ],
-                "summary": "Resync Peer Image Mirroring",
+                "summary": "Detail of Gateway VM",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Pool Name for Mirroring",
-                        "name": "mirrorPool",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Image Name for Mirroring",
-                        "name": "imageName",
+                        "description": "Hypervisor Type",
+                        "name": "hypervisorType",
                         "in": "path",
                         "required": true
                     }
@@ -3196,7 +2969,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/ImageStatus"
+                            "$ref": "#/definitions/model.GwvmMgmt"
                         }
                     },
                     "400": {
@@ -3220,32 +2993,32 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/mirror/image/{mirrorPool}/{imageName}": {
-            "get": {
-                "description": "미러링중인 이미지의 정보를 보여줍니다.",
+        "/api/v1/gwvm/migrate/{hypervisorType}": {
+            "put": {
+                "description": "Gateway VM을 Pcs cluster내 다른 호스트로 마이그레이션 합니다.",
                 "consumes": [
-                    "application/x-www-form-urlencoded"
+                    "multipart/form-data"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Mirror"
+                    "Gwvm"
                 ],
-                "summary": "Show Infomation of Mirrored Snapshot",
+                "summary": "VmMigrate to Gateway VM",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "mirrorPool",
-                        "name": "mirrorPool",
+                        "description": "Hypervisor Type",
+                        "name": "hypervisorType",
                         "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "imageName",
-                        "name": "imageName",
-                        "in": "path",
+                        "description": "Migration Target Host",
+                        "name": "target",
+                        "in": "formData",
                         "required": true
                     }
                 ],
@@ -3253,7 +3026,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/ImageMirror"
+                            "$ref": "#/definitions/model.GwvmMgmt"
                         }
                     },
                     "400": {
@@ -3275,31 +3048,26 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "이미지의 미러링 스케줄링을 비활성화 합니다.",
+            }
+        },
+        "/api/v1/gwvm/start/{hypervisorType}": {
+            "put": {
+                "description": "Gateway VM을 실행합니다.",
                 "consumes": [
-                    "application/x-www-form-urlencoded"
+                    "multipart/form-data"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Mirror"
+                    "Gwvm"
                 ],
-                "summary": "Delete Mirrored Snapshot Schedule",
+                "summary": "Start to Gateway VM",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "pool",
-                        "name": "mirrorPool",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "imageName",
-                        "name": "imageName",
+                        "description": "Hypervisor Type",
+                        "name": "hypervisorType",
                         "in": "path",
                         "required": true
                     }
@@ -3308,7 +3076,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/Message"
+                            "$ref": "#/definitions/model.GwvmMgmt"
                         }
                     },
                     "400": {
@@ -3332,61 +3100,33 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/mirror/image/{mirrorPool}/{imageName}/{hostName}/{vmName}": {
-            "post": {
-                "description": "Glue 의 이미지에 미러링 스케줄을 설정합니다.",
+        "/api/v1/gwvm/stop/{hypervisorType}": {
+            "put": {
+                "description": "Gateway VM을 정지합니다.",
                 "consumes": [
-                    "application/x-www-form-urlencoded"
+                    "multipart/form-data"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Mirror"
+                    "Gwvm"
                 ],
-                "summary": "Setup Image Mirroring Schedule",
+                "summary": "Stop to Gateway VM",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Pool Name for Mirroring",
-                        "name": "mirrorPool",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Image Name for Mirroring",
-                        "name": "imageName",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Host Name for Mirroring VM",
-                        "name": "hostName",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "VM Name for Mirroring Image",
-                        "name": "vmName",
+                        "description": "Hypervisor Type",
+                        "name": "hypervisorType",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Volume Type",
-                        "name": "volType",
-                        "in": "formData",
-                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/ImageMirror"
+                            "$ref": "#/definitions/model.GwvmMgmt"
                         }
                     },
                     "400": {
@@ -3410,9 +3150,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/mirror/image/snapshot/{mirrorPool}/{vmName}": {
-            "post": {
-                "description": "Glue 의 이미지에 미러링 스냅샷을 생성하거나 스케줄을 설정합니다.",
+        "/api/v1/gwvm/{hypervisorType}": {
+            "get": {
+                "description": "gwvm의 상태를 보여줍니다.",
                 "consumes": [
                     "application/x-www-form-urlencoded"
                 ],
@@ -3420,51 +3160,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Mirror"
+                    "Gwvm"
                 ],
-                "summary": "Take Image Mirroring Snapshot or Setup Image Mirroring Snapshot Schedule",
+                "summary": "State of Gateway VM",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Pool Name for Mirroring",
-                        "name": "mirrorPool",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "VM Name for Mirroring",
-                        "name": "vmName",
+                        "description": "Hypervisor Type",
+                        "name": "hypervisorType",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Host Name for Mirroring VM",
-                        "name": "hostName",
-                        "in": "formData",
-                        "required": false
-                    },
-                    {
-                        "type": "string",
-                        "description": "Image Name for Mirroring (Schedule)",
-                        "name": "imageName",
-                        "in": "formData",
-                        "required": false
-                    },
-                    {
-                        "type": "string",
-                        "description": "Image List for Mirroring (Manual)",
-                        "name": "imageList",
-                        "in": "formData",
-                        "required": false
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/Message"
+                            "$ref": "#/definitions/model.GwvmMgmt"
                         }
                     },
                     "400": {
@@ -3486,33 +3198,62 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/api/v1/nfs": {
-            "get": {
-                "description": "Glue NFS Cluster의 리스트 및 상세정보를 보여줍니다.",
+            },
+            "post": {
+                "description": "gwvm을 생성합니다.",
                 "consumes": [
-                    "application/x-www-form-urlencoded"
+                    "multipart/form-data"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "NFS"
+                    "Gwvm"
                 ],
-                "summary": "Show List of Info of Glue NFS Cluster",
+                "summary": "Setup Gateway Vm",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "NFS Cluster Identifier",
-                        "name": "cluster_id",
-                        "in": "query"
+                        "description": "Hypervisor Type",
+                        "name": "hypervisorType",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Gwvm Management Nic Parent",
+                        "name": "gwvmMngtNicParent",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Gwvm Management Nic Ip",
+                        "name": "gwvmMngtNicIp",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Gwvm Storage Nic Parent",
+                        "name": "gwvmStorageNicParent",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Gwvm Storage Nic Ip",
+                        "name": "gwvmStorageNicIp",
+                        "in": "formData",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
-                        "schema": {}
+                        "schema": {
+                            "$ref": "#/definitions/model.GwvmMgmt"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -3535,9 +3276,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/nfs/export": {
+        "/api/v1/host": {
             "get": {
-                "description": "Glue NFS Export 상세 정보를 보여줍니다.",
+                "description": "오케스트레이터에 등록된 Glue 호스트 목록과 레이블, 유지보수 상태를 보여줍니다.",
                 "consumes": [
                     "application/x-www-form-urlencoded"
                 ],
@@ -3545,78 +3286,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "NFS"
-                ],
-                "summary": "Show Detail of Glue NFS Export",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "NFS Cluster Identifier",
-                        "name": "cluster_id",
-                        "in": "query"
-                    }
+                    "Hosts"
                 ],
+                "summary": "Show List of Orchestrator Hosts",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "description": "Glue NFS Export 상세정보 구조체",
                             "type": "array",
                             "items": {
-                                "type": "object",
-                                "properties": {
-                                    "access_type": {
-                                        "type": "string"
-                                    },
-                                    "clients": {
-                                        "type": "array",
-                                        "items": {}
-                                    },
-                                    "cluster_id": {
-                                        "type": "string"
-                                    },
-                                    "export_id": {
-                                        "type": "integer"
-                                    },
-                                    "fsal": {
-                                        "type": "object",
-                                        "properties": {
-                                            "fs_name": {
-                                                "type": "string"
-                                            },
-                                            "name": {
-                                                "type": "string"
-                                            },
-                                            "user_id": {
-                                                "type": "string"
-                                            }
-                                        }
-                                    },
-                                    "path": {
-                                        "type": "string"
-                                    },
-                                    "protocols": {
-                                        "type": "array",
-                                        "items": {
-                                            "type": "integer"
-                                        }
-                                    },
-                                    "pseudo": {
-                                        "type": "string"
-                                    },
-                                    "security_label": {
-                                        "type": "boolean"
-                                    },
-                                    "squash": {
-                                        "type": "string"
-                                    },
-                                    "transports": {
-                                        "type": "array",
-                                        "items": {
-                                            "type": "string"
-                                        }
-                                    }
-                                }
+                                "$ref": "#/definitions/OrchHost"
                             }
                         }
                     },
@@ -3639,11 +3318,9 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/api/v1/nfs/export/{cluster_id}": {
-            "put": {
-                "description": "Glue NFS Export를 수정합니다.",
+            },
+            "post": {
+                "description": "Glue 호스트를 오케스트레이터에 추가합니다. 호스트에는 cephadm 공개 키가 등록되어 있어야 합니다. 레이블을 지정하려면 주소도 함께 지정해야 합니다.",
                 "consumes": [
                     "application/x-www-form-urlencoded"
                 ],
@@ -3651,98 +3328,31 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "NFS"
+                    "Hosts"
                 ],
-                "summary": "Update of Glue NFS Export",
+                "summary": "Add of Glue Host",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "NFS Cluster Identifier",
-                        "name": "cluster_id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "description": "NFS Export ID",
-                        "name": "export_id",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "enum": [
-                            "RW",
-                            "RO",
-                            "NONE"
-                        ],
-                        "type": "string",
-                        "default": "RW",
-                        "description": "NFS Access Type",
-                        "name": "access_type",
+                        "description": "Host Name",
+                        "name": "hostname",
                         "in": "formData",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "FS Name(if stroage_name is CEPH Required value)",
-                        "name": "fs_name",
+                        "description": "Host Address",
+                        "name": "addr",
                         "in": "formData"
                     },
-                    {
-                        "enum": [
-                            "CEPH",
-                            "RGW"
-                        ],
-                        "type": "string",
-                        "description": "NFS Storage Name",
-                        "name": "storage_name",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Glue FS Path or Glue RGW Bucket Name",
-                        "name": "path",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "NFS Export Path",
-                        "name": "pseudo",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "enum": [
-                            "no_root_squash",
-                            "root_id_squash",
-                            "all_squash",
-                            "root_squash"
-                        ],
-                        "type": "string",
-                        "default": "no_root_squash",
-                        "description": "Squash",
-                        "name": "squash",
-                        "in": "formData",
-                        "required": true
-                    },
                     {
                         "type": "array",
                         "items": {
                             "type": "string"
                         },
                         "collectionFormat": "multi",
-                        "default": "TCP",
-                        "description": "Transports",
-                        "name": "transports",
-                        "in": "formData"
-                    },
-                    {
-                        "type": "boolean",
-                        "default": false,
-                        "description": "Security Label",
-                        "name": "security_label",
+                        "description": "Host Labels",
+                        "name": "labels",
                         "in": "formData"
                     }
                 ],
@@ -3772,9 +3382,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
-                "description": "Glue NFS Export를 생성합니다.",
+            }
+        },
+        "/api/v1/host/{hostname}": {
+            "delete": {
+                "description": "데몬이 없는 Glue 호스트를 오케스트레이터에서 제거합니다. 데몬이 남아 있으면 drain 을 지정하여 데몬을 먼저 다른 호스트로 옮긴 뒤 다시 요청해야 합니다.",
                 "consumes": [
                     "application/x-www-form-urlencoded"
                 ],
@@ -3782,92 +3394,22 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "NFS"
+                    "Hosts"
                 ],
-                "summary": "Create of Glue NFS Export",
+                "summary": "Remove of Glue Host",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "NFS Cluster Identifier",
-                        "name": "cluster_id",
+                        "description": "Host Name",
+                        "name": "hostname",
                         "in": "path",
                         "required": true
                     },
-                    {
-                        "enum": [
-                            "RW",
-                            "RO",
-                            "NONE"
-                        ],
-                        "type": "string",
-                        "default": "RW",
-                        "description": "NFS Access Type",
-                        "name": "access_type",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "FS Name(if stroage_name is CEPH Required value)",
-                        "name": "fs_name",
-                        "in": "formData"
-                    },
-                    {
-                        "enum": [
-                            "CEPH",
-                            "RGW"
-                        ],
-                        "type": "string",
-                        "description": "NFS Storage Name",
-                        "name": "storage_name",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Glue FS Path or Glue RGW Bucket Name",
-                        "name": "path",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "NFS Export Path",
-                        "name": "pseudo",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "enum": [
-                            "no_root_squash",
-                            "root_id_squash",
-                            "all_squash",
-                            "root_squash"
-                        ],
-                        "type": "string",
-                        "default": "no_root_squash",
-                        "description": "Squash",
-                        "name": "squash",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        },
-                        "collectionFormat": "multi",
-                        "default": "TCP",
-                        "description": "Transports",
-                        "name": "transports",
-                        "in": "formData"
-                    },
                     {
                         "type": "boolean",
-                        "default": false,
-                        "description": "Security Label",
-                        "name": "security_label",
-                        "in": "formData"
+                        "description": "Drain Daemons Before Removal",
+                        "name": "drain",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -3898,9 +3440,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/nfs/export/{cluster_id}/{export_id}": {
-            "delete": {
-                "description": "Glue NFS Export를 삭제합니다.",
+        "/api/v1/host/{hostname}/label": {
+            "post": {
+                "description": "Glue 호스트에 레이블을 추가합니다. NFS, RGW, iSCSI 서비스 배치(placement)에 레이블을 사용할 수 있습니다.",
                 "consumes": [
                     "application/x-www-form-urlencoded"
                 ],
@@ -3908,22 +3450,22 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "NFS"
+                    "Hosts"
                 ],
-                "summary": "Delete of Glue NFS Export",
+                "summary": "Add Label of Glue Host",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "NFS Cluster Identifier",
-                        "name": "cluster_id",
+                        "description": "Host Name",
+                        "name": "hostname",
                         "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "NFS Export ID",
-                        "name": "export_id",
-                        "in": "path",
+                        "description": "Host Label",
+                        "name": "label",
+                        "in": "formData",
                         "required": true
                     }
                 ],
@@ -3955,9 +3497,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/nfs/{cluster_id}": {
+        "/api/v1/host/{hostname}/label/{label}": {
             "delete": {
-                "description": "Glue NFS Cluster를 삭제합니다.",
+                "description": "Glue 호스트의 레이블을 제거합니다.",
                 "consumes": [
                     "application/x-www-form-urlencoded"
                 ],
@@ -3965,14 +3507,21 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "NFS"
+                    "Hosts"
                 ],
-                "summary": "Delete of Glue NFS Cluster",
+                "summary": "Remove Label of Glue Host",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "NFS Cluster Identifier",
-                        "name": "cluster_id",
+                        "description": "Host Name",
+                        "name": "hostname",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Host Label",
+                        "name": "label",
                         "in": "path",
                         "required": true
                     }
@@ -4005,9 +3554,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/nfs/{cluster_id}/{port}": {
-            "put": {
-                "description": "Glue NFS Cluster를 수정합니다.",
+        "/api/v1/host/{hostname}/maintenance": {
+            "get": {
+                "description": "호스트를 유지보수 모드로 전환하기 전에 ok-to-stop, 게이트웨이 VM 배치, 영향을 받는 서비스를 점검합니다.",
                 "consumes": [
                     "application/x-www-form-urlencoded"
                 ],
@@ -4015,47 +3564,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "NFS"
+                    "Hosts"
                 ],
-                "summary": "Update of Glue NFS Cluster",
+                "summary": "Show Pre-check of Host Maintenance",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "NFS Cluster Identifier",
-                        "name": "cluster_id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Cluster Port",
-                        "name": "port",
+                        "description": "Host Name",
+                        "name": "hostname",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        },
-                        "collectionFormat": "multi",
-                        "description": "Cluster Daemon Hostname",
-                        "name": "hosts",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Cluster Daemon Service Count",
-                        "name": "service_count",
-                        "in": "formData"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Success",
+                        "description": "OK",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/HostMaintenanceReport"
                         }
                     },
                     "400": {
@@ -4078,8 +3603,8 @@ const docTemplate = `{
                     }
                 }
             },
-            "post": {
-                "description": "Glue NFS Cluster를 생성합니다.",
+            "put": {
+                "description": "호스트를 유지보수 모드로 전환하거나 해제합니다. 전환 시 사전 점검에서 문제가 있으면 force 를 지정해야 하며, 영향을 받는 서비스 보고서를 반환합니다.",
                 "consumes": [
                     "application/x-www-form-urlencoded"
                 ],
@@ -4087,47 +3612,41 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "NFS"
+                    "Hosts"
                 ],
-                "summary": "Create of Glue NFS Cluster",
+                "summary": "Enter or Exit Host Maintenance",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "NFS Cluster Identifier",
-                        "name": "cluster_id",
+                        "description": "Host Name",
+                        "name": "hostname",
                         "in": "path",
                         "required": true
                     },
                     {
+                        "enum": [
+                            "enter",
+                            "exit"
+                        ],
                         "type": "string",
-                        "description": "Cluster Port",
-                        "name": "port",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        },
-                        "collectionFormat": "multi",
-                        "description": "Cluster Daemon Hostname",
-                        "name": "hosts",
+                        "description": "Maintenance Action",
+                        "name": "action",
                         "in": "formData",
                         "required": true
                     },
                     {
-                        "type": "integer",
-                        "description": "Cluster Daemon Service Count",
-                        "name": "service_count",
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Ignore Pre-check Failures",
+                        "name": "force",
                         "in": "formData"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Success",
+                        "description": "OK",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/HostMaintenanceReport"
                         }
                     },
                     "400": {
@@ -4151,9 +3670,60 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/nvmeof": {
+        "/api/v1/image": {
+            "get": {
+                "description": "Glue 스토리지 풀의 이미지 목록을 보여줍니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Image"
+                ],
+                "summary": "Show List or Info Images of Pool",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Glue Pool Name",
+                        "name": "pool_name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Glue Image Name",
+                        "name": "image_name",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {}
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            },
             "post": {
-                "description": "NVMe-OF 서비스를 생성합니다.",
+                "description": "Glue 스토리지 풀의 이미지를 생성합니다.",
                 "consumes": [
                     "application/x-www-form-urlencoded"
                 ],
@@ -4161,25 +3731,28 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "NVMe-OF"
+                    "Image"
                 ],
-                "summary": "Create of NVMe-OF Service",
+                "summary": "Create Images of Pool",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Glue NVMe-OF Store Data In Pool Name",
+                        "description": "Glue Image Name",
+                        "name": "image_name",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Glue Pool Name",
                         "name": "pool_name",
                         "in": "formData",
                         "required": true
                     },
                     {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        },
-                        "collectionFormat": "multi",
-                        "description": "Glue NVMe-OF Service Placement Hosts",
-                        "name": "hosts",
+                        "type": "integer",
+                        "description": "Image Size(default:GB)",
+                        "name": "size",
                         "in": "formData",
                         "required": true
                     }
@@ -4210,11 +3783,9 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/api/v1/nvmeof/image/download": {
-            "post": {
-                "description": "NVMe-OF 이미지를 최신으로 다운받습니다.",
+            },
+            "delete": {
+                "description": "Glue 스토리지 풀의 이미지를 휴지통으로 옮깁니다. 유예 기간(초)을 지정하지 않으면 풀의 휴지통 유예 기간을 사용하며, 유예 기간 안에는 휴지통에서 복구할 수 있습니다.",
                 "consumes": [
                     "application/x-www-form-urlencoded"
                 ],
@@ -4222,16 +3793,29 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "NVMe-OF"
+                    "Image"
                 ],
-                "summary": "Download of NVMe-OF Image",
+                "summary": "Delete Images of Pool",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Glue NVMe-OF Gateway IP",
-                        "name": "gateway_ip",
-                        "in": "formData",
+                        "description": "Glue Image Name",
+                        "name": "image_name",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Glue Pool Name",
+                        "name": "pool_name",
+                        "in": "query",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Trash Deferment Seconds",
+                        "name": "expires_in",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -4262,9 +3846,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/nvmeof/namespace": {
+        "/api/v1/image/task": {
             "get": {
-                "description": "NVMe-OF의 Sub System 리스트를 보여줍니다.",
+                "description": "flatten 등 대기 중이거나 진행 중인 이미지 백그라운드 작업과 진행률을 보여줍니다.",
                 "consumes": [
                     "application/x-www-form-urlencoded"
                 ],
@@ -4272,14 +3856,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "NVMe-OF-NameSpace"
+                    "Image"
                 ],
-                "summary": "Show List of NVMe-OF Sub Systems",
+                "summary": "Show List of Image Background Tasks",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Glue NVMe-OF Sub System NQN ID",
-                        "name": "subsystem_nqn_id",
+                        "description": "Task ID",
+                        "name": "task_id",
                         "in": "query"
                     }
                 ],
@@ -4287,7 +3871,10 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/NvmeOfNameSpaceList"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/ImageTask"
+                            }
                         }
                     },
                     "400": {
@@ -4309,9 +3896,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
-                "description": "NVMe-OF의 네임스페이스를 생성합니다.",
+            }
+        },
+        "/api/v1/image/task/{task_id}": {
+            "delete": {
+                "description": "대기 중이거나 진행 중인 이미지 백그라운드 작업을 취소합니다.",
                 "consumes": [
                     "application/x-www-form-urlencoded"
                 ],
@@ -4319,36 +3908,15 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "NVMe-OF-NameSpace"
+                    "Image"
                 ],
-                "summary": "Create of NVMe-OF NameSpace",
+                "summary": "Cancel of Image Background Task",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Glue NVMe-OF Sub System NQN ID",
-                        "name": "subsystem_nqn_id",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Glue Pool Name",
-                        "name": "pool_name",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Glue Image Name",
-                        "name": "image_name",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Glue NVMe-OF Image Size(default GB)",
-                        "name": "size",
-                        "in": "formData",
+                        "description": "Task ID",
+                        "name": "task_id",
+                        "in": "path",
                         "required": true
                     }
                 ],
@@ -4378,9 +3946,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "NVMe-OF의 네임스페이스를 삭제합니다.",
+            }
+        },
+        "/api/v1/image/trash": {
+            "get": {
+                "description": "휴지통으로 옮겨진 이미지 목록과 삭제 시각, 유예 상태를 보여줍니다. 풀을 지정하지 않으면 모든 rbd 풀의 휴지통을 보여줍니다.",
                 "consumes": [
                     "application/x-www-form-urlencoded"
                 ],
@@ -4388,54 +3958,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "NVMe-OF-NameSpace"
+                    "Image"
                 ],
-                "summary": "Delete of NVMe-OF NameSpace",
+                "summary": "Show List of Trashed Images",
                 "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Glue NVMe-OF Sub System NQN ID",
-                        "name": "subsystem_nqn_id",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Glue NVMe-OF NameSpace UUID",
-                        "name": "namespace_uuid",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "enum": [
-                            true,
-                            false
-                        ],
-                        "type": "boolean",
-                        "default": false,
-                        "description": "Glue NVMe-OF Image Delete Check",
-                        "name": "image_del_check",
-                        "in": "query",
-                        "required": true
-                    },
                     {
                         "type": "string",
                         "description": "Glue Pool Name",
                         "name": "pool_name",
                         "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Glue Image Name",
-                        "name": "image_name",
-                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Success",
+                        "description": "OK",
                         "schema": {
-                            "type": "string"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/ImageTrash"
+                            }
                         }
                     },
                     "400": {
@@ -4459,9 +4000,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/nvmeof/subsystem": {
+        "/api/v1/image/trash/schedule": {
             "get": {
-                "description": "NVMe-OF의 Sub System 리스트를 보여줍니다.",
+                "description": "유예 기간이 지난 이미지를 주기적으로 비우는 휴지통 자동 비우기 일정을 보여줍니다.",
                 "consumes": [
                     "application/x-www-form-urlencoded"
                 ],
@@ -4469,14 +4010,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "NVMe-OF-SubSystem"
+                    "Image"
                 ],
-                "summary": "Show List of NVMe-OF Sub System",
+                "summary": "Show List of Trash Purge Schedules",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Glue NVMe-OF Sub System NQN ID",
-                        "name": "subsystem_nqn_id",
+                        "description": "Glue Pool Name",
+                        "name": "pool_name",
                         "in": "query"
                     }
                 ],
@@ -4484,7 +4025,10 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/NvmeOfSubSystemList"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/TrashPurgeSchedule"
+                            }
                         }
                     },
                     "400": {
@@ -4508,7 +4052,7 @@ const docTemplate = `{
                 }
             },
             "post": {
-                "description": "NVMe-OF의 Sub System을 생성합니다.",
+                "description": "휴지통 자동 비우기 일정을 등록합니다. 풀을 지정하지 않으면 모든 풀에 적용됩니다.",
                 "consumes": [
                     "application/x-www-form-urlencoded"
                 ],
@@ -4516,23 +4060,28 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "NVMe-OF-SubSystem"
+                    "Image"
                 ],
-                "summary": "Create of NVMe-OF Sub System",
+                "summary": "Create of Trash Purge Schedule",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Glue NVMe-OF Gateway IP",
-                        "name": "gateway_ip",
-                        "in": "formData",
-                        "required": true
+                        "description": "Glue Pool Name",
+                        "name": "pool_name",
+                        "in": "formData"
                     },
                     {
                         "type": "string",
-                        "description": "Glue NVMe-OF Sub System NQN ID",
-                        "name": "subsystem_nqn_id",
+                        "description": "Purge Interval (ex. 30m, 12h, 1d)",
+                        "name": "interval",
                         "in": "formData",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Start Time (ISO 8601)",
+                        "name": "start_time",
+                        "in": "formData"
                     }
                 ],
                 "responses": {
@@ -4563,7 +4112,7 @@ const docTemplate = `{
                 }
             },
             "delete": {
-                "description": "NVMe-OF Sub System을 삭제합니다.",
+                "description": "휴지통 자동 비우기 일정을 삭제합니다.",
                 "consumes": [
                     "application/x-www-form-urlencoded"
                 ],
@@ -4571,16 +4120,28 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "NVMe-OF-SubSystem"
+                    "Image"
                 ],
-                "summary": "Delete of NVMe-OF Sub System",
+                "summary": "Delete of Trash Purge Schedule",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Glue NVMe-OF Sub System NQN ID",
-                        "name": "subsystem_nqn_id",
+                        "description": "Glue Pool Name",
+                        "name": "pool_name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Purge Interval",
+                        "name": "interval",
                         "in": "query",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Start Time",
+                        "name": "start_time",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -4611,9 +4172,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/nvmeof/target": {
-            "get": {
-                "description": "NVMe-OF의 타겟 리스트를 보여줍니다.",
+        "/api/v1/image/trash/{pool_name}": {
+            "delete": {
+                "description": "풀의 휴지통에서 유예 기간이 지난 이미지를 모두 영구 삭제합니다.",
                 "consumes": [
                     "application/x-www-form-urlencoded"
                 ],
@@ -4621,116 +4182,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "NVMe-OF"
+                    "Image"
                 ],
-                "summary": "Show List of NVMe-OF Target",
+                "summary": "Purge of Pool Trash",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Glue NVMe-OF Sub System NQN ID",
-                        "name": "subsystem_nqn_id",
-                        "in": "query"
-                    }
+                        "description": "Glue Pool Name",
+                        "name": "pool_name",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Success",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "type": "object",
-                                "properties": {
-                                    "allow_any_host": {
-                                        "type": "boolean"
-                                    },
-                                    "hosts": {
-                                        "type": "array",
-                                        "items": {}
-                                    },
-                                    "listen_addresses": {
-                                        "type": "array",
-                                        "items": {
-                                            "type": "object",
-                                            "properties": {
-                                                "adrfam": {
-                                                    "type": "string"
-                                                },
-                                                "traddr": {
-                                                    "type": "string"
-                                                },
-                                                "transport": {
-                                                    "type": "string"
-                                                },
-                                                "trsvcid": {
-                                                    "type": "string"
-                                                },
-                                                "trtype": {
-                                                    "type": "string"
-                                                }
-                                            }
-                                        }
-                                    },
-                                    "max_cntlid": {
-                                        "type": "integer"
-                                    },
-                                    "max_namespaces": {
-                                        "type": "integer"
-                                    },
-                                    "min_cntlid": {
-                                        "type": "integer"
-                                    },
-                                    "model_number": {
-                                        "type": "string"
-                                    },
-                                    "namespaces": {
-                                        "type": "array",
-                                        "items": {
-                                            "type": "object",
-                                            "properties": {
-                                                "bdev_name": {
-                                                    "type": "string"
-                                                },
-                                                "block_size": {
-                                                    "type": "integer"
-                                                },
-                                                "name": {
-                                                    "type": "string"
-                                                },
-                                                "nguid": {
-                                                    "type": "string"
-                                                },
-                                                "nsid": {
-                                                    "type": "integer"
-                                                },
-                                                "rbd_image_name": {
-                                                    "type": "string"
-                                                },
-                                                "rbd_image_size": {
-                                                    "type": "string"
-                                                },
-                                                "rbd_pool_name": {
-                                                    "type": "string"
-                                                },
-                                                "uuid": {
-                                                    "type": "string"
-                                                }
-                                            }
-                                        }
-                                    },
-                                    "nqn": {
-                                        "type": "string"
-                                    },
-                                    "serial_number": {
-                                        "type": "string"
-                                    },
-                                    "session": {
-                                        "type": "integer"
-                                    },
-                                    "subtype": {
-                                        "type": "string"
-                                    }
-                                }
-                            }
+                            "type": "string"
                         }
                     },
                     "400": {
@@ -4752,9 +4220,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
-                "description": "NVMe-OF 타켓을 생성합니다.",
+            }
+        },
+        "/api/v1/image/trash/{pool_name}/deferment": {
+            "get": {
+                "description": "이미지를 삭제할 때 휴지통에 보관하는 풀의 유예 기간(초)을 보여줍니다.",
                 "consumes": [
                     "application/x-www-form-urlencoded"
                 ],
@@ -4762,50 +4232,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "NVMe-OF"
+                    "Image"
                 ],
-                "summary": "Create of NVMe-OF Target",
+                "summary": "Show Trash Deferment of Pool",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Glue NVMe-OF Gateway IP",
-                        "name": "gateway_ip",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Glue NVMe-OF Sub System NQN ID",
-                        "name": "subsystem_nqn_id",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Glue NVMe-OF Use Image Pool Name",
+                        "description": "Glue Pool Name",
                         "name": "pool_name",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Glue NVMe-OF Use Image Name",
-                        "name": "image_name",
-                        "in": "formData",
+                        "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Glue NVMe-OF Image Size(default GB)",
-                        "name": "size",
-                        "in": "formData"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Success",
+                        "description": "OK",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/ImageTrashDeferment"
                         }
                     },
                     "400": {
@@ -4827,11 +4270,9 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/api/v1/pool": {
-            "get": {
-                "description": "Glue 의 스토리지 풀 목록을 보여줍니다.",
+            },
+            "put": {
+                "description": "이미지를 삭제할 때 휴지통에 보관하는 풀의 유예 기간(초)을 변경합니다. 이미 휴지통에 있는 이미지에는 적용되지 않습니다.",
                 "consumes": [
                     "application/x-www-form-urlencoded"
                 ],
@@ -4839,21 +4280,31 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Pool"
+                    "Image"
                 ],
-                "summary": "List Pools of Glue",
+                "summary": "Update Trash Deferment of Pool",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "pool_type",
-                        "name": "pool_type",
-                        "in": "query"
+                        "description": "Glue Pool Name",
+                        "name": "pool_name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Trash Deferment Seconds",
+                        "name": "seconds",
+                        "in": "formData",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
-                        "schema": {}
+                        "description": "Success",
+                        "schema": {
+                            "type": "string"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -4876,9 +4327,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/pool/{pool_name}": {
+        "/api/v1/image/trash/{pool_name}/{image_id}": {
             "delete": {
-                "description": "Glue 스토리지 풀을 삭제합니다.",
+                "description": "휴지통의 이미지를 영구 삭제합니다. 유예 기간이 남은 이미지는 force 를 지정해야 삭제됩니다.",
                 "consumes": [
                     "application/x-www-form-urlencoded"
                 ],
@@ -4886,16 +4337,29 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Pool"
+                    "Image"
                 ],
-                "summary": "Delete of Pool",
+                "summary": "Delete of Trashed Image",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "pool_name",
+                        "description": "Glue Pool Name",
                         "name": "pool_name",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Trashed Image ID",
+                        "name": "image_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Delete Before Deferment Ends",
+                        "name": "force",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -4926,9 +4390,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/rgw": {
-            "get": {
-                "description": "RADOS Gateway Daemon 정보를 가져옵니다.",
+        "/api/v1/image/trash/{pool_name}/{image_id}/restore": {
+            "post": {
+                "description": "휴지통의 이미지를 원래 이름 또는 새 이름으로 복구합니다.",
                 "consumes": [
                     "application/x-www-form-urlencoded"
                 ],
@@ -4936,46 +4400,36 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "RGW"
+                    "Image"
+                ],
+                "summary": "Restore of Trashed Image",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Glue Pool Name",
+                        "name": "pool_name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Trashed Image ID",
+                        "name": "image_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Restored Glue Image Name",
+                        "name": "new_image_name",
+                        "in": "formData"
+                    }
                 ],
-                "summary": "Show List of RADOS Gateway Daemon",
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Success",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "type": "object",
-                                "properties": {
-                                    "default": {
-                                        "type": "boolean"
-                                    },
-                                    "id": {
-                                        "type": "string"
-                                    },
-                                    "port": {
-                                        "type": "integer"
-                                    },
-                                    "realm_name": {
-                                        "type": "string"
-                                    },
-                                    "server_hostname": {
-                                        "type": "string"
-                                    },
-                                    "service_map_id": {
-                                        "type": "string"
-                                    },
-                                    "version": {
-                                        "type": "string"
-                                    },
-                                    "zone_name": {
-                                        "type": "string"
-                                    },
-                                    "zonegroup_name": {
-                                        "type": "string"
-                                    }
-                                }
-                            }
+                            "type": "string"
                         }
                     },
                     "400": {
@@ -4997,9 +4451,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
-                "description": "RADOS Gateway Service를 수정합니다.",
+            }
+        },
+        "/api/v1/image/{pool_name}/{image_name}/copy": {
+            "post": {
+                "description": "이미지를 다른 스토리지 풀 또는 이름으로 복사합니다. deep 이면 스냅샷과 클론 관계까지 복사합니다.",
                 "consumes": [
                     "application/x-www-form-urlencoded"
                 ],
@@ -5007,56 +4463,49 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "RGW"
+                    "Image"
                 ],
-                "summary": "Update of RADOS Gateway Service",
+                "summary": "Copy of Image",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "RGW Service Name",
-                        "name": "service_id",
-                        "in": "formData",
+                        "description": "Glue Pool Name",
+                        "name": "pool_name",
+                        "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "RGW Realm Name",
-                        "name": "realm_name",
-                        "in": "formData"
+                        "description": "Glue Image Name",
+                        "name": "image_name",
+                        "in": "path",
+                        "required": true
                     },
                     {
                         "type": "string",
-                        "description": "RGW Zone Group Name",
-                        "name": "zonegroup_name",
-                        "in": "formData"
+                        "description": "Destination Glue Pool Name",
+                        "name": "dest_pool_name",
+                        "in": "formData",
+                        "required": true
                     },
                     {
                         "type": "string",
-                        "description": "RGW Zone Name",
-                        "name": "zone_name",
-                        "in": "formData"
+                        "description": "Destination Glue Image Name",
+                        "name": "dest_image_name",
+                        "in": "formData",
+                        "required": true
                     },
                     {
-                        "type": "integer",
-                        "description": "Service Port(default: 80)",
-                        "name": "port",
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Deep Copy With Snapshots",
+                        "name": "deep",
                         "in": "formData"
-                    },
-                    {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        },
-                        "collectionFormat": "multi",
-                        "description": "Service Placement Hosts",
-                        "name": "hosts",
-                        "in": "formData",
-                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Success",
                         "schema": {
                             "type": "string"
                         }
@@ -5080,68 +4529,59 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
-                "description": "RADOS Gateway Service를 생성합니다.",
+            }
+        },
+        "/api/v1/image/{pool_name}/{image_name}/export": {
+            "get": {
+                "description": "이미지 또는 스냅샷의 raw 데이터를 스트리밍으로 내려받습니다. compress 를 gzip 으로 지정하면 압축해서 보냅니다.",
                 "consumes": [
                     "application/x-www-form-urlencoded"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/octet-stream"
                 ],
                 "tags": [
-                    "RGW"
+                    "Image"
                 ],
-                "summary": "Create of RADOS Gateway Service",
+                "summary": "Export of Image",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "RGW Service Name",
-                        "name": "service_name",
-                        "in": "formData",
+                        "description": "Glue Pool Name",
+                        "name": "pool_name",
+                        "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "RGW Realm Name",
-                        "name": "realm_name",
-                        "in": "formData"
+                        "description": "Glue Image Name",
+                        "name": "image_name",
+                        "in": "path",
+                        "required": true
                     },
                     {
                         "type": "string",
-                        "description": "RGW Zone Group Name",
-                        "name": "zonegroup_name",
-                        "in": "formData"
+                        "description": "Snapshot Name",
+                        "name": "snapshot",
+                        "in": "query"
                     },
                     {
+                        "enum": [
+                            "none",
+                            "gzip"
+                        ],
                         "type": "string",
-                        "description": "RGW Zone Name",
-                        "name": "zone_name",
-                        "in": "formData"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Service Port(default: 80)",
-                        "name": "port",
-                        "in": "formData"
-                    },
-                    {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        },
-                        "collectionFormat": "multi",
-                        "description": "Service Placement Host Name",
-                        "name": "hosts",
-                        "in": "formData",
-                        "required": true
+                        "default": "none",
+                        "description": "Compression",
+                        "name": "compress",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "string"
+                            "type": "file"
                         }
                     },
                     "400": {
@@ -5165,43 +4605,65 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/rgw/bucket": {
+        "/api/v1/image/{pool_name}/{image_name}/export-diff": {
             "get": {
-                "description": "RADOS Gateway 버킷을 리스트를 보여줍니다.",
+                "description": "from_snapshot 부터 snapshot 까지 바뀐 영역을 스트리밍으로 내려받습니다. from_snapshot 을 비워 두면 이미지 생성 시점부터의 변경을 내려받습니다.",
                 "consumes": [
                     "application/x-www-form-urlencoded"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/octet-stream"
                 ],
                 "tags": [
-                    "RGW-Bucket"
+                    "Image"
                 ],
-                "summary": "Show List of RADOS Gateway Bucket",
+                "summary": "Export of Image Diff",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "RGW Bucket Name",
-                        "name": "bucket_name",
+                        "description": "Glue Pool Name",
+                        "name": "pool_name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Glue Image Name",
+                        "name": "image_name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "End Snapshot Name",
+                        "name": "snapshot",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Start Snapshot Name",
+                        "name": "from_snapshot",
                         "in": "query"
                     },
                     {
                         "enum": [
-                            "true",
-                            "false"
+                            "none",
+                            "gzip"
                         ],
                         "type": "string",
-                        "default": "false",
-                        "description": "RGW Bucket List Detail",
-                        "name": "detail",
-                        "in": "query",
-                        "required": true
+                        "default": "none",
+                        "description": "Compression",
+                        "name": "compress",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
-                        "schema": {}
+                        "schema": {
+                            "type": "file"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -5222,9 +4684,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/api/v1/image/{pool_name}/{image_name}/feature": {
             "put": {
-                "description": "RADOS Gateway 버킷을 수정합니다.",
+                "description": "이미지 기능을 켜거나 끕니다. object-map, journaling 은 exclusive-lock 이, fast-diff 는 object-map 이 먼저 켜져 있어야 합니다.",
                 "consumes": [
                     "application/x-www-form-urlencoded"
                 ],
@@ -5232,62 +4696,51 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "RGW-Bucket"
+                    "Image"
                 ],
-                "summary": "Update of RADOS Gateway Bucket",
+                "summary": "Enable or Disable Image Feature",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "RGW Bucket Name",
-                        "name": "bucket_name",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "RGW Bucket ID",
-                        "name": "bucket_id",
-                        "in": "formData",
+                        "description": "Glue Pool Name",
+                        "name": "pool_name",
+                        "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "RGW User Name",
-                        "name": "username",
-                        "in": "formData",
+                        "description": "Glue Image Name",
+                        "name": "image_name",
+                        "in": "path",
                         "required": true
                     },
                     {
                         "enum": [
-                            "Enabled",
-                            "Suspended"
-                        ],
-                        "type": "string",
-                        "description": "RGW Bucket Lock Enabled",
-                        "name": "versioning",
-                        "in": "formData"
-                    },
-                    {
-                        "enum": [
-                            "compliance",
-                            "governance"
+                            "exclusive-lock",
+                            "object-map",
+                            "fast-diff",
+                            "journaling"
                         ],
                         "type": "string",
-                        "description": "RGW Bucket Lock Mode(Required value if the lock box is checked)",
-                        "name": "lock_mode",
-                        "in": "formData"
+                        "description": "Image Feature",
+                        "name": "feature",
+                        "in": "formData",
+                        "required": true
                     },
                     {
-                        "type": "integer",
-                        "description": "RGW Bucket Lock Period(Required value if the lock box is checked)",
-                        "name": "lock_retention_period_days",
-                        "in": "formData"
+                        "type": "boolean",
+                        "description": "Enable Feature",
+                        "name": "enabled",
+                        "in": "formData",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
-                        "schema": {}
+                        "description": "Success",
+                        "schema": {
+                            "type": "string"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -5308,9 +4761,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/api/v1/image/{pool_name}/{image_name}/flatten": {
             "post": {
-                "description": "RADOS Gateway 버킷을 생성합니다.",
+                "description": "클론 이미지에 부모 데이터를 복사하여 부모와의 관계를 끊는 작업을 백그라운드로 시작합니다. 진행률은 작업 목록에서 확인합니다.",
                 "consumes": [
                     "application/x-www-form-urlencoded"
                 ],
@@ -5318,57 +4773,31 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "RGW-Bucket"
+                    "Image"
                 ],
-                "summary": "Create of RADOS Gateway Bucket",
+                "summary": "Flatten of Cloned Image",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "RGW Bucket Name",
-                        "name": "bucket_name",
-                        "in": "formData",
+                        "description": "Glue Pool Name",
+                        "name": "pool_name",
+                        "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "RGW User Name",
-                        "name": "username",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "enum": [
-                            true,
-                            false
-                        ],
-                        "type": "boolean",
-                        "default": false,
-                        "description": "RGW Bucket Lock Enabled",
-                        "name": "lock_enabled",
-                        "in": "formData",
+                        "description": "Glue Image Name",
+                        "name": "image_name",
+                        "in": "path",
                         "required": true
-                    },
-                    {
-                        "enum": [
-                            "compliance",
-                            "governance"
-                        ],
-                        "type": "string",
-                        "description": "RGW Bucket Lock Mode",
-                        "name": "lock_mode",
-                        "in": "formData"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "RGW Bucket Lock Period",
-                        "name": "lock_retention_period_days",
-                        "in": "formData"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
-                        "schema": {}
+                        "schema": {
+                            "$ref": "#/definitions/ImageTask"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -5389,32 +4818,54 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "RADOS Gateway 버킷을 삭제합니다.",
+            }
+        },
+        "/api/v1/image/{pool_name}/{image_name}/import": {
+            "put": {
+                "description": "요청 본문으로 스트리밍한 raw 데이터로 새 이미지를 생성합니다. compress 를 gzip 으로 지정하면 압축을 풀어서 가져옵니다.",
                 "consumes": [
-                    "application/x-www-form-urlencoded"
+                    "application/octet-stream"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "RGW-Bucket"
+                    "Image"
                 ],
-                "summary": "Delete of RADOS Gateway Bucket",
+                "summary": "Import of Image",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "RGW Bucket Name",
-                        "name": "bucket_name",
-                        "in": "query",
+                        "description": "Glue Pool Name",
+                        "name": "pool_name",
+                        "in": "path",
                         "required": true
-                    }
+                    },
+                    {
+                        "type": "string",
+                        "description": "New Glue Image Name",
+                        "name": "image_name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "none",
+                            "gzip"
+                        ],
+                        "type": "string",
+                        "default": "none",
+                        "description": "Compression",
+                        "name": "compress",
+                        "in": "query"
+                    }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
-                        "schema": {}
+                        "description": "Success",
+                        "schema": {
+                            "type": "string"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -5437,63 +4888,44 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/rgw/quota": {
-            "post": {
-                "description": "RADOS Gateway Quota를 설정 및 활성화합니다.",
+        "/api/v1/image/{pool_name}/{image_name}/import-diff": {
+            "put": {
+                "description": "요청 본문으로 스트리밍한 변경분을 기존 이미지에 적용합니다. 변경분의 시작 스냅샷이 이미지에 있어야 하며, 적용 후 끝 스냅샷이 생성됩니다.",
                 "consumes": [
-                    "application/x-www-form-urlencoded"
+                    "application/octet-stream"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "RGW"
+                    "Image"
                 ],
-                "summary": "Setting of RADOS Gateway Quota",
+                "summary": "Import of Image Diff",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "RGW User ID Name",
-                        "name": "username",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "enum": [
-                            "user",
-                            "bucket"
-                        ],
-                        "type": "string",
-                        "description": "RGW Quota Target",
-                        "name": "scope",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "description": "RGW Quota Max Objects",
-                        "name": "max_objects",
-                        "in": "formData",
+                        "description": "Glue Pool Name",
+                        "name": "pool_name",
+                        "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "RGW Quota Max Size(B/K/M/G/T)",
-                        "name": "max_size",
-                        "in": "formData",
+                        "description": "Glue Image Name",
+                        "name": "image_name",
+                        "in": "path",
                         "required": true
                     },
                     {
                         "enum": [
-                            "enable",
-                            "disable"
+                            "none",
+                            "gzip"
                         ],
                         "type": "string",
-                        "default": "enable",
-                        "description": "RGW Quota Whether Activated",
-                        "name": "state",
-                        "in": "formData",
-                        "required": true
+                        "default": "none",
+                        "description": "Compression",
+                        "name": "compress",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -5524,9 +4956,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/rgw/user": {
+        "/api/v1/image/{pool_name}/{image_name}/lineage": {
             "get": {
-                "description": "RADOS Gateway User의 리스트 및 정보를 보여줍니다.",
+                "description": "이미지의 부모 체인(가까운 부모부터)과 스냅샷별 자식 클론 트리를 보여줍니다.",
                 "consumes": [
                     "application/x-www-form-urlencoded"
                 ],
@@ -5534,22 +4966,30 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "RGW-User"
+                    "Image"
                 ],
-                "summary": "List and Info of RADOS Gateway Users",
+                "summary": "Show Lineage of Image",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "RGW User Name",
-                        "name": "username",
-                        "in": "query"
+                        "description": "Glue Pool Name",
+                        "name": "pool_name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Glue Image Name",
+                        "name": "image_name",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/RgwUserInfo"
+                            "$ref": "#/definitions/ImageLineage"
                         }
                     },
                     "400": {
@@ -5571,9 +5011,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/api/v1/image/{pool_name}/{image_name}/name": {
             "put": {
-                "description": "RADOS Gateway User를 수정합니다.",
+                "description": "같은 스토리지 풀 안에서 이미지 이름을 변경합니다.",
                 "consumes": [
                     "application/x-www-form-urlencoded"
                 ],
@@ -5581,49 +5023,30 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "RGW-User"
+                    "Image"
                 ],
-                "summary": "Update of RADOS Gateway User",
+                "summary": "Rename of Image",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "RGW User ID Name",
-                        "name": "username",
-                        "in": "formData",
+                        "description": "Glue Pool Name",
+                        "name": "pool_name",
+                        "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "RGW User Display Name",
-                        "name": "display_name",
-                        "in": "formData"
-                    },
-                    {
-                        "type": "string",
-                        "description": "RGW User Email ",
-                        "name": "email",
-                        "in": "formData"
-                    },
-                    {
-                        "enum": [
-                            "s3"
-                        ],
-                        "type": "string",
-                        "description": "RGW User S3",
-                        "name": "key_type",
-                        "in": "formData"
-                    },
-                    {
-                        "type": "string",
-                        "description": "RGW User S3 Access Key",
-                        "name": "access_key",
-                        "in": "formData"
+                        "description": "Glue Image Name",
+                        "name": "image_name",
+                        "in": "path",
+                        "required": true
                     },
                     {
                         "type": "string",
-                        "description": "RGW User S3 Secret Key",
-                        "name": "secret_key",
-                        "in": "formData"
+                        "description": "New Glue Image Name",
+                        "name": "new_image_name",
+                        "in": "formData",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -5652,9 +5075,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
-                "description": "RADOS Gateway User를 생성합니다.",
+            }
+        },
+        "/api/v1/image/{pool_name}/{image_name}/qos": {
+            "get": {
+                "description": "이미지에 실제로 적용되는 rbd QoS 설정(IOPS, BPS, 읽기/쓰기별 제한과 버스트)을 보여줍니다. source 로 전역, 풀, 이미지 중 어느 단계의 값인지 알 수 있습니다.",
                 "consumes": [
                     "application/x-www-form-urlencoded"
                 ],
@@ -5662,36 +5087,30 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "RGW-User"
+                    "Image"
                 ],
-                "summary": "Create of RADOS Gateway User",
+                "summary": "Show QoS of Image",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "RGW User ID Name",
-                        "name": "username",
-                        "in": "formData",
+                        "description": "Glue Pool Name",
+                        "name": "pool_name",
+                        "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "RGW  User Display Name",
-                        "name": "display_name",
-                        "in": "formData",
+                        "description": "Glue Image Name",
+                        "name": "image_name",
+                        "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "RGW User Email",
-                        "name": "email",
-                        "in": "formData"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Success",
+                        "description": "OK",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/ImageQos"
                         }
                     },
                     "400": {
@@ -5714,8 +5133,8 @@ const docTemplate = `{
                     }
                 }
             },
-            "delete": {
-                "description": "RADOS Gateway User를 삭제합니다.",
+            "put": {
+                "description": "이미지 단계의 rbd QoS 설정을 변경합니다. 지정한 옵션만 변경하며, 값이 0 이면 풀이나 전역 설정과 관계없이 제한하지 않습니다.",
                 "consumes": [
                     "application/x-www-form-urlencoded"
                 ],
@@ -5723,16 +5142,131 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "RGW-User"
+                    "Image"
                 ],
-                "summary": "Delete of RADOS Gateway User",
+                "summary": "Update QoS of Image",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "RGW User ID Name",
-                        "name": "username",
-                        "in": "query",
+                        "description": "Glue Pool Name",
+                        "name": "pool_name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Glue Image Name",
+                        "name": "image_name",
+                        "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "IOPS Limit",
+                        "name": "iops_limit",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "IOPS Burst",
+                        "name": "iops_burst",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "IOPS Burst Seconds",
+                        "name": "iops_burst_seconds",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "BPS Limit",
+                        "name": "bps_limit",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "BPS Burst",
+                        "name": "bps_burst",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "BPS Burst Seconds",
+                        "name": "bps_burst_seconds",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Read IOPS Limit",
+                        "name": "read_iops_limit",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Read IOPS Burst",
+                        "name": "read_iops_burst",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Read IOPS Burst Seconds",
+                        "name": "read_iops_burst_seconds",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Write IOPS Limit",
+                        "name": "write_iops_limit",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Write IOPS Burst",
+                        "name": "write_iops_burst",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Write IOPS Burst Seconds",
+                        "name": "write_iops_burst_seconds",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Read BPS Limit",
+                        "name": "read_bps_limit",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Read BPS Burst",
+                        "name": "read_bps_burst",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Read BPS Burst Seconds",
+                        "name": "read_bps_burst_seconds",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Write BPS Limit",
+                        "name": "write_bps_limit",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Write BPS Burst",
+                        "name": "write_bps_burst",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Write BPS Burst Seconds",
+                        "name": "write_bps_burst_seconds",
+                        "in": "formData"
                     }
                 ],
                 "responses": {
@@ -5761,11 +5295,9 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/api/v1/service": {
-            "get": {
-                "description": "Glue 서비스 목록 또는 정보를 보여줍니다.",
+            },
+            "delete": {
+                "description": "이미지 단계에서 설정한 rbd QoS 옵션을 지워 풀 또는 전역 설정을 상속하도록 합니다. 이름을 지정하지 않으면 모든 옵션을 초기화합니다.",
                 "consumes": [
                     "application/x-www-form-urlencoded"
                 ],
@@ -5773,27 +5305,37 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Service"
+                    "Image"
                 ],
-                "summary": "Show List or Info of Glue Service",
+                "summary": "Reset QoS of Image",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Glue Service Name",
-                        "name": "service_name",
-                        "in": "query"
+                        "description": "Glue Pool Name",
+                        "name": "pool_name",
+                        "in": "path",
+                        "required": true
                     },
                     {
                         "type": "string",
-                        "description": "Glue Service Type",
-                        "name": "service_type",
+                        "description": "Glue Image Name",
+                        "name": "image_name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "QoS Option Name (ex. iops_limit)",
+                        "name": "name",
                         "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
-                        "schema": {}
+                        "description": "Success",
+                        "schema": {
+                            "type": "string"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -5816,9 +5358,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/service/{service_name}": {
-            "post": {
-                "description": "Glue 서비스를 제어합니다.",
+        "/api/v1/image/{pool_name}/{image_name}/size": {
+            "put": {
+                "description": "Glue 스토리지 풀의 이미지 크기를 변경합니다. 크기를 줄이면 데이터가 삭제되므로 allow_shrink 를 지정해야 합니다.",
                 "consumes": [
                     "application/x-www-form-urlencoded"
                 ],
@@ -5826,28 +5368,37 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Service"
+                    "Image"
                 ],
-                "summary": "Control of Glue Service",
+                "summary": "Resize of Image",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Glue Service Name",
-                        "name": "service_name",
+                        "description": "Glue Pool Name",
+                        "name": "pool_name",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "enum": [
-                            "start",
-                            "stop",
-                            "restart"
-                        ],
                         "type": "string",
-                        "description": "Glue Service Control",
-                        "name": "control",
-                        "in": "query",
+                        "description": "Glue Image Name",
+                        "name": "image_name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Image Size(default:GB)",
+                        "name": "size",
+                        "in": "formData",
                         "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Allow Shrinking Image",
+                        "name": "allow_shrink",
+                        "in": "formData"
                     }
                 ],
                 "responses": {
@@ -5876,9 +5427,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Glue 서비스를 삭제합니다.",
+            }
+        },
+        "/api/v1/image/{pool_name}/{image_name}/snapshot": {
+            "get": {
+                "description": "이미지의 스냅샷 목록(크기, 생성 시각, 보호 여부)을 보여줍니다.",
                 "consumes": [
                     "application/x-www-form-urlencoded"
                 ],
@@ -5886,63 +5439,34 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Service"
+                    "Image"
                 ],
-                "summary": "Delete of Glue Service",
+                "summary": "Show List of Image Snapshots",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Glue Service Name",
-                        "name": "service_name",
+                        "description": "Glue Pool Name",
+                        "name": "pool_name",
                         "in": "path",
                         "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "Success",
-                        "schema": {
-                            "type": "string"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/HTTP400BadRequest"
-                        }
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/HTTP404NotFound"
-                        }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/HTTP500InternalServerError"
-                        }
+                    {
+                        "type": "string",
+                        "description": "Glue Image Name",
+                        "name": "image_name",
+                        "in": "path",
+                        "required": true
                     }
-                }
-            }
-        },
-        "/api/v1/smb": {
-            "get": {
-                "description": "SMB 서비스 데몬 상태를 조회합니다.",
-                "consumes": [
-                    "application/x-www-form-urlencoded"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "SMB"
                 ],
-                "summary": "Show Status of Smb Servcie Daemon",
                 "responses": {
                     "200": {
                         "description": "OK",
-                        "schema": {}
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/ImageSnapshot"
+                            }
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -5965,7 +5489,7 @@ const docTemplate = `{
                 }
             },
             "post": {
-                "description": "SMB 서비스 전체를 생성합니다.",
+                "description": "이미지 스냅샷을 생성합니다. host_name 과 vm_name 을 지정하면 virsh domfsfreeze 로 게스트 파일시스템을 고정한 뒤 스냅샷을 생성합니다.",
                 "consumes": [
                     "application/x-www-form-urlencoded"
                 ],
@@ -5973,98 +5497,42 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "SMB"
+                    "Image"
                 ],
-                "summary": "Create of Smb Service",
+                "summary": "Create of Image Snapshot",
                 "parameters": [
-                    {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        },
-                        "collectionFormat": "multi",
-                        "description": "SMB Server Host Name",
-                        "name": "hosts",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "enum": [
-                            "normal",
-                            "ads"
-                        ],
-                        "type": "string",
-                        "default": "normal",
-                        "description": "Samba Security Type",
-                        "name": "sec_type",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "SMB Share Folder Name",
-                        "name": "folder_name",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "SMB Server Actual Shared Path",
-                        "name": "path",
-                        "in": "formData",
-                        "required": true
-                    },
                     {
                         "type": "string",
-                        "description": "Glue File System Name",
-                        "name": "fs_name",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Glue File System Volume Path",
-                        "name": "volume_path",
-                        "in": "formData",
+                        "description": "Glue Pool Name",
+                        "name": "pool_name",
+                        "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "SMB Username or Active Directory Username",
-                        "name": "username",
-                        "in": "formData",
+                        "description": "Glue Image Name",
+                        "name": "image_name",
+                        "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "SMB Password or Active Directory Password",
-                        "name": "password",
+                        "description": "Snapshot Name",
+                        "name": "snapshot_name",
                         "in": "formData",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "Active Directory Domain",
-                        "name": "realm",
+                        "description": "Host Name of VM",
+                        "name": "host_name",
                         "in": "formData"
                     },
                     {
                         "type": "string",
-                        "description": "Active Directory Server IP",
-                        "name": "dns",
+                        "description": "VM Name For Filesystem Freeze",
+                        "name": "vm_name",
                         "in": "formData"
-                    },
-                    {
-                        "enum": [
-                            true,
-                            false
-                        ],
-                        "type": "boolean",
-                        "default": true,
-                        "description": "Active Directory Client Side Caching Policy",
-                        "name": "cache_policy",
-                        "in": "formData",
-                        "required": true
                     }
                 ],
                 "responses": {
@@ -6093,9 +5561,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/api/v1/image/{pool_name}/{image_name}/snapshot/{snapshot_name}": {
             "delete": {
-                "description": "SMB 서비스 전체를 삭제합니다.",
+                "description": "이미지 스냅샷을 삭제합니다. 보호된 스냅샷은 보호를 해제한 뒤 삭제할 수 있습니다.",
                 "consumes": [
                     "application/x-www-form-urlencoded"
                 ],
@@ -6103,19 +5573,29 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "SMB"
+                    "Image"
                 ],
-                "summary": "Delete of Smb Service",
+                "summary": "Delete of Image Snapshot",
                 "parameters": [
                     {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        },
-                        "collectionFormat": "multi",
-                        "description": "SMB Server Host Name",
-                        "name": "hosts",
-                        "in": "query",
+                        "type": "string",
+                        "description": "Glue Pool Name",
+                        "name": "pool_name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Glue Image Name",
+                        "name": "image_name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Snapshot Name",
+                        "name": "snapshot_name",
+                        "in": "path",
                         "required": true
                     }
                 ],
@@ -6147,9 +5627,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/smb/folder": {
+        "/api/v1/image/{pool_name}/{image_name}/snapshot/{snapshot_name}/clone": {
             "post": {
-                "description": "SMB 공유 폴더를 추가 생성합니다.",
+                "description": "보호된 스냅샷에서 임의의 스토리지 풀에 클론 이미지를 생성합니다.",
                 "consumes": [
                     "application/x-www-form-urlencoded"
                 ],
@@ -6157,70 +5637,42 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "SMB"
+                    "Image"
                 ],
-                "summary": "Add Share Folder of Smb Service",
+                "summary": "Clone of Image Snapshot",
                 "parameters": [
                     {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        },
-                        "collectionFormat": "multi",
-                        "description": "SMB Server Host Name",
-                        "name": "hosts",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "enum": [
-                            "normal",
-                            "ads"
-                        ],
-                        "type": "string",
-                        "default": "normal",
-                        "description": "Samba Security Type",
-                        "name": "sec_type",
-                        "in": "formData",
+                        "type": "string",
+                        "description": "Glue Pool Name",
+                        "name": "pool_name",
+                        "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "SMB Share Folder Name",
-                        "name": "folder_name",
-                        "in": "formData",
+                        "description": "Glue Image Name",
+                        "name": "image_name",
+                        "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "SMB Server Actual Shared Path",
-                        "name": "path",
-                        "in": "formData",
+                        "description": "Snapshot Name",
+                        "name": "snapshot_name",
+                        "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "Glue File System Name",
-                        "name": "fs_name",
+                        "description": "Clone Glue Pool Name",
+                        "name": "dest_pool_name",
                         "in": "formData",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "Glue File System Volume Path",
-                        "name": "volume_path",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "enum": [
-                            true,
-                            false
-                        ],
-                        "type": "boolean",
-                        "default": true,
-                        "description": "Active Directory Client Side Caching Policy",
-                        "name": "cache_policy",
+                        "description": "Clone Glue Image Name",
+                        "name": "dest_image_name",
                         "in": "formData",
                         "required": true
                     }
@@ -6251,9 +5703,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "SMB 공유 폴더를 삭제합니다.",
+            }
+        },
+        "/api/v1/image/{pool_name}/{image_name}/snapshot/{snapshot_name}/name": {
+            "put": {
+                "description": "이미지 스냅샷 이름을 변경합니다.",
                 "consumes": [
                     "application/x-www-form-urlencoded"
                 ],
@@ -6261,40 +5715,36 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "SMB"
+                    "Image"
                 ],
-                "summary": "Delete Share Folder of Smb Service",
+                "summary": "Rename of Image Snapshot",
                 "parameters": [
                     {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        },
-                        "collectionFormat": "multi",
-                        "description": "SMB Server Host Name",
-                        "name": "hosts",
-                        "in": "query",
+                        "type": "string",
+                        "description": "Glue Pool Name",
+                        "name": "pool_name",
+                        "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "SMB Share Folder Name",
-                        "name": "folder_name",
-                        "in": "query",
+                        "description": "Glue Image Name",
+                        "name": "image_name",
+                        "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "SMB Server Actual Shared Path",
-                        "name": "path",
-                        "in": "query",
+                        "description": "Snapshot Name",
+                        "name": "snapshot_name",
+                        "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "Glue File System Name",
-                        "name": "fs_name",
-                        "in": "query",
+                        "description": "New Snapshot Name",
+                        "name": "new_snapshot_name",
+                        "in": "formData",
                         "required": true
                     }
                 ],
@@ -6326,9 +5776,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/smb/user": {
+        "/api/v1/image/{pool_name}/{image_name}/snapshot/{snapshot_name}/protect": {
             "put": {
-                "description": "SMB 서비스 사용자의 패스워드를 변경합니다.",
+                "description": "클론의 부모로 사용할 수 있도록 스냅샷을 보호하거나 보호를 해제합니다. 클론이 남아 있는 스냅샷은 보호를 해제할 수 없습니다.",
                 "consumes": [
                     "application/x-www-form-urlencoded"
                 ],
@@ -6336,32 +5786,35 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "SMB"
+                    "Image"
                 ],
-                "summary": "Update User of Smb Service",
+                "summary": "Protect or Unprotect of Image Snapshot",
                 "parameters": [
                     {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        },
-                        "collectionFormat": "multi",
-                        "description": "SMB Server Host Name",
-                        "name": "hosts",
-                        "in": "formData",
+                        "type": "string",
+                        "description": "Glue Pool Name",
+                        "name": "pool_name",
+                        "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "SMB Username",
-                        "name": "username",
-                        "in": "formData",
+                        "description": "Glue Image Name",
+                        "name": "image_name",
+                        "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "SMB Password",
-                        "name": "password",
+                        "description": "Snapshot Name",
+                        "name": "snapshot_name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Protect Snapshot",
+                        "name": "protected",
                         "in": "formData",
                         "required": true
                     }
@@ -6392,9 +5845,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/api/v1/image/{pool_name}/{image_name}/snapshot/{snapshot_name}/rollback": {
             "post": {
-                "description": "SMB 서비스 사용자를 생성합니다.",
+                "description": "이미지를 스냅샷 시점으로 되돌립니다. 이미지를 사용 중인 클라이언트가 있으면 force 를 지정해야 합니다.",
                 "consumes": [
                     "application/x-www-form-urlencoded"
                 ],
@@ -6402,34 +5857,37 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "SMB"
+                    "Image"
                 ],
-                "summary": "Create User of Smb Service",
+                "summary": "Rollback of Image To Snapshot",
                 "parameters": [
                     {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        },
-                        "collectionFormat": "multi",
-                        "description": "SMB Server Host Name",
-                        "name": "hosts",
-                        "in": "formData",
+                        "type": "string",
+                        "description": "Glue Pool Name",
+                        "name": "pool_name",
+                        "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "SMB Username",
-                        "name": "username",
-                        "in": "formData",
+                        "description": "Glue Image Name",
+                        "name": "image_name",
+                        "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "SMB Password",
-                        "name": "password",
-                        "in": "formData",
+                        "description": "Snapshot Name",
+                        "name": "snapshot_name",
+                        "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Rollback Even If Image Is In Use",
+                        "name": "force",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -6458,9 +5916,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "SMB 서비스 사용자를 삭제합니다.",
+            }
+        },
+        "/api/v1/ingress": {
+            "put": {
+                "description": "Glue Ingress Service를 수정합니다.",
                 "consumes": [
                     "application/x-www-form-urlencoded"
                 ],
@@ -6468,27 +5928,67 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "SMB"
+                    "Ingress"
                 ],
-                "summary": "Delete User of Smb Service",
+                "summary": "Update of Glue Ingress Service",
                 "parameters": [
+                    {
+                        "type": "string",
+                        "description": "NFS or RGW Ingress Service Name",
+                        "name": "service_id",
+                        "in": "formData",
+                        "required": true
+                    },
                     {
                         "type": "array",
                         "items": {
                             "type": "string"
                         },
                         "collectionFormat": "multi",
-                        "description": "SMB Server Host Name",
+                        "description": "NFS or RGW Ingress Host Name",
                         "name": "hosts",
-                        "in": "query",
+                        "in": "formData",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "SMB Username",
-                        "name": "username",
-                        "in": "query",
+                        "description": "NFS or RGW Cluster Type",
+                        "name": "backend_service",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "NFS or RGW Ingress Virtual Ip",
+                        "name": "virtual_ip",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "maximum": 65535,
+                        "type": "integer",
+                        "description": "NFS or RGW Ingress Access Port",
+                        "name": "frontend_port",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "maximum": 65535,
+                        "type": "integer",
+                        "description": "NFS or RGW Ingress HA Proxy for Load Balancer Port",
+                        "name": "monitor_port",
+                        "in": "formData",
                         "required": true
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "NFS or RGW Ingress Vitual IP of CIDR Networks",
+                        "name": "virtual_interface_networks",
+                        "in": "formData"
                     }
                 ],
                 "responses": {
@@ -6517,11 +6017,9 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/version": {
-            "get": {
-                "description": "API 의 버전을 보여줍니다.",
+            },
+            "post": {
+                "description": "Glue Ingress Service를 생성합니다.",
                 "consumes": [
                     "application/x-www-form-urlencoded"
                 ],
@@ -6529,227 +6027,9419 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "API"
+                    "Ingress"
                 ],
-                "summary": "Show Versions of API",
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/Version"
-                        }
+                "summary": "Create of Glue Ingress Service",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "NFS or RGW Ingress Service Name",
+                        "name": "service_id",
+                        "in": "formData",
+                        "required": true
                     },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/HTTP400BadRequest"
-                        }
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "NFS or RGW Ingress Host Name",
+                        "name": "hosts",
+                        "in": "formData",
+                        "required": true
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/HTTP404NotFound"
-                        }
+                    {
+                        "type": "string",
+                        "description": "NFS or RGW Cluster Type",
+                        "name": "backend_service",
+                        "in": "formData",
+                        "required": true
                     },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/HTTP500InternalServerError"
-                        }
-                    }
-                }
-            }
-        }
+                    {
+                        "type": "string",
+                        "description": "NFS or RGW Ingress Virtual Ip",
+                        "name": "virtual_ip",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "maximum": 65535,
+                        "type": "integer",
+                        "description": "NFS or RGW Ingress Access Port",
+                        "name": "frontend_port",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "maximum": 65535,
+                        "type": "integer",
+                        "description": "NFS or RGW Ingress HA Proxy for Load Balancer Port",
+                        "name": "monitor_port",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "NFS or RGW Ingress Vitual IP of CIDR Networks",
+                        "name": "virtual_interface_networks",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/iscsi": {
+            "put": {
+                "description": "Iscsi 서비스 데몬을 수정합니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Iscsi"
+                ],
+                "summary": "Update of Iscsi Servcie Daemon",
+                "parameters": [
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Host Name",
+                        "name": "hosts",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ISCSI Service Name",
+                        "name": "service_id",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Pool Name",
+                        "name": "pool",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "maximum": 65535,
+                        "type": "integer",
+                        "description": "ISCSI API Port",
+                        "name": "api_port",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ISCSI API User",
+                        "name": "api_user",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ISCSI API Password",
+                        "name": "api_password",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Iscsi Service Daemon Count",
+                        "name": "count",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Iscsi 서비스 데몬을 생성합니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Iscsi"
+                ],
+                "summary": "Create of Iscsi Servcie Daemon",
+                "parameters": [
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Host Name",
+                        "name": "hosts",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ISCSI Service Name",
+                        "name": "service_id",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Pool Name",
+                        "name": "pool",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "maximum": 65535,
+                        "type": "integer",
+                        "description": "ISCSI API Port",
+                        "name": "api_port",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ISCSI API User",
+                        "name": "api_user",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ISCSI API Password",
+                        "name": "api_password",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Iscsi Service Daemon Count",
+                        "name": "count",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/iscsi/discovery": {
+            "get": {
+                "description": "Iscsi 계정 정보를 가져옵니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Iscsi"
+                ],
+                "summary": "Show of Iscsi Discovery Auth Details",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/Auth"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Iscsi 계정 정보를 수정합니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Iscsi"
+                ],
+                "summary": "Update of Iscsi Discovery Auth Details",
+                "parameters": [
+                    {
+                        "maxLength": 64,
+                        "minLength": 8,
+                        "type": "string",
+                        "description": "Iscsi Discovery Authorization Username",
+                        "name": "user",
+                        "in": "formData"
+                    },
+                    {
+                        "maxLength": 16,
+                        "minLength": 12,
+                        "type": "string",
+                        "description": "Iscsi Discovery Authorization Password",
+                        "name": "password",
+                        "in": "formData"
+                    },
+                    {
+                        "maxLength": 64,
+                        "minLength": 8,
+                        "type": "string",
+                        "description": "Iscsi Discovery Authorization Mutual Username",
+                        "name": "mutual_user",
+                        "in": "formData"
+                    },
+                    {
+                        "maxLength": 16,
+                        "minLength": 12,
+                        "type": "string",
+                        "description": "Iscsi Discovery Authorization Mutual Password",
+                        "name": "mutual_password",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/Auth"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/iscsi/target": {
+            "get": {
+                "description": "Iscsi 타겟 리스트를 가져옵니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "IscsiTarget"
+                ],
+                "summary": "Show List of Iscsi Target",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Iscsi Target IQN Name",
+                        "name": "iqn_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {}
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Iscsi 타겟을 수정합니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "IscsiTarget"
+                ],
+                "summary": "Update of Iscsi Target",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Iscsi Target Old IQN Name",
+                        "name": "iqn_id",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Iscsi Target New IQN Name",
+                        "name": "new_iqn_id",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Gateway Host Name",
+                        "name": "hosts",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Gateway Host IP Address",
+                        "name": "ip_address",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Glue Pool Name",
+                        "name": "pool_name",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Glue Image Name",
+                        "name": "image_name",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Iscsi Authentication",
+                        "name": "acl_enabled",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "maxLength": 64,
+                        "minLength": 8,
+                        "type": "string",
+                        "description": "Iscsi Auth User",
+                        "name": "username",
+                        "in": "formData"
+                    },
+                    {
+                        "maxLength": 16,
+                        "minLength": 12,
+                        "type": "string",
+                        "description": "Iscsi Auth Password",
+                        "name": "password",
+                        "in": "formData"
+                    },
+                    {
+                        "maxLength": 64,
+                        "minLength": 8,
+                        "type": "string",
+                        "description": "Iscsi Auth Mutual User",
+                        "name": "mutual_username",
+                        "in": "formData"
+                    },
+                    {
+                        "maxLength": 16,
+                        "minLength": 12,
+                        "type": "string",
+                        "description": "Iscsi Auth Mutaul Password",
+                        "name": "mutual_password",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {}
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Iscsi 타겟을 생성합니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "IscsiTarget"
+                ],
+                "summary": "Create of Iscsi Target",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Iscsi Target IQN Name",
+                        "name": "iqn_id",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Gateway Host Name",
+                        "name": "hosts",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Gateway Host IP Address",
+                        "name": "ip_address",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Glue Pool Name",
+                        "name": "pool_name",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Glue Image Name",
+                        "name": "image_name",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Iscsi Authentication",
+                        "name": "acl_enabled",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "maxLength": 64,
+                        "minLength": 8,
+                        "type": "string",
+                        "description": "Iscsi Auth User",
+                        "name": "username",
+                        "in": "formData"
+                    },
+                    {
+                        "maxLength": 16,
+                        "minLength": 12,
+                        "type": "string",
+                        "description": "Iscsi Auth Password",
+                        "name": "password",
+                        "in": "formData"
+                    },
+                    {
+                        "maxLength": 64,
+                        "minLength": 8,
+                        "type": "string",
+                        "description": "Iscsi Auth Mutual User",
+                        "name": "mutual_username",
+                        "in": "formData"
+                    },
+                    {
+                        "maxLength": 16,
+                        "minLength": 12,
+                        "type": "string",
+                        "description": "Iscsi Auth Mutaul Password",
+                        "name": "mutual_password",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Iscsi 타겟을 삭제합니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "IscsiTarget"
+                ],
+                "summary": "Delete of Iscsi Target",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Iscsi Target IQN Name",
+                        "name": "iqn_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/iscsi/target/purge": {
+            "delete": {
+                "description": "Iscsi 타겟을 파괴합니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "IscsiTarget"
+                ],
+                "summary": "Purge of Iscsi Target",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Iscsi Target IQN Name",
+                        "name": "iqn_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/iscsi/target/qos": {
+            "get": {
+                "description": "Iscsi 타겟의 디스크로 노출된 이미지에 실제로 적용되는 rbd QoS 설정을 보여줍니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "IscsiTarget"
+                ],
+                "summary": "Show QoS of Iscsi Target Disks",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Iscsi Target IQN Name",
+                        "name": "iqn_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Glue Pool Name",
+                        "name": "pool_name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Glue Image Name",
+                        "name": "image_name",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/ImageQos"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Iscsi 타겟의 디스크로 노출된 이미지의 rbd QoS 설정을 변경합니다. 디스크를 지정하지 않으면 타겟의 모든 디스크에 적용합니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "IscsiTarget"
+                ],
+                "summary": "Update QoS of Iscsi Target Disks",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Iscsi Target IQN Name",
+                        "name": "iqn_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Glue Pool Name",
+                        "name": "pool_name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Glue Image Name",
+                        "name": "image_name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "IOPS Limit",
+                        "name": "iops_limit",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "IOPS Burst",
+                        "name": "iops_burst",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "IOPS Burst Seconds",
+                        "name": "iops_burst_seconds",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "BPS Limit",
+                        "name": "bps_limit",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "BPS Burst",
+                        "name": "bps_burst",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "BPS Burst Seconds",
+                        "name": "bps_burst_seconds",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Read IOPS Limit",
+                        "name": "read_iops_limit",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Read IOPS Burst",
+                        "name": "read_iops_burst",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Read IOPS Burst Seconds",
+                        "name": "read_iops_burst_seconds",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Write IOPS Limit",
+                        "name": "write_iops_limit",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Write IOPS Burst",
+                        "name": "write_iops_burst",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Write IOPS Burst Seconds",
+                        "name": "write_iops_burst_seconds",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Read BPS Limit",
+                        "name": "read_bps_limit",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Read BPS Burst",
+                        "name": "read_bps_burst",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Read BPS Burst Seconds",
+                        "name": "read_bps_burst_seconds",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Write BPS Limit",
+                        "name": "write_bps_limit",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Write BPS Burst",
+                        "name": "write_bps_burst",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Write BPS Burst Seconds",
+                        "name": "write_bps_burst_seconds",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Iscsi 타겟의 디스크로 노출된 이미지에서 이미지 단계로 설정한 rbd QoS 옵션을 지워 풀 또는 전역 설정을 상속하도록 합니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "IscsiTarget"
+                ],
+                "summary": "Reset QoS of Iscsi Target Disks",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Iscsi Target IQN Name",
+                        "name": "iqn_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Glue Pool Name",
+                        "name": "pool_name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Glue Image Name",
+                        "name": "image_name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "QoS Option Name (ex. iops_limit)",
+                        "name": "name",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/license": {
+            "get": {
+                "description": "라이센스를 조회합니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "License"
+                ],
+                "summary": "Show License",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "description": "Lience 상세정보 및 리스트 구조체"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/license/controlHostAgent/{action}": {
+            "get": {
+                "description": "Mold Agent를 제어합니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "License"
+                ],
+                "summary": "ControlHostAgent",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Agent action(start, stop)",
+                        "name": "action",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "description": "Lience 상세정보 및 리스트 구조체"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/license/isLicenseExpired": {
+            "get": {
+                "description": "라이센스 만료일을 조회합니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "License"
+                ],
+                "summary": "IsLicenseExpired",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "description": "Lience 상세정보 및 리스트 구조체"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/metric": {
+            "get": {
+                "description": "백그라운드에서 수집한 Glue 상태 지표를 조회 구간과 간격에 맞춰 시계열로 보여줍니다. pool_name 을 지정하면 풀 지표를 조회합니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Metric"
+                ],
+                "summary": "Show Time Series of Glue Status Metrics",
+                "parameters": [
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Metric Name(health, data_bytes, bytes_used, bytes_avail, bytes_total, read_bytes_sec, write_bytes_sec, read_op_per_sec, write_op_per_sec, num_osds, num_up_osds, num_in_osds / pool: stored, bytes_used, max_avail, percent_used, objects)",
+                        "name": "metric",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Pool Name",
+                        "name": "pool_name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Start Time(Unix Seconds or RFC3339)",
+                        "name": "start",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "End Time(Unix Seconds or RFC3339)",
+                        "name": "end",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Step Seconds",
+                        "name": "step",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/MetricSeries"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/metric/forecast": {
+            "get": {
+                "description": "수집한 사용량 이력으로 클러스터와 각 풀이 nearfull, full 비율에 도달하는 시점을 선형 및 계절성 회귀로 예측합니다. 풀은 복제 수(size) 또는 EC 오버헤드((k+m)/k)를 반영하며, 수집한 샘플과 같이 항상 로컬 클러스터를 대상으로 합니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Metric"
+                ],
+                "summary": "Show Capacity Forecast of Glue Cluster and Pools",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 30,
+                        "description": "History Days Used For Forecast",
+                        "name": "history_days",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/CapacityForecast"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/metric/sample": {
+            "get": {
+                "description": "백그라운드에서 수집한 Glue 상태 샘플 원본을 조회 구간에 맞춰 보여줍니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Metric"
+                ],
+                "summary": "Show Raw Samples of Glue Status Metrics",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Start Time(Unix Seconds or RFC3339)",
+                        "name": "start",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "End Time(Unix Seconds or RFC3339)",
+                        "name": "end",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/MetricSample"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/mirror": {
+            "get": {
+                "description": "Glue 의 미러링 상태를 보여줍니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Mirror"
+                ],
+                "summary": "Show Status of Mirror",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/MirrorStatus"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Glue 의 미러링 클러스터의 설정을 변경합니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Mirror"
+                ],
+                "summary": "Put Mirroring Cluster",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Mirroring Schedule Interval",
+                        "name": "interval",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Mold API request URL",
+                        "name": "moldUrl",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Mold Admin Api Key",
+                        "name": "moldApiKey",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Mold Admin Secret Key",
+                        "name": "moldSecretKey",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Mold"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Glue 의 미러링 클러스터를 설정합니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Mirror"
+                ],
+                "summary": "Setup Mirroring Cluster",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/MirrorSetup"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Glue 의 미러링 클러스터를 제거합니다.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Mirror"
+                ],
+                "summary": "Delete Mirroring Cluster",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Remote Cluster Host Address",
+                        "name": "host",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "Remote Cluster PrivateKey",
+                        "name": "privateKeyFile",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Pool Name for Mirroring",
+                        "name": "mirrorPool",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/MirrorSetup"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/mirror/garbage": {
+            "delete": {
+                "description": "Glue 의 미러링 클러스터 가비지를 제거합니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Mirror"
+                ],
+                "summary": "Delete Mirroring Cluster Garbage",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/Message"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/mirror/image/demote/{mirrorPool}/{imageName}": {
+            "delete": {
+                "description": "Peer Glue 의 이미지를 Demote 합니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Mirror"
+                ],
+                "summary": "Peer Demote Image Mirroring",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Pool Name for Mirroring",
+                        "name": "mirrorPool",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Image Name for Mirroring",
+                        "name": "imageName",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ImageStatus"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/mirror/image/info/{mirrorPool}/{imageName}": {
+            "get": {
+                "description": "Glue 의 이미지에 미러링 정보를 확인합니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Mirror"
+                ],
+                "summary": "Show Mirroring Image Parent Info",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Pool Name for Mirroring",
+                        "name": "mirrorPool",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Image Name for Mirroring",
+                        "name": "imageName",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ImageInfo"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/mirror/image/promote/peer/{mirrorPool}/{imageName}": {
+            "post": {
+                "description": "Peer Glue 의 이미지를 Promote 합니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Mirror"
+                ],
+                "summary": "Peer Promote Image Mirroring",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Pool Name for Mirroring",
+                        "name": "mirrorPool",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Image Name for Mirroring",
+                        "name": "imageName",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ImageStatus"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/mirror/image/promote/{mirrorPool}/{imageName}": {
+            "post": {
+                "description": "Glue 의 이미지를 Promote 합니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Mirror"
+                ],
+                "summary": "Promote Image Mirroring",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Pool Name for Mirroring",
+                        "name": "mirrorPool",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Image Name for Mirroring",
+                        "name": "imageName",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ImageStatus"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/mirror/image/resync/peer/{mirrorPool}/{imageName}": {
+            "put": {
+                "description": "Peer Glue 의 이미지를 resync 합니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Mirror"
+                ],
+                "summary": "Peer Resync Image Mirroring",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Pool Name for Mirroring",
+                        "name": "mirrorPool",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Image Name for Mirroring",
+                        "name": "imageName",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ImageStatus"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/mirror/image/resync/{mirrorPool}/{imageName}": {
+            "put": {
+                "description": "Glue 의 이미지를 resync 합니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Mirror"
+                ],
+                "summary": "Resync Image Mirroring",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Pool Name for Mirroring",
+                        "name": "mirrorPool",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Image Name for Mirroring",
+                        "name": "imageName",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ImageStatus"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/mirror/image/snapshot/{mirrorPool}/{vmName}": {
+            "post": {
+                "description": "Glue 의 이미지에 미러링 스냅샷을 생성하거나 스케줄을 설정합니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Mirror"
+                ],
+                "summary": "Take Image Mirroring Snapshot or Setup Image Mirroring Snapshot Schedule",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Pool Name for Mirroring",
+                        "name": "mirrorPool",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "VM Name for Mirroring",
+                        "name": "vmName",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Host Name for Mirroring VMe",
+                        "name": "hostName",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Image Name for Mirroring (Schedule)",
+                        "name": "imageName",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Image List for Mirroring (Manual)",
+                        "name": "imageList",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ImageMirror"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/mirror/image/status/{mirrorPool}/{imageName}": {
+            "get": {
+                "description": "Glue 의 이미지에 미러링상태를 확인합니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Mirror"
+                ],
+                "summary": "Show Mirroring Image Status",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Pool Name for Mirroring",
+                        "name": "mirrorPool",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Image Name for Mirroring",
+                        "name": "imageName",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ImageStatus"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/mirror/image/{mirrorPool}": {
+            "get": {
+                "description": "미러링중인 이미지의 목록과 상태를 보여줍니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Mirror"
+                ],
+                "summary": "Show List of Mirrored Snapshot",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "mirrorPool",
+                        "name": "mirrorPool",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/MirrorList"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/mirror/image/{mirrorPool}/{imageName}": {
+            "get": {
+                "description": "미러링중인 이미지의 정보를 보여줍니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Mirror"
+                ],
+                "summary": "Show Information of Mirrored Snapshot",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "mirrorPool",
+                        "name": "mirrorPool",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "imageName",
+                        "name": "imageName",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ImageMirror"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "이미지의 미러링 스케줄링을 비활성화 합니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Mirror"
+                ],
+                "summary": "Delete Mirrored Snapshot Schedule",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "pool",
+                        "name": "mirrorPool",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "imageName",
+                        "name": "imageName",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/Message"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/mirror/image/{mirrorPool}/{imageName}/{hostName}/{vmName}": {
+            "post": {
+                "description": "Glue 의 이미지에 미러링 스케줄을 설정합니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Mirror"
+                ],
+                "summary": "Setup Image Mirroring Schedule",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Pool Name for Mirroring",
+                        "name": "mirrorPool",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Image Name for Mirroring",
+                        "name": "imageName",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Host Name",
+                        "name": "hostName",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "VM Name",
+                        "name": "vmName",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Volume Type",
+                        "name": "volType",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ImageMirror"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/mirror/pool/{mirrorPool}": {
+            "post": {
+                "description": "Glue 의 미러링 클러스터를 활성화합니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Mirror"
+                ],
+                "summary": "Enable Mirroring",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Remote Cluster Host Address",
+                        "name": "host",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "Remote Cluster PrivateKey",
+                        "name": "privateKeyFile",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Pool Name for Mirroring",
+                        "name": "mirrorPool",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ImageStatus"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Glue 의 미러링 클러스터를 비활성화합니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Mirror"
+                ],
+                "summary": "Disable Mirroring",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Remote Cluster Host Address",
+                        "name": "host",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "Remote Cluster PrivateKey",
+                        "name": "privateKeyFile",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Pool Name for Mirroring",
+                        "name": "mirrorPool",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ImageStatus"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/nfs": {
+            "get": {
+                "description": "Glue NFS Cluster의 리스트 및 상세정보를 보여줍니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "NFS"
+                ],
+                "summary": "Show List of Info of Glue NFS Cluster",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "NFS Cluster Identifier",
+                        "name": "cluster_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {}
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/nfs/export": {
+            "get": {
+                "description": "Glue NFS Export 상세 정보를 보여줍니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "NFS"
+                ],
+                "summary": "Show Detail of Glue NFS Export",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "NFS Cluster Identifier",
+                        "name": "cluster_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "description": "Glue NFS Export 상세정보 구조체",
+                            "type": "array",
+                            "items": {
+                                "type": "object",
+                                "properties": {
+                                    "access_type": {
+                                        "type": "string"
+                                    },
+                                    "clients": {
+                                        "type": "array",
+                                        "items": {}
+                                    },
+                                    "cluster_id": {
+                                        "type": "string"
+                                    },
+                                    "export_id": {
+                                        "type": "integer"
+                                    },
+                                    "fsal": {
+                                        "type": "object",
+                                        "properties": {
+                                            "fs_name": {
+                                                "type": "string"
+                                            },
+                                            "name": {
+                                                "type": "string"
+                                            },
+                                            "user_id": {
+                                                "type": "string"
+                                            }
+                                        }
+                                    },
+                                    "path": {
+                                        "type": "string"
+                                    },
+                                    "protocols": {
+                                        "type": "array",
+                                        "items": {
+                                            "type": "integer"
+                                        }
+                                    },
+                                    "pseudo": {
+                                        "type": "string"
+                                    },
+                                    "security_label": {
+                                        "type": "boolean"
+                                    },
+                                    "squash": {
+                                        "type": "string"
+                                    },
+                                    "transports": {
+                                        "type": "array",
+                                        "items": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/nfs/export/{cluster_id}": {
+            "put": {
+                "description": "Glue NFS Export를 수정합니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "NFS"
+                ],
+                "summary": "Update of Glue NFS Export",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "NFS Cluster Identifier",
+                        "name": "cluster_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "NFS Export ID",
+                        "name": "export_id",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "RW",
+                            "RO",
+                            "NONE"
+                        ],
+                        "type": "string",
+                        "default": "RW",
+                        "description": "NFS Access Type",
+                        "name": "access_type",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "FS Name(if stroage_name is CEPH Required value)",
+                        "name": "fs_name",
+                        "in": "formData"
+                    },
+                    {
+                        "enum": [
+                            "CEPH",
+                            "RGW"
+                        ],
+                        "type": "string",
+                        "description": "NFS Storage Name",
+                        "name": "storage_name",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Glue FS Path or Glue RGW Bucket Name",
+                        "name": "path",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "NFS Export Path",
+                        "name": "pseudo",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "no_root_squash",
+                            "root_id_squash",
+                            "all_squash",
+                            "root_squash"
+                        ],
+                        "type": "string",
+                        "default": "no_root_squash",
+                        "description": "Squash",
+                        "name": "squash",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "default": "TCP",
+                        "description": "Transports",
+                        "name": "transports",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Security Label",
+                        "name": "security_label",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Glue NFS Export를 생성합니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "NFS"
+                ],
+                "summary": "Create of Glue NFS Export",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "NFS Cluster Identifier",
+                        "name": "cluster_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "RW",
+                            "RO",
+                            "NONE"
+                        ],
+                        "type": "string",
+                        "default": "RW",
+                        "description": "NFS Access Type",
+                        "name": "access_type",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "FS Name(if stroage_name is CEPH Required value)",
+                        "name": "fs_name",
+                        "in": "formData"
+                    },
+                    {
+                        "enum": [
+                            "CEPH",
+                            "RGW"
+                        ],
+                        "type": "string",
+                        "description": "NFS Storage Name",
+                        "name": "storage_name",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Glue FS Path or Glue RGW Bucket Name",
+                        "name": "path",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "NFS Export Path",
+                        "name": "pseudo",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "no_root_squash",
+                            "root_id_squash",
+                            "all_squash",
+                            "root_squash"
+                        ],
+                        "type": "string",
+                        "default": "no_root_squash",
+                        "description": "Squash",
+                        "name": "squash",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "default": "TCP",
+                        "description": "Transports",
+                        "name": "transports",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Security Label",
+                        "name": "security_label",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/nfs/export/{cluster_id}/{export_id}": {
+            "delete": {
+                "description": "Glue NFS Export를 삭제합니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "NFS"
+                ],
+                "summary": "Delete of Glue NFS Export",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "NFS Cluster Identifier",
+                        "name": "cluster_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "NFS Export ID",
+                        "name": "export_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/nfs/{cluster_id}": {
+            "delete": {
+                "description": "Glue NFS Cluster를 삭제합니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "NFS"
+                ],
+                "summary": "Delete of Glue NFS Cluster",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "NFS Cluster Identifier",
+                        "name": "cluster_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/nfs/{cluster_id}/{port}": {
+            "put": {
+                "description": "Glue NFS Cluster를 수정합니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "NFS"
+                ],
+                "summary": "Update of Glue NFS Cluster",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "NFS Cluster Identifier",
+                        "name": "cluster_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cluster Port",
+                        "name": "port",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Cluster Daemon Hostname",
+                        "name": "hosts",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Cluster Daemon Service Count",
+                        "name": "service_count",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Glue NFS Cluster를 생성합니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "NFS"
+                ],
+                "summary": "Create of Glue NFS Cluster",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "NFS Cluster Identifier",
+                        "name": "cluster_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cluster Port",
+                        "name": "port",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Cluster Daemon Hostname",
+                        "name": "hosts",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Cluster Daemon Service Count",
+                        "name": "service_count",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/nvmeof": {
+            "post": {
+                "description": "NVMe-OF 서비스를 생성합니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "NVMe-OF"
+                ],
+                "summary": "Create of NVMe-OF Service",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Glue NVMe-OF Store Data In Pool Name",
+                        "name": "pool_name",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Glue NVMe-OF Service Placement Hosts",
+                        "name": "hosts",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/nvmeof/image": {
+            "get": {
+                "description": "NVMe-OF CLI 명령에 사용하는 이미지와 게이트웨이 이미지를 보여줍니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "NVMe-OF"
+                ],
+                "summary": "Show NVMe-OF Images",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/NvmeOfImage"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Glue 업그레이드 후 게이트웨이 버전에 맞춰 NVMe-OF CLI 이미지를 변경합니다. 변경 후 이미지 다운로드를 다시 실행해야 합니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "NVMe-OF"
+                ],
+                "summary": "Update of NVMe-OF CLI Image",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "NVMe-OF CLI Image(quay.io/ceph/nvmeof-cli:x.y.z)",
+                        "name": "image",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/nvmeof/image/download": {
+            "post": {
+                "description": "NVMe-OF 이미지를 최신으로 다운받습니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "NVMe-OF"
+                ],
+                "summary": "Download of NVMe-OF Image",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Glue NVMe-OF Gateway IP",
+                        "name": "gateway_ip",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/nvmeof/namespace": {
+            "get": {
+                "description": "NVMe-OF의 Sub System 리스트를 보여줍니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "NVMe-OF-NameSpace"
+                ],
+                "summary": "Show List of NVMe-OF Sub Systems",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Glue NVMe-OF Sub System NQN ID",
+                        "name": "subsystem_nqn_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/NvmeOfNameSpaceList"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "NVMe-OF의 네임스페이스를 생성합니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "NVMe-OF-NameSpace"
+                ],
+                "summary": "Create of NVMe-OF NameSpace",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Glue NVMe-OF Sub System NQN ID",
+                        "name": "subsystem_nqn_id",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Glue Pool Name",
+                        "name": "pool_name",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Glue Image Name",
+                        "name": "image_name",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Glue NVMe-OF Image Size(default GB)",
+                        "name": "size",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "NVMe-OF의 네임스페이스를 삭제합니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "NVMe-OF-NameSpace"
+                ],
+                "summary": "Delete of NVMe-OF NameSpace",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Glue NVMe-OF Sub System NQN ID",
+                        "name": "subsystem_nqn_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Glue NVMe-OF NameSpace UUID",
+                        "name": "namespace_uuid",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            true,
+                            false
+                        ],
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Glue NVMe-OF Image Delete Check",
+                        "name": "image_del_check",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Glue Pool Name",
+                        "name": "pool_name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Glue Image Name",
+                        "name": "image_name",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/nvmeof/namespace/qos": {
+            "get": {
+                "description": "NVMe-OF 네임스페이스로 노출된 이미지에 실제로 적용되는 rbd QoS 설정을 보여줍니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "NVMe-OF-NameSpace"
+                ],
+                "summary": "Show QoS of NVMe-OF NameSpaces",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Glue NVMe-OF Sub System NQN ID",
+                        "name": "subsystem_nqn_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Glue NVMe-OF NameSpace UUID",
+                        "name": "namespace_uuid",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/ImageQos"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "NVMe-OF 네임스페이스로 노출된 이미지의 rbd QoS 설정을 변경합니다. 네임스페이스를 지정하지 않으면 서브시스템의 모든 네임스페이스에 적용합니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "NVMe-OF-NameSpace"
+                ],
+                "summary": "Update QoS of NVMe-OF NameSpaces",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Glue NVMe-OF Sub System NQN ID",
+                        "name": "subsystem_nqn_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Glue NVMe-OF NameSpace UUID",
+                        "name": "namespace_uuid",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "IOPS Limit",
+                        "name": "iops_limit",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "IOPS Burst",
+                        "name": "iops_burst",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "IOPS Burst Seconds",
+                        "name": "iops_burst_seconds",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "BPS Limit",
+                        "name": "bps_limit",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "BPS Burst",
+                        "name": "bps_burst",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "BPS Burst Seconds",
+                        "name": "bps_burst_seconds",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Read IOPS Limit",
+                        "name": "read_iops_limit",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Read IOPS Burst",
+                        "name": "read_iops_burst",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Read IOPS Burst Seconds",
+                        "name": "read_iops_burst_seconds",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Write IOPS Limit",
+                        "name": "write_iops_limit",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Write IOPS Burst",
+                        "name": "write_iops_burst",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Write IOPS Burst Seconds",
+                        "name": "write_iops_burst_seconds",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Read BPS Limit",
+                        "name": "read_bps_limit",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Read BPS Burst",
+                        "name": "read_bps_burst",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Read BPS Burst Seconds",
+                        "name": "read_bps_burst_seconds",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Write BPS Limit",
+                        "name": "write_bps_limit",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Write BPS Burst",
+                        "name": "write_bps_burst",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Write BPS Burst Seconds",
+                        "name": "write_bps_burst_seconds",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "NVMe-OF 네임스페이스로 노출된 이미지에서 이미지 단계로 설정한 rbd QoS 옵션을 지워 풀 또는 전역 설정을 상속하도록 합니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "NVMe-OF-NameSpace"
+                ],
+                "summary": "Reset QoS of NVMe-OF NameSpaces",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Glue NVMe-OF Sub System NQN ID",
+                        "name": "subsystem_nqn_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Glue NVMe-OF NameSpace UUID",
+                        "name": "namespace_uuid",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "QoS Option Name (ex. iops_limit)",
+                        "name": "name",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/nvmeof/subsystem": {
+            "get": {
+                "description": "NVMe-OF의 Sub System 리스트를 보여줍니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "NVMe-OF-SubSystem"
+                ],
+                "summary": "Show List of NVMe-OF Sub System",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Glue NVMe-OF Sub System NQN ID",
+                        "name": "subsystem_nqn_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/NvmeOfSubSystemList"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "NVMe-OF의 Sub System을 생성합니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "NVMe-OF-SubSystem"
+                ],
+                "summary": "Create of NVMe-OF Sub System",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Glue NVMe-OF Gateway IP",
+                        "name": "gateway_ip",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Glue NVMe-OF Sub System NQN ID",
+                        "name": "subsystem_nqn_id",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "NVMe-OF Sub System을 삭제합니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "NVMe-OF-SubSystem"
+                ],
+                "summary": "Delete of NVMe-OF Sub System",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Glue NVMe-OF Sub System NQN ID",
+                        "name": "subsystem_nqn_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/nvmeof/target": {
+            "get": {
+                "description": "NVMe-OF의 타겟 리스트를 보여줍니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "NVMe-OF"
+                ],
+                "summary": "Show List of NVMe-OF Target",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Glue NVMe-OF Sub System NQN ID",
+                        "name": "subsystem_nqn_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "object",
+                                "properties": {
+                                    "allow_any_host": {
+                                        "type": "boolean"
+                                    },
+                                    "hosts": {
+                                        "type": "array",
+                                        "items": {}
+                                    },
+                                    "listen_addresses": {
+                                        "type": "array",
+                                        "items": {
+                                            "type": "object",
+                                            "properties": {
+                                                "adrfam": {
+                                                    "type": "string"
+                                                },
+                                                "traddr": {
+                                                    "type": "string"
+                                                },
+                                                "transport": {
+                                                    "type": "string"
+                                                },
+                                                "trsvcid": {
+                                                    "type": "string"
+                                                },
+                                                "trtype": {
+                                                    "type": "string"
+                                                }
+                                            }
+                                        }
+                                    },
+                                    "max_cntlid": {
+                                        "type": "integer"
+                                    },
+                                    "max_namespaces": {
+                                        "type": "integer"
+                                    },
+                                    "min_cntlid": {
+                                        "type": "integer"
+                                    },
+                                    "model_number": {
+                                        "type": "string"
+                                    },
+                                    "namespaces": {
+                                        "type": "array",
+                                        "items": {
+                                            "type": "object",
+                                            "properties": {
+                                                "bdev_name": {
+                                                    "type": "string"
+                                                },
+                                                "block_size": {
+                                                    "type": "integer"
+                                                },
+                                                "name": {
+                                                    "type": "string"
+                                                },
+                                                "nguid": {
+                                                    "type": "string"
+                                                },
+                                                "nsid": {
+                                                    "type": "integer"
+                                                },
+                                                "rbd_image_name": {
+                                                    "type": "string"
+                                                },
+                                                "rbd_image_size": {
+                                                    "type": "string"
+                                                },
+                                                "rbd_pool_name": {
+                                                    "type": "string"
+                                                },
+                                                "uuid": {
+                                                    "type": "string"
+                                                }
+                                            }
+                                        }
+                                    },
+                                    "nqn": {
+                                        "type": "string"
+                                    },
+                                    "serial_number": {
+                                        "type": "string"
+                                    },
+                                    "session": {
+                                        "type": "integer"
+                                    },
+                                    "subtype": {
+                                        "type": "string"
+                                    }
+                                }
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "NVMe-OF 타켓을 생성합니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "NVMe-OF"
+                ],
+                "summary": "Create of NVMe-OF Target",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Glue NVMe-OF Gateway IP",
+                        "name": "gateway_ip",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Glue NVMe-OF Sub System NQN ID",
+                        "name": "subsystem_nqn_id",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Glue NVMe-OF Use Image Pool Name",
+                        "name": "pool_name",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Glue NVMe-OF Use Image Name",
+                        "name": "image_name",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Glue NVMe-OF Image Size(default GB)",
+                        "name": "size",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/osd": {
+            "get": {
+                "description": "Glue OSD 목록 또는 상세정보(호스트, 디바이스, 클래스, 사용률, 상태)를 보여줍니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Osd"
+                ],
+                "summary": "Show List or Info of OSDs",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "OSD ID",
+                        "name": "osd_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/Osd"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "호스트에서 선택한 디스크에 OSD 를 생성합니다. db_devices, wal_devices 를 지정하면 BlueStore DB/WAL 을 NVMe 등 빠른 디스크에 둡니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Osd"
+                ],
+                "summary": "Create of OSDs On Devices",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Host Name",
+                        "name": "hostname",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Data Device Paths",
+                        "name": "data_devices",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "DB Device Paths",
+                        "name": "db_devices",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "WAL Device Paths",
+                        "name": "wal_devices",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/osd/device": {
+            "get": {
+                "description": "호스트의 디스크 목록(크기, 종류, 회전 여부, 상태)과 OSD 로 사용할 수 없는 이유를 보여줍니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Osd"
+                ],
+                "summary": "Show List of Disk Devices",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Host Name",
+                        "name": "hostname",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Show Only Available Devices",
+                        "name": "available",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Refresh Device Inventory",
+                        "name": "refresh",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/Device"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/osd/flag": {
+            "get": {
+                "description": "noout, norebalance 등 클러스터 OSD 플래그의 설정 상태를 보여줍니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Osd"
+                ],
+                "summary": "Show List of OSD Flags",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/OsdFlag"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "클러스터 OSD 플래그를 설정하거나 해제합니다. 유지보수 중에는 noout, norebalance 를 설정하여 불필요한 데이터 이동을 막습니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Osd"
+                ],
+                "summary": "Set or Unset OSD Flag",
+                "parameters": [
+                    {
+                        "enum": [
+                            "noout",
+                            "norebalance",
+                            "nobackfill",
+                            "norecover",
+                            "noscrub",
+                            "nodeep-scrub",
+                            "noup",
+                            "nodown",
+                            "noin"
+                        ],
+                        "type": "string",
+                        "description": "OSD Flag",
+                        "name": "flag",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Set(true) or Unset(false)",
+                        "name": "enabled",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/osd/remove": {
+            "get": {
+                "description": "제거 중인 OSD 의 PG 비우기 진행 상황과 남은 PG 수를 보여줍니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Osd"
+                ],
+                "summary": "Show Progress of OSD Removal",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/OsdRmStatus"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/osd/remove/{osd_id}": {
+            "delete": {
+                "description": "진행 중인 OSD 제거를 중단합니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Osd"
+                ],
+                "summary": "Stop OSD Removal",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "OSD ID",
+                        "name": "osd_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/osd/spec": {
+            "post": {
+                "description": "OSD 서비스 명세로 호스트의 디스크에 OSD 를 생성합니다. 경로 또는 디스크 종류로 데이터와 DB/WAL 디스크를 선택하며, dry_run 이면 생성될 OSD 미리보기만 보여줍니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Osd"
+                ],
+                "summary": "Apply of OSD Service Spec",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "OSD Service ID",
+                        "name": "service_id",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Placement Hosts",
+                        "name": "hosts",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Placement Host Label",
+                        "name": "label",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Data Device Paths",
+                        "name": "data_devices",
+                        "in": "formData"
+                    },
+                    {
+                        "enum": [
+                            "all",
+                            "hdd",
+                            "ssd"
+                        ],
+                        "type": "string",
+                        "default": "all",
+                        "description": "Data Device Type",
+                        "name": "data_type",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "DB Device Paths",
+                        "name": "db_devices",
+                        "in": "formData"
+                    },
+                    {
+                        "enum": [
+                            "hdd",
+                            "ssd"
+                        ],
+                        "type": "string",
+                        "description": "DB Device Type",
+                        "name": "db_type",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "WAL Device Paths",
+                        "name": "wal_devices",
+                        "in": "formData"
+                    },
+                    {
+                        "enum": [
+                            "hdd",
+                            "ssd"
+                        ],
+                        "type": "string",
+                        "description": "WAL Device Type",
+                        "name": "wal_type",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Encrypt OSDs",
+                        "name": "encrypted",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "OSDs Per Device",
+                        "name": "osds_per_device",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Only Preview OSDs",
+                        "name": "dry_run",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/osd/{osd_id}": {
+            "put": {
+                "description": "Glue OSD 를 out 으로 표시하여 데이터를 다른 OSD 로 옮기거나 다시 in 으로 표시합니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Osd"
+                ],
+                "summary": "Mark OSD In or Out",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "OSD ID",
+                        "name": "osd_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "in",
+                            "out"
+                        ],
+                        "type": "string",
+                        "description": "OSD State",
+                        "name": "state",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "ceph orch osd rm 으로 OSD 의 PG 를 비운 뒤 제거합니다. ok-to-stop 또는 safe-to-destroy 검사를 통과하지 못하면 제거하지 않으므로, 사용 중인 OSD 는 먼저 out 으로 표시하고 데이터 이동이 끝난 뒤 제거합니다. 진행 상황은 /api/v1/osd/remove 에서 확인합니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Osd"
+                ],
+                "summary": "Remove or Replace OSD",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "OSD ID",
+                        "name": "osd_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Keep OSD ID For Replacement Disk",
+                        "name": "replace",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Zap Device After Removal",
+                        "name": "zap",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/osd/{osd_id}/safety": {
+            "get": {
+                "description": "ceph osd ok-to-stop, safe-to-destroy 결과로 OSD 를 중지하거나 제거해도 데이터 가용성과 내구성이 유지되는지 보여줍니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Osd"
+                ],
+                "summary": "Show Safety of Stopping or Destroying OSD",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "OSD ID",
+                        "name": "osd_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/OsdSafety"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/pg": {
+            "get": {
+                "description": "PG 목록과 acting set, 풀, 객체 상태를 보여줍니다. state 를 지정하지 않으면 active+clean 이 아닌 PG 만 보여줍니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pg"
+                ],
+                "summary": "Show List of Placement Groups",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Pool Name",
+                        "name": "pool",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "PG States(inconsistent, degraded, undersized, ...)",
+                        "name": "state",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Show All PGs",
+                        "name": "all",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/Pg"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/pg/stuck": {
+            "get": {
+                "description": "inactive, unclean, stale, undersized, degraded 상태로 멈춰 있는 PG 목록을 보여줍니다. type 을 지정하지 않으면 모든 상태를 조회합니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pg"
+                ],
+                "summary": "Show List of Stuck Placement Groups",
+                "parameters": [
+                    {
+                        "enum": [
+                            "inactive",
+                            "unclean",
+                            "stale",
+                            "undersized",
+                            "degraded"
+                        ],
+                        "type": "string",
+                        "description": "Stuck Type",
+                        "name": "type",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/Pg"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/pg/{pg_id}": {
+            "post": {
+                "description": "PG 에 scrub, deep-scrub, repair 를 요청합니다. repair 는 불일치 객체를 정상 샤드로 복구합니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pg"
+                ],
+                "summary": "Scrub or Repair of Placement Group",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "PG ID",
+                        "name": "pg_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "scrub",
+                            "deep-scrub",
+                            "repair"
+                        ],
+                        "type": "string",
+                        "description": "Scrub Action",
+                        "name": "action",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/pg/{pg_id}/inconsistent": {
+            "get": {
+                "description": "scrub 에서 발견된 PG 의 불일치 객체와 OSD 샤드별 오류를 보여줍니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pg"
+                ],
+                "summary": "Show Inconsistent Objects of Placement Group",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "PG ID",
+                        "name": "pg_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/PgInconsistent"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/pool": {
+            "get": {
+                "description": "Glue 의 스토리지 풀 목록을 보여줍니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pool"
+                ],
+                "summary": "List Pools of Glue",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "pool_type",
+                        "name": "pool_type",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {}
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Glue 스토리지 풀을 생성합니다. 복제(replicated) 또는 EC(erasure) 풀을 생성할 수 있으며 rbd 애플리케이션은 rbd pool init 으로 초기화합니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pool"
+                ],
+                "summary": "Create of Pool",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Pool Name",
+                        "name": "pool_name",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "replicated",
+                            "erasure"
+                        ],
+                        "type": "string",
+                        "default": "replicated",
+                        "description": "Pool Type",
+                        "name": "pool_type",
+                        "in": "formData"
+                    },
+                    {
+                        "enum": [
+                            "rbd",
+                            "cephfs",
+                            "rgw"
+                        ],
+                        "type": "string",
+                        "description": "Pool Application",
+                        "name": "application",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Placement Group Count",
+                        "name": "pg_num",
+                        "in": "formData"
+                    },
+                    {
+                        "enum": [
+                            "on",
+                            "off",
+                            "warn"
+                        ],
+                        "type": "string",
+                        "description": "PG Autoscale Mode",
+                        "name": "pg_autoscale_mode",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "CRUSH Rule Name",
+                        "name": "crush_rule",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "default": "default",
+                        "description": "Erasure Code Profile Name",
+                        "name": "erasure_code_profile",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Replica Size",
+                        "name": "size",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Replica Min Size",
+                        "name": "min_size",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/pool/stats": {
+            "get": {
+                "description": "모든 Glue 스토리지 풀의 사용량, 할당량 사용률, 복제 및 EC 설정, PG 수와 자동 조정 권장값, 클라이언트 I/O 를 보여줍니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pool"
+                ],
+                "summary": "Show Statistics of All Pools",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/PoolStats"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/pool/{pool_name}": {
+            "put": {
+                "description": "Glue 스토리지 풀의 복제 수, 할당량, 압축, PG 자동 조정 설정, CRUSH 규칙을 변경합니다. 입력한 항목만 변경합니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pool"
+                ],
+                "summary": "Update of Pool Properties",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Pool Name",
+                        "name": "pool_name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Replica Size",
+                        "name": "size",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Replica Min Size",
+                        "name": "min_size",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Quota Max Bytes(0 is unlimited)",
+                        "name": "max_bytes",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Quota Max Objects(0 is unlimited)",
+                        "name": "max_objects",
+                        "in": "formData"
+                    },
+                    {
+                        "enum": [
+                            "none",
+                            "passive",
+                            "aggressive",
+                            "force"
+                        ],
+                        "type": "string",
+                        "description": "Compression Mode",
+                        "name": "compression_mode",
+                        "in": "formData"
+                    },
+                    {
+                        "enum": [
+                            "snappy",
+                            "zlib",
+                            "zstd",
+                            "lz4"
+                        ],
+                        "type": "string",
+                        "description": "Compression Algorithm",
+                        "name": "compression_algorithm",
+                        "in": "formData"
+                    },
+                    {
+                        "enum": [
+                            "on",
+                            "off",
+                            "warn"
+                        ],
+                        "type": "string",
+                        "description": "PG Autoscale Mode",
+                        "name": "pg_autoscale_mode",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Placement Group Count",
+                        "name": "pg_num",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Target Size Ratio For PG Autoscale",
+                        "name": "target_size_ratio",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "CRUSH Rule Name",
+                        "name": "crush_rule",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Glue 스토리지 풀을 삭제합니다. 첫 번째 요청은 풀 사용 현황과 확인 토큰을 반환하며, 확인 토큰을 전달한 두 번째 요청에서 삭제합니다. 보호된 풀은 삭제할 수 없으며 사용 중인 풀은 force 를 지정해야 삭제합니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pool"
+                ],
+                "summary": "Delete of Pool",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "pool_name",
+                        "name": "pool_name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Delete Pool Even If It Is In Use",
+                        "name": "force",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Confirm Token Returned By First Request",
+                        "name": "confirm_token",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/PoolDeleteConfirm"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/pool/{pool_name}/protect": {
+            "put": {
+                "description": "Glue 스토리지 풀에 nodelete 플래그를 설정하여 삭제를 막거나 보호를 해제합니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pool"
+                ],
+                "summary": "Protect of Pool",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Pool Name",
+                        "name": "pool_name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Protect Pool From Deletion",
+                        "name": "protected",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/pool/{pool_name}/qos": {
+            "get": {
+                "description": "풀의 이미지가 상속받는 rbd QoS 설정을 보여줍니다. source 로 전역 또는 풀 중 어느 단계의 값인지 알 수 있습니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pool"
+                ],
+                "summary": "Show QoS of Pool",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Glue Pool Name",
+                        "name": "pool_name",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ImageQos"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "풀 단계의 rbd QoS 설정을 변경합니다. 이미지 단계에서 따로 설정하지 않은 풀의 모든 이미지에 적용됩니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pool"
+                ],
+                "summary": "Update QoS of Pool",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Glue Pool Name",
+                        "name": "pool_name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "IOPS Limit",
+                        "name": "iops_limit",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "IOPS Burst",
+                        "name": "iops_burst",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "IOPS Burst Seconds",
+                        "name": "iops_burst_seconds",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "BPS Limit",
+                        "name": "bps_limit",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "BPS Burst",
+                        "name": "bps_burst",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "BPS Burst Seconds",
+                        "name": "bps_burst_seconds",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Read IOPS Limit",
+                        "name": "read_iops_limit",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Read IOPS Burst",
+                        "name": "read_iops_burst",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Read IOPS Burst Seconds",
+                        "name": "read_iops_burst_seconds",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Write IOPS Limit",
+                        "name": "write_iops_limit",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Write IOPS Burst",
+                        "name": "write_iops_burst",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Write IOPS Burst Seconds",
+                        "name": "write_iops_burst_seconds",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Read BPS Limit",
+                        "name": "read_bps_limit",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Read BPS Burst",
+                        "name": "read_bps_burst",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Read BPS Burst Seconds",
+                        "name": "read_bps_burst_seconds",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Write BPS Limit",
+                        "name": "write_bps_limit",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Write BPS Burst",
+                        "name": "write_bps_burst",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Write BPS Burst Seconds",
+                        "name": "write_bps_burst_seconds",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "풀 단계에서 설정한 rbd QoS 옵션을 지워 전역 설정을 상속하도록 합니다. 이름을 지정하지 않으면 모든 옵션을 초기화합니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pool"
+                ],
+                "summary": "Reset QoS of Pool",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Glue Pool Name",
+                        "name": "pool_name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "QoS Option Name (ex. iops_limit)",
+                        "name": "name",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/pool/{pool_name}/scrub": {
+            "post": {
+                "description": "풀의 모든 PG 에 scrub, deep-scrub, repair 를 요청합니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pg"
+                ],
+                "summary": "Scrub or Repair of Pool",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Pool Name",
+                        "name": "pool_name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "scrub",
+                            "deep-scrub",
+                            "repair"
+                        ],
+                        "type": "string",
+                        "description": "Scrub Action",
+                        "name": "action",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/pool/{pool_name}/stats": {
+            "get": {
+                "description": "Glue 스토리지 풀의 사용량, 할당량 사용률, 복제 및 EC 설정, PG 수와 자동 조정 권장값, 클라이언트 I/O 를 보여줍니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pool"
+                ],
+                "summary": "Show Statistics of Pool",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Pool Name",
+                        "name": "pool_name",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/PoolStats"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/rgw": {
+            "get": {
+                "description": "RADOS Gateway Daemon 정보를 가져옵니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "RGW"
+                ],
+                "summary": "Show List of RADOS Gateway Daemon",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "object",
+                                "properties": {
+                                    "default": {
+                                        "type": "boolean"
+                                    },
+                                    "id": {
+                                        "type": "string"
+                                    },
+                                    "port": {
+                                        "type": "integer"
+                                    },
+                                    "realm_name": {
+                                        "type": "string"
+                                    },
+                                    "server_hostname": {
+                                        "type": "string"
+                                    },
+                                    "service_map_id": {
+                                        "type": "string"
+                                    },
+                                    "version": {
+                                        "type": "string"
+                                    },
+                                    "zone_name": {
+                                        "type": "string"
+                                    },
+                                    "zonegroup_name": {
+                                        "type": "string"
+                                    }
+                                }
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "RADOS Gateway Service를 수정합니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "RGW"
+                ],
+                "summary": "Update of RADOS Gateway Service",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "RGW Service Name",
+                        "name": "service_id",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "RGW Realm Name",
+                        "name": "realm_name",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "RGW Zone Group Name",
+                        "name": "zonegroup_name",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "RGW Zone Name",
+                        "name": "zone_name",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Service Port(default: 80)",
+                        "name": "port",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Service Placement Hosts",
+                        "name": "hosts",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "RADOS Gateway Service를 생성합니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "RGW"
+                ],
+                "summary": "Create of RADOS Gateway Service",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "RGW Service Name",
+                        "name": "service_name",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "RGW Realm Name",
+                        "name": "realm_name",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "RGW Zone Group Name",
+                        "name": "zonegroup_name",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "RGW Zone Name",
+                        "name": "zone_name",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Service Port(default: 80)",
+                        "name": "port",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Service Placement Host Name",
+                        "name": "hosts",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/rgw/bucket": {
+            "get": {
+                "description": "RADOS Gateway 버킷을 리스트를 보여줍니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "RGW-Bucket"
+                ],
+                "summary": "Show List of RADOS Gateway Bucket",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "RGW Bucket Name",
+                        "name": "bucket_name",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "true",
+                            "false"
+                        ],
+                        "type": "string",
+                        "default": "false",
+                        "description": "RGW Bucket List Detail",
+                        "name": "detail",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {}
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "RADOS Gateway 버킷을 수정합니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "RGW-Bucket"
+                ],
+                "summary": "Update of RADOS Gateway Bucket",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "RGW Bucket Name",
+                        "name": "bucket_name",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "RGW Bucket ID",
+                        "name": "bucket_id",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "RGW User Name",
+                        "name": "username",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "Enabled",
+                            "Suspended"
+                        ],
+                        "type": "string",
+                        "description": "RGW Bucket Lock Enabled",
+                        "name": "versioning",
+                        "in": "formData"
+                    },
+                    {
+                        "enum": [
+                            "compliance",
+                            "governance"
+                        ],
+                        "type": "string",
+                        "description": "RGW Bucket Lock Mode(Required value if the lock box is checked)",
+                        "name": "lock_mode",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "RGW Bucket Lock Period(Required value if the lock box is checked)",
+                        "name": "lock_retention_period_days",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {}
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "RADOS Gateway 버킷을 생성합니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "RGW-Bucket"
+                ],
+                "summary": "Create of RADOS Gateway Bucket",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "RGW Bucket Name",
+                        "name": "bucket_name",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "RGW User Name",
+                        "name": "username",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            true,
+                            false
+                        ],
+                        "type": "boolean",
+                        "default": false,
+                        "description": "RGW Bucket Lock Enabled",
+                        "name": "lock_enabled",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "compliance",
+                            "governance"
+                        ],
+                        "type": "string",
+                        "description": "RGW Bucket Lock Mode",
+                        "name": "lock_mode",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "RGW Bucket Lock Period",
+                        "name": "lock_retention_period_days",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {}
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "RADOS Gateway 버킷을 삭제합니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "RGW-Bucket"
+                ],
+                "summary": "Delete of RADOS Gateway Bucket",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "RGW Bucket Name",
+                        "name": "bucket_name",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {}
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/rgw/quota": {
+            "post": {
+                "description": "RADOS Gateway Quota를 설정 및 활성화합니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "RGW"
+                ],
+                "summary": "Setting of RADOS Gateway Quota",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "RGW User ID Name",
+                        "name": "username",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "user",
+                            "bucket"
+                        ],
+                        "type": "string",
+                        "description": "RGW Quota Target",
+                        "name": "scope",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "RGW Quota Max Objects",
+                        "name": "max_objects",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "RGW Quota Max Size(B/K/M/G/T)",
+                        "name": "max_size",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "enable",
+                            "disable"
+                        ],
+                        "type": "string",
+                        "default": "enable",
+                        "description": "RGW Quota Whether Activated",
+                        "name": "state",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/rgw/user": {
+            "get": {
+                "description": "RADOS Gateway User의 리스트 및 정보를 보여줍니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "RGW-User"
+                ],
+                "summary": "List and Info of RADOS Gateway Users",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "RGW User Name",
+                        "name": "username",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/RgwUserInfo"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "RADOS Gateway User를 수정합니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "RGW-User"
+                ],
+                "summary": "Update of RADOS Gateway User",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "RGW User ID Name",
+                        "name": "username",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "RGW User Display Name",
+                        "name": "display_name",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "RGW User Email ",
+                        "name": "email",
+                        "in": "formData"
+                    },
+                    {
+                        "enum": [
+                            "s3"
+                        ],
+                        "type": "string",
+                        "description": "RGW User S3",
+                        "name": "key_type",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "RGW User S3 Access Key",
+                        "name": "access_key",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "RGW User S3 Secret Key",
+                        "name": "secret_key",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "RADOS Gateway User를 생성합니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "RGW-User"
+                ],
+                "summary": "Create of RADOS Gateway User",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "RGW User ID Name",
+                        "name": "username",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "RGW  User Display Name",
+                        "name": "display_name",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "RGW User Email",
+                        "name": "email",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "RADOS Gateway User를 삭제합니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "RGW-User"
+                ],
+                "summary": "Delete of RADOS Gateway User",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "RGW User ID Name",
+                        "name": "username",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/service": {
+            "get": {
+                "description": "Glue 서비스 목록 또는 정보를 보여줍니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Service"
+                ],
+                "summary": "Show List or Info of Glue Service",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Glue Service Name",
+                        "name": "service_name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Glue Service Type",
+                        "name": "service_type",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {}
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/service/{service_name}": {
+            "post": {
+                "description": "Glue 서비스를 제어합니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Service"
+                ],
+                "summary": "Control of Glue Service",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Glue Service Name",
+                        "name": "service_name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "start",
+                            "stop",
+                            "restart"
+                        ],
+                        "type": "string",
+                        "description": "Glue Service Control",
+                        "name": "control",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Glue 서비스를 삭제합니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Service"
+                ],
+                "summary": "Delete of Glue Service",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Glue Service Name",
+                        "name": "service_name",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/smb": {
+            "get": {
+                "description": "SMB 서비스 데몬 상태를 조회합니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "SMB"
+                ],
+                "summary": "Show Status of Smb Servcie Daemon",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {}
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "SMB 서비스 전체를 생성합니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "SMB"
+                ],
+                "summary": "Create of Smb Service",
+                "parameters": [
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "SMB Server Host Name",
+                        "name": "hosts",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "normal",
+                            "ads"
+                        ],
+                        "type": "string",
+                        "default": "normal",
+                        "description": "Samba Security Type",
+                        "name": "sec_type",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "SMB Share Folder Name",
+                        "name": "folder_name",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "SMB Server Actual Shared Path",
+                        "name": "path",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Glue File System Name",
+                        "name": "fs_name",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Glue File System Volume Path",
+                        "name": "volume_path",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "SMB Username or Active Directory Username",
+                        "name": "username",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "SMB Password or Active Directory Password",
+                        "name": "password",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Active Directory Domain",
+                        "name": "realm",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Active Directory Server IP",
+                        "name": "dns",
+                        "in": "formData"
+                    },
+                    {
+                        "enum": [
+                            true,
+                            false
+                        ],
+                        "type": "boolean",
+                        "default": true,
+                        "description": "Active Directory Client Side Caching Policy",
+                        "name": "cache_policy",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "SMB 서비스 전체를 삭제합니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "SMB"
+                ],
+                "summary": "Delete of Smb Service",
+                "parameters": [
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "SMB Server Host Name",
+                        "name": "hosts",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/smb/folder": {
+            "post": {
+                "description": "SMB 공유 폴더를 추가 생성합니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "SMB"
+                ],
+                "summary": "Add Share Folder of Smb Service",
+                "parameters": [
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "SMB Server Host Name",
+                        "name": "hosts",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "normal",
+                            "ads"
+                        ],
+                        "type": "string",
+                        "default": "normal",
+                        "description": "Samba Security Type",
+                        "name": "sec_type",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "SMB Share Folder Name",
+                        "name": "folder_name",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "SMB Server Actual Shared Path",
+                        "name": "path",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Glue File System Name",
+                        "name": "fs_name",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Glue File System Volume Path",
+                        "name": "volume_path",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            true,
+                            false
+                        ],
+                        "type": "boolean",
+                        "default": true,
+                        "description": "Active Directory Client Side Caching Policy",
+                        "name": "cache_policy",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "SMB 공유 폴더를 삭제합니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "SMB"
+                ],
+                "summary": "Delete Share Folder of Smb Service",
+                "parameters": [
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "SMB Server Host Name",
+                        "name": "hosts",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "SMB Share Folder Name",
+                        "name": "folder_name",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "SMB Server Actual Shared Path",
+                        "name": "path",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Glue File System Name",
+                        "name": "fs_name",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/smb/user": {
+            "put": {
+                "description": "SMB 서비스 사용자의 패스워드를 변경합니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "SMB"
+                ],
+                "summary": "Update User of Smb Service",
+                "parameters": [
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "SMB Server Host Name",
+                        "name": "hosts",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "SMB Username",
+                        "name": "username",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "SMB Password",
+                        "name": "password",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "SMB 서비스 사용자를 생성합니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "SMB"
+                ],
+                "summary": "Create User of Smb Service",
+                "parameters": [
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "SMB Server Host Name",
+                        "name": "hosts",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "SMB Username",
+                        "name": "username",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "SMB Password",
+                        "name": "password",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "SMB 서비스 사용자를 삭제합니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "SMB"
+                ],
+                "summary": "Delete User of Smb Service",
+                "parameters": [
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "SMB Server Host Name",
+                        "name": "hosts",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "SMB Username",
+                        "name": "username",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/upgrade": {
+            "get": {
+                "description": "Glue 업그레이드 상태와 데몬 종류별로 대상 버전으로 업그레이드된 데몬 수를 보여줍니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Upgrade"
+                ],
+                "summary": "Show Progress of Glue Upgrade",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/UpgradeProgress"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "진행 중인 Glue 업그레이드를 일시 중지, 재개, 중단합니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Upgrade"
+                ],
+                "summary": "Control of Glue Upgrade",
+                "parameters": [
+                    {
+                        "enum": [
+                            "pause",
+                            "resume",
+                            "stop"
+                        ],
+                        "type": "string",
+                        "description": "Upgrade Control",
+                        "name": "control",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "사전 점검을 통과하면 Glue 업그레이드를 시작합니다. force 를 지정하면 점검 결과와 관계없이 시작합니다. nvmeof_cli_image 를 지정하면 NVMe-OF CLI 이미지도 함께 변경합니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Upgrade"
+                ],
+                "summary": "Start of Glue Upgrade",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Target Glue Version(x.y.z)",
+                        "name": "version",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Target Container Image",
+                        "name": "image",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Skip Pre-flight Blockers",
+                        "name": "force",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "NVMe-OF CLI Image For Target Version",
+                        "name": "nvmeof_cli_image",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/upgrade/check": {
+            "get": {
+                "description": "대상 버전의 이미지를 확인하고 클러스터 상태(HEALTH_OK), 재동기화 중인 미러링 이미지, 진행 중인 작업 등 업그레이드를 막는 항목을 보여줍니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Upgrade"
+                ],
+                "summary": "Pre-flight Check of Glue Upgrade",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Target Glue Version(x.y.z)",
+                        "name": "version",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Target Container Image",
+                        "name": "image",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/UpgradePreflight"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/upgrade/versions": {
+            "get": {
+                "description": "레지스트리에서 업그레이드할 수 있는 Glue 버전 목록을 보여줍니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Upgrade"
+                ],
+                "summary": "Show List of Available Glue Versions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Container Image Without Tag",
+                        "name": "image",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/UpgradeVersions"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/version": {
+            "get": {
+                "description": "API 의 버전을 보여줍니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "API"
+                ],
+                "summary": "Show Versions of API",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/Version"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP400BadRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP404NotFound"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/HTTP500InternalServerError"
+                        }
+                    }
+                }
+            }
+        }
     },
     "definitions": {
+        "Alert": {
+            "description": "Glue 경고 구조체",
+            "type": "object",
+            "properties": {
+                "firing_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "instance": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "resolved_at": {
+                    "type": "string"
+                },
+                "rule": {
+                    "type": "string"
+                },
+                "severity": {
+                    "type": "string"
+                },
+                "silenced": {
+                    "type": "boolean"
+                },
+                "starts_at": {
+                    "type": "string"
+                },
+                "state": {
+                    "type": "string"
+                }
+            }
+        },
+        "AlertRule": {
+            "description": "Glue 경고 규칙 구조체",
+            "type": "object",
+            "properties": {
+                "duration": {
+                    "description": "조건이 이 시간(초) 동안 유지되어야 firing 상태가 됩니다.",
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "severity": {
+                    "description": "warning, critical",
+                    "type": "string"
+                },
+                "target": {
+                    "description": "health_check 는 점검 코드(* 은 전체), pool_usage 는 풀 이름, mirror_image 는 미러링 풀 이름",
+                    "type": "string"
+                },
+                "threshold": {
+                    "type": "number"
+                },
+                "type": {
+                    "description": "health_check, cluster_usage, pool_usage, mirror_image, smb_host",
+                    "type": "string"
+                }
+            }
+        },
+        "AlertSilence": {
+            "description": "Glue 경고 무시 설정 구조체",
+            "type": "object",
+            "properties": {
+                "comment": {
+                    "type": "string"
+                },
+                "ends_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "instance": {
+                    "type": "string"
+                },
+                "rule": {
+                    "type": "string"
+                }
+            }
+        },
+        "ApplyResult": {
+            "description": "Glue 선언형 구성 적용 결과 구조체",
+            "type": "object",
+            "properties": {
+                "dry_run": {
+                    "type": "boolean"
+                },
+                "steps": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ApplyStep"
+                    }
+                }
+            }
+        },
+        "ApplyStep": {
+            "description": "Glue 선언형 구성 적용 계획의 리소스별 단계 구조체",
+            "type": "object",
+            "properties": {
+                "action": {
+                    "type": "string"
+                },
+                "detail": {
+                    "type": "string"
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "requires": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "result": {
+                    "type": "string"
+                }
+            }
+        },
         "Auth": {
             "type": "object",
             "properties": {
-                "mutual_password": {
+                "mutual_password": {
+                    "type": "string"
+                },
+                "mutual_user": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                },
+                "user": {
+                    "type": "string"
+                }
+            }
+        },
+        "BackupItem": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "section": {
+                    "type": "string"
+                }
+            }
+        },
+        "BackupRestoreResult": {
+            "description": "Glue 설정 복원 결과 구조체",
+            "type": "object",
+            "properties": {
+                "applied": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/BackupItem"
+                    }
+                },
+                "conflicts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/BackupItem"
+                    }
+                },
+                "dry_run": {
+                    "type": "boolean"
+                },
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/BackupItem"
+                    }
+                },
+                "fsid": {
+                    "type": "string"
+                },
+                "version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "CapacityForecast": {
+            "description": "Glue 클러스터 및 풀 용량 예측 구조체",
+            "type": "object",
+            "properties": {
+                "cluster": {
+                    "$ref": "#/definitions/ForecastItem"
+                },
+                "full_ratio": {
+                    "type": "number"
+                },
+                "history_end": {
+                    "type": "integer"
+                },
+                "history_start": {
+                    "type": "integer"
+                },
+                "nearfull_ratio": {
+                    "type": "number"
+                },
+                "pools": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ForecastItem"
+                    }
+                }
+            }
+        },
+        "Cluster": {
+            "description": "Glue API 에서 관리하는 클러스터 구조체",
+            "type": "object",
+            "properties": {
+                "client_name": {
+                    "type": "string"
+                },
+                "conf_path": {
+                    "type": "string"
+                },
+                "dashboard_url": {
+                    "type": "string"
+                },
+                "keyring_path": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "ssh_host": {
+                    "type": "string"
+                },
+                "ssh_key_path": {
+                    "type": "string"
+                }
+            }
+        },
+        "ClusterMetric": {
+            "type": "object",
+            "properties": {
+                "bytes_avail": {
+                    "type": "integer"
+                },
+                "bytes_total": {
+                    "type": "integer"
+                },
+                "bytes_used": {
+                    "type": "integer"
+                },
+                "data_bytes": {
+                    "type": "integer"
+                },
+                "health": {
+                    "type": "string"
+                },
+                "num_in_osds": {
+                    "type": "integer"
+                },
+                "num_osds": {
+                    "type": "integer"
+                },
+                "num_up_osds": {
+                    "type": "integer"
+                },
+                "read_bytes_sec": {
+                    "type": "integer"
+                },
+                "read_op_per_sec": {
+                    "type": "integer"
+                },
+                "write_bytes_sec": {
+                    "type": "integer"
+                },
+                "write_op_per_sec": {
+                    "type": "integer"
+                }
+            }
+        },
+        "ConfigHelp": {
+            "description": "ceph config help 설정 설명 구조체",
+            "type": "object",
+            "properties": {
+                "can_update_at_runtime": {
+                    "type": "boolean"
+                },
+                "default": {},
+                "desc": {
+                    "type": "string"
+                },
+                "enum_values": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "level": {
+                    "type": "string"
+                },
+                "long_desc": {
+                    "type": "string"
+                },
+                "max": {},
+                "min": {},
+                "name": {
+                    "type": "string"
+                },
+                "services": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "ConfigOption": {
+            "description": "ceph config dump 설정 항목 구조체",
+            "type": "object",
+            "properties": {
+                "can_update_at_runtime": {
+                    "type": "boolean"
+                },
+                "default": {
+                    "description": "diff 조회 시 ceph config help 에서 채웁니다.",
+                    "type": "string"
+                },
+                "level": {
+                    "type": "string"
+                },
+                "mask": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "section": {
+                    "type": "string"
+                },
+                "value": {
+                    "type": "string"
+                }
+            }
+        },
+        "ConfigValue": {
+            "description": "Glue 설정 값 구조체",
+            "type": "object",
+            "properties": {
+                "help": {
+                    "$ref": "#/definitions/ConfigHelp"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "value": {
+                    "type": "string"
+                },
+                "who": {
+                    "type": "string"
+                }
+            }
+        },
+        "Crash": {
+            "description": "ceph crash 데몬 장애 보고서 구조체",
+            "type": "object",
+            "properties": {
+                "archived": {
+                    "type": "string"
+                },
+                "assert_condition": {
+                    "type": "string"
+                },
+                "assert_file": {
+                    "type": "string"
+                },
+                "assert_func": {
+                    "type": "string"
+                },
+                "assert_line": {
+                    "type": "integer"
+                },
+                "assert_msg": {
+                    "type": "string"
+                },
+                "backtrace": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "ceph_version": {
+                    "type": "string"
+                },
+                "crash_id": {
+                    "type": "string"
+                },
+                "entity_name": {
+                    "type": "string"
+                },
+                "os_name": {
+                    "type": "string"
+                },
+                "os_version": {
+                    "type": "string"
+                },
+                "process_name": {
+                    "type": "string"
+                },
+                "timestamp": {
+                    "type": "string"
+                },
+                "utsname_hostname": {
+                    "type": "string"
+                }
+            }
+        },
+        "CrushNode": {
+            "description": "Glue CRUSH 트리 노드 구조체",
+            "type": "object",
+            "properties": {
+                "children": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/CrushNode"
+                    }
+                },
+                "crush_weight": {
+                    "type": "number"
+                },
+                "device_class": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "reweight": {
+                    "type": "number"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "CrushRule": {
+            "description": "Glue CRUSH 규칙 구조체",
+            "type": "object",
+            "properties": {
+                "device_class": {
+                    "type": "string"
+                },
+                "failure_domain": {
+                    "type": "string"
+                },
+                "pools": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "root": {
+                    "type": "string"
+                },
+                "rule_id": {
+                    "type": "integer"
+                },
+                "rule_name": {
+                    "type": "string"
+                },
+                "type": {
+                    "description": "replicated, erasure",
+                    "type": "string"
+                }
+            }
+        },
+        "CrushTree": {
+            "description": "Glue CRUSH 트리와 디바이스 클래스 구조체",
+            "type": "object",
+            "properties": {
+                "classes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "roots": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/CrushNode"
+                    }
+                },
+                "stray": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/CrushNode"
+                    }
+                }
+            }
+        },
+        "Daemon": {
+            "description": "ceph orch ps 데몬 구조체",
+            "type": "object",
+            "properties": {
+                "container_id": {
+                    "type": "string"
+                },
+                "container_image_id": {
+                    "type": "string"
+                },
+                "container_image_name": {
+                    "type": "string"
+                },
+                "cpu_percentage": {
+                    "type": "string"
+                },
+                "created": {
+                    "type": "string"
+                },
+                "daemon_id": {
+                    "type": "string"
+                },
+                "daemon_name": {
+                    "type": "string"
+                },
+                "daemon_type": {
+                    "type": "string"
+                },
+                "hostname": {
+                    "type": "string"
+                },
+                "is_active": {
+                    "type": "boolean"
+                },
+                "last_refresh": {
+                    "type": "string"
+                },
+                "memory_limit": {
+                    "type": "integer"
+                },
+                "memory_request": {
+                    "type": "integer"
+                },
+                "memory_usage": {
+                    "type": "integer"
+                },
+                "ports": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "service_name": {
+                    "type": "string"
+                },
+                "started": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "integer"
+                },
+                "status_desc": {
+                    "type": "string"
+                },
+                "version": {
+                    "type": "string"
+                }
+            }
+        },
+        "Device": {
+            "description": "ceph orch device ls 디스크 구조체",
+            "type": "object",
+            "properties": {
+                "available": {
+                    "type": "boolean"
+                },
+                "crush_device_class": {
+                    "type": "string"
+                },
+                "device_id": {
+                    "type": "string"
+                },
+                "health": {
+                    "type": "string"
+                },
+                "hostname": {
+                    "type": "string"
+                },
+                "model": {
+                    "type": "string"
+                },
+                "path": {
+                    "type": "string"
+                },
+                "rejected_reasons": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "rotational": {
+                    "type": "boolean"
+                },
+                "size": {
+                    "type": "integer"
+                },
+                "type": {
+                    "type": "string"
+                },
+                "vendor": {
+                    "type": "string"
+                }
+            }
+        },
+        "ErasureCodeProfile": {
+            "description": "Glue EC(Erasure Code) 프로파일 구조체",
+            "type": "object",
+            "properties": {
+                "crush_device_class": {
+                    "type": "string"
+                },
+                "crush_failure_domain": {
+                    "type": "string"
+                },
+                "crush_root": {
+                    "type": "string"
+                },
+                "k": {
+                    "type": "integer"
+                },
+                "m": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "plugin": {
+                    "description": "jerasure, isa, clay",
+                    "type": "string"
+                },
+                "pools": {
+                    "description": "이 프로파일을 사용하는 풀 목록입니다.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "technique": {
+                    "type": "string"
+                }
+            }
+        },
+        "ForecastEta": {
+            "type": "object",
+            "properties": {
+                "days_to_full": {
+                    "type": "number"
+                },
+                "days_to_nearfull": {
+                    "type": "number"
+                },
+                "full_at": {
+                    "type": "string"
+                },
+                "growth_per_day": {
+                    "type": "number"
+                },
+                "nearfull_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "ForecastItem": {
+            "type": "object",
+            "properties": {
+                "full_bytes": {
+                    "type": "number"
+                },
+                "linear": {
+                    "$ref": "#/definitions/ForecastEta"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "nearfull_bytes": {
+                    "type": "number"
+                },
+                "note": {
+                    "type": "string"
+                },
+                "overhead": {
+                    "description": "복제 풀은 size, EC 풀은 (k+m)/k",
+                    "type": "number"
+                },
+                "seasonal": {
+                    "$ref": "#/definitions/ForecastEta"
+                },
+                "size": {
+                    "type": "integer"
+                },
+                "used": {
+                    "type": "number"
+                }
+            }
+        },
+        "FsGetInfo": {
+            "description": "GlueFS의 상세정보 구조체",
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer"
+                },
+                "mdsmap": {
+                    "type": "object",
+                    "properties": {
+                        "bal_rank_mask": {
+                            "type": "string"
+                        },
+                        "balancer": {
+                            "type": "string"
+                        },
+                        "created": {
+                            "type": "string"
+                        },
+                        "damaged": {
+                            "type": "array",
+                            "items": {}
+                        },
+                        "data_pools": {
+                            "type": "array",
+                            "items": {
+                                "type": "integer"
+                            }
+                        },
+                        "enabled": {
+                            "type": "boolean"
+                        },
+                        "epoch": {
+                            "type": "integer"
+                        },
+                        "ever_allowed_features": {
+                            "type": "integer"
+                        },
+                        "explicitly_allowed_features": {
+                            "type": "integer"
+                        },
+                        "failed": {
+                            "type": "array",
+                            "items": {}
+                        },
+                        "flags": {
+                            "type": "integer"
+                        },
+                        "flags_state": {
+                            "type": "object",
+                            "properties": {
+                                "allow_multimds_snaps": {
+                                    "type": "boolean"
+                                },
+                                "allow_snaps": {
+                                    "type": "boolean"
+                                },
+                                "allow_standby_replay": {
+                                    "type": "boolean"
+                                },
+                                "joinable": {
+                                    "type": "boolean"
+                                },
+                                "refuse_client_session": {
+                                    "type": "boolean"
+                                }
+                            }
+                        },
+                        "fs_name": {
+                            "type": "string"
+                        },
+                        "in": {
+                            "type": "array",
+                            "items": {
+                                "type": "integer"
+                            }
+                        },
+                        "info": {},
+                        "last_failure": {
+                            "type": "integer"
+                        },
+                        "last_failure_osd_epoch": {
+                            "type": "integer"
+                        },
+                        "max_file_size": {
+                            "type": "integer"
+                        },
+                        "max_mds": {
+                            "type": "integer"
+                        },
+                        "metadata_pool": {
+                            "type": "integer"
+                        },
+                        "modified": {
+                            "type": "string"
+                        },
+                        "required_client_features": {
+                            "type": "object"
+                        },
+                        "root": {
+                            "type": "integer"
+                        },
+                        "session_autoclose": {
+                            "type": "integer"
+                        },
+                        "session_timeout": {
+                            "type": "integer"
+                        },
+                        "standby_count_wanted": {
+                            "type": "integer"
+                        },
+                        "stopped": {
+                            "type": "array",
+                            "items": {}
+                        },
+                        "tableserver": {
+                            "type": "integer"
+                        },
+                        "up": {
+                            "type": "object",
+                            "properties": {
+                                "mds_0": {
+                                    "type": "integer"
+                                }
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "FsStatus": {
+            "description": "GlueFS의 상태 구조체",
+            "type": "object",
+            "properties": {
+                "clients": {
+                    "type": "array",
+                    "items": {
+                        "type": "object",
+                        "properties": {
+                            "clients": {
+                                "type": "integer"
+                            },
+                            "fs": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                },
+                "mds_version": {
+                    "type": "array",
+                    "items": {
+                        "type": "object",
+                        "properties": {
+                            "daemon": {
+                                "type": "array",
+                                "items": {
+                                    "type": "string"
+                                }
+                            },
+                            "version": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                },
+                "mdsmap": {
+                    "type": "array",
+                    "items": {
+                        "type": "object",
+                        "properties": {
+                            "name": {
+                                "type": "string"
+                            },
+                            "state": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                },
+                "pools": {
+                    "type": "array",
+                    "items": {
+                        "type": "object",
+                        "properties": {
+                            "avail": {
+                                "type": "integer"
+                            },
+                            "id": {
+                                "type": "integer"
+                            },
+                            "name": {
+                                "type": "string"
+                            },
+                            "type": {
+                                "type": "string"
+                            },
+                            "used": {
+                                "type": "integer"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "GlueStatus": {
+            "description": "Glue의 상태를 나타내는 구조체",
+            "type": "object",
+            "properties": {
+                "election_epoch": {
+                    "type": "integer",
+                    "format": "uint32",
+                    "example": 148
+                },
+                "fsid": {
+                    "description": "Glue클러스터를 구분하는 ID",
+                    "type": "string",
+                    "format": "uuid",
+                    "example": "9980ffe8-4bc1-11ee-9b1f-002481004170"
+                },
+                "fsmap": {
+                    "type": "object",
+                    "properties": {
+                        "by_rank": {
+                            "type": "array",
+                            "items": {}
+                        },
+                        "epoch": {
+                            "type": "integer"
+                        },
+                        "up:standby": {
+                            "type": "integer"
+                        }
+                    }
+                },
+                "health": {
+                    "type": "object",
+                    "properties": {
+                        "checks": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "$ref": "#/definitions/HealthCheck"
+                            }
+                        },
+                        "mutes": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/HealthMute"
+                            }
+                        },
+                        "status": {
+                            "type": "string",
+                            "format": "string",
+                            "example": "HEALTH_WARN"
+                        }
+                    }
+                },
+                "mgrmap": {
+                    "type": "object",
+                    "properties": {
+                        "available": {
+                            "type": "boolean"
+                        },
+                        "modules": {
+                            "type": "array",
+                            "items": {
+                                "type": "string"
+                            }
+                        },
+                        "num_standbys": {
+                            "type": "integer"
+                        },
+                        "services": {
+                            "type": "object",
+                            "properties": {
+                                "dashboard": {
+                                    "type": "string"
+                                },
+                                "prometheus": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    }
+                },
+                "monmap": {
+                    "type": "object",
+                    "properties": {
+                        "epoch": {
+                            "type": "integer"
+                        },
+                        "min_mon_release_name": {
+                            "type": "string"
+                        },
+                        "num_mons": {
+                            "type": "integer"
+                        }
+                    }
+                },
+                "osdmap": {
+                    "type": "object",
+                    "properties": {
+                        "epoch": {
+                            "type": "integer"
+                        },
+                        "num_in_osds": {
+                            "type": "integer"
+                        },
+                        "num_osds": {
+                            "type": "integer"
+                        },
+                        "num_remapped_pgs": {
+                            "type": "integer"
+                        },
+                        "num_up_osds": {
+                            "type": "integer"
+                        },
+                        "osd_in_since": {
+                            "type": "integer"
+                        },
+                        "osd_up_since": {
+                            "type": "integer"
+                        }
+                    }
+                },
+                "pgmap": {
+                    "type": "object",
+                    "properties": {
+                        "bytes_avail": {
+                            "type": "integer"
+                        },
+                        "bytes_total": {
+                            "type": "integer"
+                        },
+                        "bytes_used": {
+                            "type": "integer"
+                        },
+                        "data_bytes": {
+                            "type": "integer"
+                        },
+                        "num_objects": {
+                            "type": "integer"
+                        },
+                        "num_pgs": {
+                            "type": "integer"
+                        },
+                        "num_pools": {
+                            "type": "integer"
+                        },
+                        "pgs_by_state": {
+                            "type": "array",
+                            "items": {
+                                "type": "object",
+                                "properties": {
+                                    "count": {
+                                        "type": "integer"
+                                    },
+                                    "state_name": {
+                                        "type": "string"
+                                    }
+                                }
+                            }
+                        },
+                        "read_bytes_sec": {
+                            "type": "integer"
+                        },
+                        "read_op_per_sec": {
+                            "type": "integer"
+                        },
+                        "write_bytes_sec": {
+                            "type": "integer"
+                        },
+                        "write_op_per_sec": {
+                            "type": "integer"
+                        }
+                    }
+                },
+                "progress_events": {
+                    "type": "object"
+                },
+                "quorum": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "quorum_age": {
+                    "type": "integer"
+                },
+                "quorum_names": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "servicemap": {
+                    "type": "object",
+                    "properties": {
+                        "epoch": {
+                            "type": "integer"
+                        },
+                        "modified": {
+                            "type": "string"
+                        },
+                        "services": {}
+                    }
+                }
+            }
+        },
+        "GlueVersion": {
+            "description": "Glue의 버전",
+            "type": "object",
+            "properties": {
+                "mds": {},
+                "mgr": {},
+                "mon": {},
+                "osd": {},
+                "overall": {},
+                "rbd-mirror": {},
+                "rgw": {}
+            }
+        },
+        "HTTP400BadRequest": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 400
+                },
+                "message": {
+                    "type": "string",
+                    "example": "status bad request"
+                }
+            }
+        },
+        "HTTP404NotFound": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 404
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Not Found"
+                }
+            }
+        },
+        "HTTP500InternalServerError": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 500
+                },
+                "message": {
+                    "type": "string",
+                    "example": "InternalServerError"
+                }
+            }
+        },
+        "HealthCheck": {
+            "description": "Glue 상태 점검 항목 구조체",
+            "type": "object",
+            "properties": {
+                "detail": {
+                    "type": "array",
+                    "items": {
+                        "type": "object",
+                        "properties": {
+                            "message": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                },
+                "muted": {
+                    "type": "boolean"
+                },
+                "severity": {
+                    "type": "string",
+                    "format": "string",
+                    "example": "HEALTH_WARN"
+                },
+                "summary": {
+                    "type": "object",
+                    "properties": {
+                        "count": {
+                            "type": "integer"
+                        },
+                        "message": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "HealthMute": {
+            "description": "Glue 상태 점검 항목 무시 구조체",
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string",
+                    "format": "string",
+                    "example": "OSD_DOWN"
+                },
+                "count": {
+                    "type": "integer"
+                },
+                "sticky": {
+                    "type": "boolean"
+                },
+                "summary": {
+                    "type": "string"
+                }
+            }
+        },
+        "HostMaintenanceReport": {
+            "description": "호스트 유지보수 사전 점검 결과 구조체",
+            "type": "object",
+            "properties": {
+                "blockers": {
+                    "description": "force 없이 유지보수 모드로 전환할 수 없는 이유입니다.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "gwvm": {
+                    "type": "boolean"
+                },
+                "hostname": {
+                    "type": "string"
+                },
+                "ok_to_stop": {
+                    "type": "boolean"
+                },
+                "ok_to_stop_message": {
+                    "type": "string"
+                },
+                "services": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/HostServiceImpact"
+                    }
+                }
+            }
+        },
+        "HostServiceImpact": {
+            "description": "호스트 유지보수 시 영향을 받는 서비스 구조체",
+            "type": "object",
+            "properties": {
+                "daemons": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "impact": {
+                    "description": "degraded, unavailable",
+                    "type": "string"
+                },
+                "other_running_daemons": {
+                    "description": "다른 호스트에서 실행 중인 같은 서비스의 데몬 수입니다.",
+                    "type": "integer"
+                },
+                "service_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "ImageChild": {
+            "type": "object",
+            "properties": {
+                "children": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ImageChild"
+                    }
+                },
+                "image": {
+                    "type": "string"
+                },
+                "pool": {
+                    "type": "string"
+                },
+                "snapshot": {
+                    "type": "string"
+                }
+            }
+        },
+        "ImageInfo": {
+            "type": "object",
+            "properties": {
+                "features": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "parent": {
+                    "type": "object",
+                    "properties": {
+                        "image": {
+                            "type": "string"
+                        },
+                        "pool": {
+                            "type": "string"
+                        },
+                        "snapshot": {
+                            "type": "string"
+                        }
+                    }
+                },
+                "size": {
+                    "type": "integer"
+                },
+                "snapshot_count": {
+                    "type": "integer"
+                }
+            }
+        },
+        "ImageLineage": {
+            "description": "이미지의 부모 체인과 자식 클론 트리 구조체",
+            "type": "object",
+            "properties": {
+                "children": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ImageChild"
+                    }
+                },
+                "image": {
+                    "type": "string"
+                },
+                "parents": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ImageRef"
+                    }
+                },
+                "pool": {
+                    "type": "string"
+                }
+            }
+        },
+        "ImageMirror": {
+            "type": "object",
+            "properties": {
+                "image": {
                     "type": "string"
                 },
-                "mutual_user": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/MirrorImageItem"
+                    }
+                },
+                "namespace": {
                     "type": "string"
                 },
-                "password": {
+                "pool": {
+                    "type": "string"
+                }
+            }
+        },
+        "ImageQos": {
+            "description": "풀 또는 이미지에 실제로 적용되는 rbd QoS 설정 구조체",
+            "type": "object",
+            "properties": {
+                "configs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ImageQosConfig"
+                    }
+                },
+                "image": {
                     "type": "string"
                 },
-                "user": {
+                "pool": {
                     "type": "string"
                 }
             }
         },
-        "FsGetInfo": {
-            "description": "GlueFS의 상세정보 구조체",
+        "ImageQosConfig": {
+            "description": "rbd QoS 설정 구조체. source 는 값이 적용된 단계(config: 전역/기본값, pool: 풀, image: 이미지)입니다.",
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "source": {
+                    "type": "string"
+                },
+                "value": {
+                    "type": "string"
+                }
+            }
+        },
+        "ImageRef": {
+            "type": "object",
+            "properties": {
+                "image": {
+                    "type": "string"
+                },
+                "pool": {
+                    "type": "string"
+                },
+                "snapshot": {
+                    "type": "string"
+                }
+            }
+        },
+        "ImageSnapshot": {
+            "description": "rbd snap ls 이미지 스냅샷 구조체",
             "type": "object",
             "properties": {
                 "id": {
                     "type": "integer"
                 },
-                "mdsmap": {
+                "name": {
+                    "type": "string"
+                },
+                "protected": {
+                    "type": "boolean"
+                },
+                "size": {
+                    "type": "integer"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "ImageStatus": {
+            "type": "object",
+            "properties": {
+                "daemon_service": {
                     "type": "object",
                     "properties": {
-                        "bal_rank_mask": {
-                            "type": "string"
-                        },
-                        "balancer": {
+                        "daemon_id": {
                             "type": "string"
                         },
-                        "created": {
+                        "hostname": {
                             "type": "string"
                         },
-                        "damaged": {
-                            "type": "array",
-                            "items": {}
-                        },
-                        "data_pools": {
-                            "type": "array",
-                            "items": {
-                                "type": "integer"
-                            }
-                        },
-                        "enabled": {
-                            "type": "boolean"
-                        },
-                        "epoch": {
-                            "type": "integer"
-                        },
-                        "ever_allowed_features": {
-                            "type": "integer"
-                        },
-                        "explicitly_allowed_features": {
-                            "type": "integer"
-                        },
-                        "failed": {
-                            "type": "array",
-                            "items": {}
-                        },
-                        "flags": {
-                            "type": "integer"
-                        },
-                        "flags_state": {
-                            "type": "object",
-                            "properties": {
-                                "allow_multimds_snaps": {
-                                    "type": "boolean"
-                                },
-                                "allow_snaps": {
-                                    "type": "boolean"
-                                },
-                                "allow_standby_replay": {
-                                    "type": "boolean"
-                                },
-                                "joinable": {
-                                    "type": "boolean"
-                                },
-                                "refuse_client_session": {
-                                    "type": "boolean"
-                                }
-                            }
-                        },
-                        "fs_name": {
+                        "instance_id": {
                             "type": "string"
                         },
-                        "in": {
-                            "type": "array",
-                            "items": {
-                                "type": "integer"
-                            }
-                        },
-                        "info": {},
-                        "last_failure": {
-                            "type": "integer"
-                        },
-                        "last_failure_osd_epoch": {
-                            "type": "integer"
-                        },
-                        "max_file_size": {
-                            "type": "integer"
-                        },
-                        "max_mds": {
-                            "type": "integer"
-                        },
-                        "metadata_pool": {
-                            "type": "integer"
-                        },
-                        "modified": {
+                        "service_id": {
                             "type": "string"
-                        },
-                        "required_client_features": {
-                            "type": "object"
-                        },
-                        "root": {
-                            "type": "integer"
-                        },
-                        "session_autoclose": {
-                            "type": "integer"
-                        },
-                        "session_timeout": {
-                            "type": "integer"
-                        },
-                        "standby_count_wanted": {
-                            "type": "integer"
-                        },
-                        "stopped": {
-                            "type": "array",
-                            "items": {}
-                        },
-                        "tableserver": {
-                            "type": "integer"
-                        },
-                        "up": {
-                            "type": "object",
-                            "properties": {
-                                "mds_0": {
-                                    "type": "integer"
-                                }
-                            }
                         }
                     }
-                }
-            }
-        },
-        "FsStatus": {
-            "description": "GlueFS의 상태 구조체",
-            "type": "object",
-            "properties": {
-                "clients": {
+                },
+                "description": {
+                    "type": "string"
+                },
+                "global_id": {
+                    "type": "string"
+                },
+                "last_update": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "peer_sites": {
                     "type": "array",
                     "items": {
                         "type": "object",
                         "properties": {
-                            "clients": {
-                                "type": "integer"
+                            "description": {
+                                "type": "string"
                             },
-                            "fs": {
+                            "last_update": {
                                 "type": "string"
-                            }
-                        }
-                    }
-                },
-                "mds_version": {
-                    "type": "array",
-                    "items": {
-                        "type": "object",
-                        "properties": {
-                            "daemon": {
-                                "type": "array",
-                                "items": {
-                                    "type": "string"
-                                }
                             },
-                            "version": {
+                            "mirror_uuids": {
                                 "type": "string"
-                            }
-                        }
-                    }
-                },
-                "mdsmap": {
-                    "type": "array",
-                    "items": {
-                        "type": "object",
-                        "properties": {
-                            "name": {
+                            },
+                            "site_name": {
                                 "type": "string"
                             },
                             "state": {
@@ -6758,317 +15448,255 @@ const docTemplate = `{
                         }
                     }
                 },
-                "pools": {
+                "snapshots": {
                     "type": "array",
                     "items": {
                         "type": "object",
                         "properties": {
-                            "avail": {
-                                "type": "integer"
+                            "demoted": {
+                                "type": "boolean"
                             },
                             "id": {
                                 "type": "integer"
                             },
-                            "name": {
-                                "type": "string"
+                            "mirror_peer_uuids": {
+                                "type": "array",
+                                "items": {
+                                    "type": "string"
+                                }
                             },
-                            "type": {
+                            "name": {
                                 "type": "string"
-                            },
-                            "used": {
-                                "type": "integer"
                             }
                         }
                     }
+                },
+                "state": {
+                    "type": "string"
                 }
             }
         },
-        "GlueStatus": {
-            "description": "Glue의 상태를 나타내는 구조체",
+        "ImageTask": {
+            "description": "ceph rbd task 백그라운드 작업 구조체",
             "type": "object",
             "properties": {
-                "election_epoch": {
-                    "type": "integer",
-                    "format": "uint32",
-                    "example": 148
-                },
-                "fsid": {
-                    "description": "Glue클러스터를 구분하는 ID",
-                    "type": "string",
-                    "format": "uuid",
-                    "example": "9980ffe8-4bc1-11ee-9b1f-002481004170"
+                "id": {
+                    "type": "string"
                 },
-                "fsmap": {
-                    "type": "object",
-                    "properties": {
-                        "by_rank": {
-                            "type": "array",
-                            "items": {}
-                        },
-                        "epoch": {
-                            "type": "integer"
-                        },
-                        "up:standby": {
-                            "type": "integer"
-                        }
-                    }
+                "in_progress": {
+                    "type": "boolean"
                 },
-                "health": {
-                    "type": "object",
-                    "properties": {
-                        "checks": {},
-                        "mutes": {},
-                        "status": {
-                            "type": "string",
-                            "format": "string",
-                            "example": "HEALTH_WARN"
-                        }
-                    }
+                "message": {
+                    "type": "string"
                 },
-                "mgrmap": {
-                    "type": "object",
-                    "properties": {
-                        "available": {
-                            "type": "boolean"
-                        },
-                        "modules": {
-                            "type": "array",
-                            "items": {
-                                "type": "string"
-                            }
-                        },
-                        "num_standbys": {
-                            "type": "integer"
-                        },
-                        "services": {
-                            "type": "object",
-                            "properties": {
-                                "dashboard": {
-                                    "type": "string"
-                                },
-                                "prometheus": {
-                                    "type": "string"
-                                }
-                            }
-                        }
-                    }
+                "progress": {
+                    "type": "number"
                 },
-                "monmap": {
+                "refs": {
                     "type": "object",
                     "properties": {
-                        "epoch": {
-                            "type": "integer"
-                        },
-                        "min_mon_release_name": {
+                        "action": {
                             "type": "string"
                         },
-                        "num_mons": {
-                            "type": "integer"
-                        }
-                    }
-                },
-                "osdmap": {
-                    "type": "object",
-                    "properties": {
-                        "epoch": {
-                            "type": "integer"
-                        },
-                        "num_in_osds": {
-                            "type": "integer"
-                        },
-                        "num_osds": {
-                            "type": "integer"
-                        },
-                        "num_remapped_pgs": {
-                            "type": "integer"
+                        "image_id": {
+                            "type": "string"
                         },
-                        "num_up_osds": {
-                            "type": "integer"
+                        "image_name": {
+                            "type": "string"
                         },
-                        "osd_in_since": {
-                            "type": "integer"
+                        "pool_name": {
+                            "type": "string"
                         },
-                        "osd_up_since": {
-                            "type": "integer"
+                        "pool_namespace": {
+                            "type": "string"
                         }
                     }
                 },
-                "pgmap": {
-                    "type": "object",
-                    "properties": {
-                        "bytes_avail": {
-                            "type": "integer"
-                        },
-                        "bytes_total": {
-                            "type": "integer"
-                        },
-                        "bytes_used": {
-                            "type": "integer"
-                        },
-                        "data_bytes": {
-                            "type": "integer"
-                        },
-                        "num_objects": {
-                            "type": "integer"
-                        },
-                        "num_pgs": {
-                            "type": "integer"
-                        },
-                        "num_pools": {
-                            "type": "integer"
-                        },
-                        "pgs_by_state": {
-                            "type": "array",
-                            "items": {
-                                "type": "object",
-                                "properties": {
-                                    "count": {
-                                        "type": "integer"
-                                    },
-                                    "state_name": {
-                                        "type": "string"
-                                    }
-                                }
-                            }
-                        },
-                        "read_bytes_sec": {
-                            "type": "integer"
-                        },
-                        "read_op_per_sec": {
-                            "type": "integer"
-                        },
-                        "write_bytes_sec": {
-                            "type": "integer"
-                        },
-                        "write_op_per_sec": {
-                            "type": "integer"
-                        }
-                    }
+                "retry_attempts": {
+                    "type": "integer"
                 },
-                "progress_events": {
-                    "type": "object"
+                "retry_message": {
+                    "type": "string"
                 },
-                "quorum": {
-                    "type": "array",
-                    "items": {
-                        "type": "integer"
-                    }
+                "retry_time": {
+                    "type": "string"
                 },
-                "quorum_age": {
+                "sequence": {
                     "type": "integer"
+                }
+            }
+        },
+        "ImageTrash": {
+            "description": "rbd trash ls 휴지통 이미지 구조체",
+            "type": "object",
+            "properties": {
+                "deleted_at": {
+                    "type": "string"
                 },
-                "quorum_names": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "id": {
+                    "type": "string"
                 },
-                "servicemap": {
-                    "type": "object",
-                    "properties": {
-                        "epoch": {
-                            "type": "integer"
-                        },
-                        "modified": {
-                            "type": "string"
-                        },
-                        "services": {}
-                    }
+                "name": {
+                    "type": "string"
+                },
+                "pool": {
+                    "type": "string"
+                },
+                "source": {
+                    "type": "string"
+                },
+                "status": {
+                    "description": "expired at ..., protected until ...",
+                    "type": "string"
                 }
             }
         },
-        "GlueVersion": {
-            "description": "Glue의 버전",
+        "ImageTrashDeferment": {
             "type": "object",
             "properties": {
-                "mds": {},
-                "mgr": {},
-                "mon": {},
-                "osd": {},
-                "overall": {},
-                "rbd-mirror": {},
-                "rgw": {}
+                "pool": {
+                    "type": "string"
+                },
+                "seconds": {
+                    "type": "integer"
+                }
             }
         },
-        "HTTP400BadRequest": {
+        "Message": {
             "type": "object",
             "properties": {
-                "code": {
-                    "type": "integer",
-                    "example": 400
-                },
                 "message": {
                     "type": "string",
-                    "example": "status bad request"
+                    "example": "message"
                 }
             }
         },
-        "HTTP404NotFound": {
+        "MetricPoint": {
             "type": "object",
             "properties": {
-                "code": {
-                    "type": "integer",
-                    "example": 404
+                "timestamp": {
+                    "type": "integer"
                 },
-                "message": {
-                    "type": "string",
-                    "example": "Not Found"
+                "value": {
+                    "type": "number"
                 }
             }
         },
-        "HTTP500InternalServerError": {
+        "MetricSample": {
+            "description": "Glue 클러스터 상태 수집 샘플 구조체",
             "type": "object",
             "properties": {
-                "code": {
-                    "type": "integer",
-                    "example": 500
+                "cluster": {
+                    "$ref": "#/definitions/ClusterMetric"
                 },
-                "message": {
-                    "type": "string",
-                    "example": "InternalServerError"
+                "pools": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/PoolMetric"
+                    }
+                },
+                "timestamp": {
+                    "type": "integer"
                 }
             }
         },
-        "ImageMirror": {
+        "MetricSeries": {
+            "description": "Glue 상태 지표의 시계열 구조체",
             "type": "object",
             "properties": {
-                "image": {
+                "end": {
+                    "type": "integer"
+                },
+                "metric": {
                     "type": "string"
                 },
-                "items": {
+                "points": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/MirrorImageItem"
+                        "$ref": "#/definitions/MetricPoint"
                     }
                 },
-                "namespace": {
+                "pool": {
+                    "type": "string"
+                },
+                "start": {
+                    "type": "integer"
+                },
+                "step": {
+                    "type": "integer"
+                }
+            }
+        },
+        "MirrorImageItem": {
+            "type": "object",
+            "properties": {
+                "interval": {
                     "type": "string"
                 },
-                "pool": {
+                "start_time": {
                     "type": "string"
                 }
             }
         },
-        "ImageStatus": {
+        "MirrorList": {
             "type": "object",
             "properties": {
-                "daemon_service": {
+                "daemons": {
+                    "type": "array",
+                    "items": {
+                        "type": "object",
+                        "properties": {
+                            "ceph_version": {
+                                "type": "string"
+                            },
+                            "client_id": {
+                                "type": "string"
+                            },
+                            "health": {
+                                "type": "string"
+                            },
+                            "hostname": {
+                                "type": "string"
+                            },
+                            "instance_id": {
+                                "type": "string"
+                            },
+                            "leader": {
+                                "type": "boolean"
+                            },
+                            "service_id": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                },
+                "images": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/MirrorListImages"
+                    }
+                },
+                "summary": {
                     "type": "object",
                     "properties": {
-                        "daemon_id": {
+                        "daemon_health": {
                             "type": "string"
                         },
-                        "hostname": {
+                        "health": {
                             "type": "string"
                         },
-                        "instance_id": {
+                        "image_health": {
                             "type": "string"
                         },
-                        "service_id": {
-                            "type": "string"
-                        }
+                        "states": {}
                     }
-                },
+                }
+            }
+        },
+        "MirrorListImages": {
+            "type": "object",
+            "properties": {
+                "daemon_service": {},
                 "description": {
                     "type": "string"
                 },
@@ -7104,352 +15732,600 @@ const docTemplate = `{
                         }
                     }
                 },
-                "snapshots": {
+                "state": {
+                    "type": "string"
+                }
+            }
+        },
+        "MirrorSetup": {
+            "type": "object",
+            "properties": {
+                "host": {
+                    "type": "string"
+                },
+                "localClusterName": {
+                    "description": "미러링 상태",
+                    "type": "string"
+                },
+                "localToken": {
+                    "type": "string"
+                },
+                "mirrorPool": {
+                    "type": "string"
+                },
+                "privateKeyFile": {},
+                "remoteClusterName": {
+                    "description": "미러링 상태",
+                    "type": "string"
+                },
+                "remoteToken": {
+                    "type": "string"
+                }
+            }
+        },
+        "MirrorStatus": {
+            "type": "object",
+            "properties": {
+                "daemon_health": {
+                    "description": "미러링 데몬 상태",
+                    "type": "string"
+                },
+                "health": {
+                    "description": "미러링 상태",
+                    "type": "string"
+                },
+                "image_health": {
+                    "description": "이미지 상태",
+                    "type": "string"
+                },
+                "states": {
+                    "description": "이미지 상세"
+                }
+            }
+        },
+        "NvmeOfImage": {
+            "description": "NVMe-OF CLI 및 게이트웨이 이미지 구조체",
+            "type": "object",
+            "properties": {
+                "cli_image": {
+                    "type": "string"
+                },
+                "gateway_image": {
+                    "type": "string"
+                }
+            }
+        },
+        "NvmeOfNameSpaceList": {
+            "type": "object",
+            "properties": {
+                "error_message": {
+                    "type": "string"
+                },
+                "namespaces": {
                     "type": "array",
                     "items": {
                         "type": "object",
                         "properties": {
-                            "demoted": {
+                            "bdev_name": {
+                                "type": "string"
+                            },
+                            "block_size": {
+                                "type": "integer"
+                            },
+                            "load_balancing_group": {
+                                "type": "integer"
+                            },
+                            "nsid": {
+                                "type": "integer"
+                            },
+                            "r_mbytes_per_second": {
+                                "type": "string"
+                            },
+                            "rbd_image_name": {
+                                "type": "string"
+                            },
+                            "rbd_image_size": {
+                                "type": "string"
+                            },
+                            "rbd_pool_name": {
+                                "type": "string"
+                            },
+                            "rw_ios_per_second": {
+                                "type": "string"
+                            },
+                            "rw_mbytes_per_second": {
+                                "type": "string"
+                            },
+                            "uuid": {
+                                "type": "string"
+                            },
+                            "w_mbytes_per_second": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                },
+                "status": {
+                    "type": "integer"
+                },
+                "subsystem_nqn": {
+                    "type": "string"
+                }
+            }
+        },
+        "NvmeOfSubSystemList": {
+            "type": "object",
+            "properties": {
+                "error_message": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "integer"
+                },
+                "subsystems": {
+                    "type": "array",
+                    "items": {
+                        "type": "object",
+                        "properties": {
+                            "enable_ha": {
                                 "type": "boolean"
                             },
-                            "id": {
+                            "max_cntlid": {
                                 "type": "integer"
                             },
-                            "mirror_peer_uuids": {
-                                "type": "array",
-                                "items": {
-                                    "type": "string"
-                                }
+                            "min_cntlid": {
+                                "type": "integer"
                             },
-                            "name": {
+                            "model_number": {
+                                "type": "string"
+                            },
+                            "namespace_count": {
+                                "type": "integer"
+                            },
+                            "nqn": {
+                                "type": "string"
+                            },
+                            "serial_number": {
+                                "type": "string"
+                            },
+                            "subtype": {
                                 "type": "string"
                             }
                         }
                     }
+                }
+            }
+        },
+        "OrchHost": {
+            "description": "Glue 오케스트레이터 호스트 구조체",
+            "type": "object",
+            "properties": {
+                "addr": {
+                    "type": "string"
+                },
+                "hostname": {
+                    "type": "string"
+                },
+                "labels": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "status": {
+                    "description": "빈 값은 정상, maintenance 는 유지보수 모드, offline 은 연결 끊김입니다.",
+                    "type": "string"
+                }
+            }
+        },
+        "Osd": {
+            "description": "Glue OSD 구조체",
+            "type": "object",
+            "properties": {
+                "avail_bytes": {
+                    "type": "integer"
+                },
+                "crush_weight": {
+                    "type": "number"
+                },
+                "device_class": {
+                    "type": "string"
+                },
+                "devices": {
+                    "type": "string"
+                },
+                "host": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "in": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "pgs": {
+                    "type": "integer"
+                },
+                "reweight": {
+                    "type": "number"
+                },
+                "status": {
+                    "description": "up, down, destroyed",
+                    "type": "string"
+                },
+                "total_bytes": {
+                    "type": "integer"
+                },
+                "used_bytes": {
+                    "type": "integer"
+                },
+                "utilization": {
+                    "type": "number"
                 },
-                "state": {
+                "version": {
                     "type": "string"
                 }
             }
         },
-        "Message": {
+        "OsdFlag": {
+            "description": "Glue 클러스터 OSD 플래그 구조체",
             "type": "object",
             "properties": {
-                "message": {
-                    "type": "string",
-                    "example": "message"
+                "enabled": {
+                    "type": "boolean"
+                },
+                "flag": {
+                    "type": "string"
                 }
             }
         },
-        "ImageInfo": {
+        "OsdRmStatus": {
+            "description": "Glue OSD 제거 진행 상태 구조체",
             "type": "object",
             "properties": {
-                "name": {
+                "drain_done_at": {
                     "type": "string"
                 },
-                "id": {
+                "drain_started_at": {
                     "type": "string"
                 },
-                "size": {
+                "draining": {
+                    "type": "boolean"
+                },
+                "force": {
+                    "type": "boolean"
+                },
+                "hostname": {
+                    "type": "string"
+                },
+                "osd_id": {
                     "type": "integer"
                 },
-                "snapshot_count": {
+                "pgs": {
+                    "description": "비워야 할 남은 PG 수입니다. ceph osd df 에서 채웁니다.",
                     "type": "integer"
                 },
-                "parent": {
-                    "type": "array",
-                    "items": {
-                        "type": "object",
-                        "properties": {
-                            "pool": {
-                                "type": "string"
-                            },
-                            "image": {
-                                "type": "string"
-                            },
-                            "snapshot": {
-                                "type": "string"
-                            }
-                        }
-                    }
+                "process_started_at": {
+                    "type": "string"
+                },
+                "replace": {
+                    "type": "boolean"
+                },
+                "started": {
+                    "type": "boolean"
+                },
+                "stopped": {
+                    "type": "boolean"
+                },
+                "zap": {
+                    "type": "boolean"
                 }
             }
         },
-        "MirrorImage": {
+        "OsdSafety": {
+            "description": "Glue OSD 중지 및 제거 안전성 구조체",
             "type": "object",
             "properties": {
-                "image": {
+                "ok_to_stop": {
+                    "type": "boolean"
+                },
+                "ok_to_stop_message": {
                     "type": "string"
                 },
-                "items": {
+                "osd_id": {
+                    "type": "integer"
+                },
+                "safe_to_destroy": {
+                    "type": "boolean"
+                },
+                "safe_to_destroy_message": {
+                    "type": "string"
+                }
+            }
+        },
+        "Pg": {
+            "description": "Glue 배치 그룹(PG) 구조체",
+            "type": "object",
+            "properties": {
+                "acting": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/MirrorImageItem"
+                        "type": "integer"
                     }
                 },
-                "namespace": {
+                "acting_primary": {
+                    "type": "integer"
+                },
+                "last_deep_scrub_stamp": {
+                    "type": "string"
+                },
+                "last_scrub_stamp": {
+                    "type": "string"
+                },
+                "objects": {
+                    "type": "integer"
+                },
+                "objects_degraded": {
+                    "type": "integer"
+                },
+                "objects_misplaced": {
+                    "type": "integer"
+                },
+                "objects_unfound": {
+                    "type": "integer"
+                },
+                "pgid": {
                     "type": "string"
                 },
                 "pool": {
                     "type": "string"
+                },
+                "state": {
+                    "type": "string"
+                },
+                "stuck": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "up": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "up_primary": {
+                    "type": "integer"
                 }
             }
         },
-        "MirrorImageItem": {
+        "PgInconsistent": {
+            "description": "rados list-inconsistent-obj 불일치 객체 구조체",
             "type": "object",
             "properties": {
-                "interval": {
-                    "type": "string"
+                "epoch": {
+                    "type": "integer"
                 },
-                "start_time": {
-                    "type": "string"
+                "inconsistents": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/PgInconsistentObject"
+                    }
                 }
             }
         },
-        "MirrorList": {
+        "PgInconsistentObject": {
             "type": "object",
             "properties": {
-                "Summary": {
-                    "type": "object",
-                    "properties": {
-                        "health": {
-                            "type": "string"
-                        },
-                        "daemon_health": {
-                            "type": "string"
-                        },
-                        "image_health": {
-                            "type": "string"
-                        },
-                        "states": {
-                            "type": "string"
-                        }
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
                     }
                 },
-                "Daemons": {
+                "object": {
                     "type": "object",
                     "properties": {
-                        "service_id": {
-                            "type": "string"
-                        },
-                        "instance_id": {
-                            "type": "string"
-                        },
-                        "client_id": {
+                        "locator": {
                             "type": "string"
                         },
-                        "hostname": {
+                        "name": {
                             "type": "string"
                         },
-                        "ceph_version": {
+                        "nspace": {
                             "type": "string"
                         },
-                        "leader": {
-                            "type": "boolean"
-                        },
-                        "health": {
-                            "type": "string"
+                        "snap": {},
+                        "version": {
+                            "type": "integer"
                         }
                     }
                 },
-                "Images": {
+                "shards": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/MirrorListImages"
+                        "$ref": "#/definitions/PgShard"
                     }
-                }
-            }
-        },
-        "MirrorListImages": {
-            "type": "object",
-            "properties": {
-                "name": {
-                    "type": "string"
-                },
-                "global_id": {
-                    "type": "string"
-                },
-                "state": {
-                    "type": "string"
-                },
-                "description": {
-                    "type": "string"
                 },
-                "daemon_service": {
-                    "type": "string"
-                },
-                "last_update": {
-                    "type": "string"
-                },
-                "peer_sites": {
+                "union_shard_errors": {
                     "type": "array",
                     "items": {
-                        "type": "object",
-                        "properties": {
-                            "site_name": {
-                                "type": "string"
-                            },
-                            "mirror_uuids": {
-                                "type": "string"
-                            },
-                            "state": {
-                                "type": "string"
-                            },
-                            "description": {
-                                "type": "string"
-                            },
-                            "last_update": {
-                                "type": "string"
-                            }
-                        }
+                        "type": "string"
                     }
                 }
             }
         },
-        "MirrorSetup": {
+        "PgShard": {
             "type": "object",
             "properties": {
-                "host": {
-                    "type": "string"
-                },
-                "localClusterName": {
-                    "description": "미러링 상태",
-                    "type": "string"
-                },
-                "localToken": {
-                    "type": "string"
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 },
-                "mirrorPool": {
-                    "type": "string"
+                "osd": {
+                    "type": "integer"
                 },
-                "privateKeyFile": {},
-                "remoteClusterName": {
-                    "description": "미러링 상태",
-                    "type": "string"
+                "primary": {
+                    "type": "boolean"
                 },
-                "remoteToken": {
-                    "type": "string"
+                "size": {
+                    "type": "integer"
                 }
             }
         },
-        "MirrorStatus": {
+        "PoolDeleteConfirm": {
+            "description": "Glue 스토리지 풀 삭제 확인 구조체",
             "type": "object",
             "properties": {
-                "daemon_health": {
-                    "description": "미러링 데몬 상태",
-                    "type": "string"
-                },
-                "health": {
-                    "description": "미러링 상태",
+                "cluster": {
                     "type": "string"
                 },
-                "image_health": {
-                    "description": "이미지 상태",
+                "confirm_token": {
+                    "description": "두 번째 삭제 요청에 confirm_token 으로 전달해야 합니다.",
                     "type": "string"
                 },
-                "states": {
-                    "description": "이미지 상세"
-                }
-            }
-        },
-        "NvmeOfNameSpaceList": {
-            "type": "object",
-            "properties": {
-                "error_message": {
+                "expires_at": {
                     "type": "string"
                 },
-                "namespaces": {
-                    "type": "array",
-                    "items": {
-                        "type": "object",
-                        "properties": {
-                            "bdev_name": {
-                                "type": "string"
-                            },
-                            "block_size": {
-                                "type": "integer"
-                            },
-                            "load_balancing_group": {
-                                "type": "integer"
-                            },
-                            "nsid": {
-                                "type": "integer"
-                            },
-                            "r_mbytes_per_second": {
-                                "type": "string"
-                            },
-                            "rbd_image_name": {
-                                "type": "string"
-                            },
-                            "rbd_image_size": {
-                                "type": "string"
-                            },
-                            "rbd_pool_name": {
-                                "type": "string"
-                            },
-                            "rw_ios_per_second": {
-                                "type": "string"
-                            },
-                            "rw_mbytes_per_second": {
-                                "type": "string"
-                            },
-                            "uuid": {
-                                "type": "string"
-                            },
-                            "w_mbytes_per_second": {
-                                "type": "string"
-                            }
-                        }
+                "force": {
+                    "type": "boolean"
+                },
+                "fs_names": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
                     }
                 },
-                "status": {
+                "mirror_images": {
                     "type": "integer"
                 },
-                "subsystem_nqn": {
+                "pool_name": {
                     "type": "string"
+                },
+                "rbd_images": {
+                    "type": "integer"
+                },
+                "rgw_buckets": {
+                    "type": "integer"
+                },
+                "trash_images": {
+                    "type": "integer"
                 }
             }
         },
-        "NvmeOfSubSystemList": {
+        "PoolMetric": {
             "type": "object",
             "properties": {
-                "error_message": {
+                "bytes_used": {
+                    "type": "integer"
+                },
+                "max_avail": {
+                    "type": "integer"
+                },
+                "name": {
                     "type": "string"
                 },
-                "status": {
+                "objects": {
                     "type": "integer"
                 },
-                "subsystems": {
+                "percent_used": {
+                    "type": "number"
+                },
+                "stored": {
+                    "type": "integer"
+                }
+            }
+        },
+        "PoolStats": {
+            "description": "Glue 스토리지 풀 사용량 및 I/O 통계 구조체",
+            "type": "object",
+            "properties": {
+                "applications": {
                     "type": "array",
                     "items": {
-                        "type": "object",
-                        "properties": {
-                            "enable_ha": {
-                                "type": "boolean"
-                            },
-                            "max_cntlid": {
-                                "type": "integer"
-                            },
-                            "min_cntlid": {
-                                "type": "integer"
-                            },
-                            "model_number": {
-                                "type": "string"
-                            },
-                            "namespace_count": {
-                                "type": "integer"
-                            },
-                            "nqn": {
-                                "type": "string"
-                            },
-                            "serial_number": {
-                                "type": "string"
-                            },
-                            "subtype": {
-                                "type": "string"
-                            }
-                        }
+                        "type": "string"
                     }
+                },
+                "bytes_used": {
+                    "type": "integer"
+                },
+                "crush_rule": {
+                    "type": "integer"
+                },
+                "erasure_code_profile": {
+                    "type": "string"
+                },
+                "max_avail": {
+                    "type": "integer"
+                },
+                "min_size": {
+                    "type": "integer"
+                },
+                "objects": {
+                    "type": "integer"
+                },
+                "percent_used": {
+                    "type": "number"
+                },
+                "pg_autoscale_mode": {
+                    "type": "string"
+                },
+                "pg_num": {
+                    "type": "integer"
+                },
+                "pg_num_recommended": {
+                    "description": "PG 자동 조정이 권장하는 PG 수입니다.",
+                    "type": "integer"
+                },
+                "pg_would_adjust": {
+                    "type": "boolean"
+                },
+                "pool_id": {
+                    "type": "integer"
+                },
+                "pool_name": {
+                    "type": "string"
+                },
+                "quota_bytes_percent": {
+                    "description": "할당량이 없으면 0 입니다.",
+                    "type": "number"
+                },
+                "quota_max_bytes": {
+                    "type": "integer"
+                },
+                "quota_max_objects": {
+                    "type": "integer"
+                },
+                "quota_objects_percent": {
+                    "type": "number"
+                },
+                "read_bytes_sec": {
+                    "type": "integer"
+                },
+                "read_op_per_sec": {
+                    "type": "integer"
+                },
+                "size": {
+                    "type": "integer"
+                },
+                "stored": {
+                    "type": "integer"
+                },
+                "type": {
+                    "description": "replicated, erasure",
+                    "type": "string"
+                },
+                "write_bytes_sec": {
+                    "type": "integer"
+                },
+                "write_op_per_sec": {
+                    "type": "integer"
                 }
             }
         },
@@ -7633,6 +16509,199 @@ const docTemplate = `{
                 }
             }
         },
+        "TrashPurgeSchedule": {
+            "description": "rbd trash purge schedule 휴지통 자동 비우기 일정 구조체",
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/TrashPurgeScheduleItem"
+                    }
+                },
+                "namespace": {
+                    "type": "string"
+                },
+                "pool": {
+                    "type": "string"
+                }
+            }
+        },
+        "TrashPurgeScheduleItem": {
+            "type": "object",
+            "properties": {
+                "interval": {
+                    "type": "string"
+                },
+                "start_time": {
+                    "type": "string"
+                }
+            }
+        },
+        "UpgradeCheck": {
+            "description": "ceph orch upgrade check 대상 이미지 점검 구조체",
+            "type": "object",
+            "properties": {
+                "needs_update": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/UpgradeCheckDaemon"
+                    }
+                },
+                "non_ceph_image_daemons": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "target_digest": {},
+                "target_id": {
+                    "type": "string"
+                },
+                "target_name": {
+                    "type": "string"
+                },
+                "target_version": {
+                    "type": "string"
+                },
+                "up_to_date": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "UpgradeCheckDaemon": {
+            "type": "object",
+            "properties": {
+                "current_id": {
+                    "type": "string"
+                },
+                "current_name": {
+                    "type": "string"
+                },
+                "current_version": {
+                    "type": "string"
+                }
+            }
+        },
+        "UpgradeDaemonProgress": {
+            "type": "object",
+            "properties": {
+                "daemon_type": {
+                    "type": "string"
+                },
+                "total": {
+                    "type": "integer"
+                },
+                "upgraded": {
+                    "type": "integer"
+                },
+                "versions": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "UpgradePreflight": {
+            "description": "Glue 업그레이드 사전 점검 결과 구조체",
+            "type": "object",
+            "properties": {
+                "blockers": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "check": {
+                    "$ref": "#/definitions/UpgradeCheck"
+                },
+                "ready": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "UpgradeProgress": {
+            "description": "Glue 업그레이드 데몬 종류별 진행률 구조체",
+            "type": "object",
+            "properties": {
+                "daemons": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/UpgradeDaemonProgress"
+                    }
+                },
+                "started_at": {
+                    "type": "string"
+                },
+                "status": {
+                    "$ref": "#/definitions/UpgradeStatus"
+                },
+                "target_version": {
+                    "type": "string"
+                },
+                "total": {
+                    "type": "integer"
+                },
+                "upgraded": {
+                    "type": "integer"
+                }
+            }
+        },
+        "UpgradeStatus": {
+            "description": "ceph orch upgrade status 업그레이드 진행 상태 구조체",
+            "type": "object",
+            "properties": {
+                "in_progress": {
+                    "type": "boolean"
+                },
+                "is_paused": {
+                    "type": "boolean"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "progress": {
+                    "type": "string"
+                },
+                "services_complete": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "target_image": {
+                    "type": "string"
+                },
+                "which": {
+                    "type": "string"
+                }
+            }
+        },
+        "UpgradeVersions": {
+            "description": "ceph orch upgrade ls 업그레이드 가능 버전 구조체",
+            "type": "object",
+            "properties": {
+                "bare_image": {
+                    "type": "string"
+                },
+                "image": {
+                    "type": "string"
+                },
+                "registry": {
+                    "type": "string"
+                },
+                "versions": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
         "Version": {
             "description": "API의 버전",
             "type": "object",
@@ -7651,6 +16720,20 @@ const docTemplate = `{
                     "type": "string"
                 }
             }
+        },
+        "model.Mold": {
+            "type": "object",
+            "properties": {
+                "mold_api_key": {
+                    "type": "string"
+                },
+                "mold_secret_key": {
+                    "type": "string"
+                },
+                "mold_url": {
+                    "type": "string"
+                }
+            }
         }
     },
     "securityDefinitions": {
@@ -8,7 +8,10 @@ import (
 	"Glue-API/utils"
 
 	// "Glue-API/utils/license"
+	"Glue-API/utils/alert"
+	"Glue-API/utils/metric"
 	"Glue-API/utils/mirror"
+	"context"
 	"encoding/json"
 
 	// "fmt"
@@ -49,6 +52,9 @@ func main() {
 
 	mold, _ := utils.ReadMoldFile()
 	go MirroringSchedule(mold)
+	conf, _ := utils.ReadConfFile()
+	go metric.Collect(conf)
+	go alert.Watch(conf)
 	// programmatically set swagger info
 
 	// 로그 설정
@@ -89,25 +95,143 @@ func main() {
 
 	log.SetFlags(log.LstdFlags | log.Lshortfile)
 	controller.LogSetting()
-	r := gin.Default()
+	r := gin.New()
+	r.Use(gin.Logger(), gin.CustomRecovery(controller.Recovery))
 	r.ForwardedByClientIP = true
 	r.SetTrustedProxies(nil)
 	c := controller.NewController()
 	v1 := r.Group("/api/v1")
+	v1.Use(controller.ClusterSelect())
 	{
+		clusters := v1.Group("/cluster")
+		{
+			clusters.GET("", c.ClusterList)
+			clusters.POST("", c.ClusterCreate)
+			clusters.OPTIONS("", c.ClusterOption)
+
+			clusters.DELETE("/:cluster_name", c.ClusterDelete)
+			clusters.OPTIONS("/:cluster_name", c.ClusterOption)
+		}
 		glue := v1.Group("/glue")
 		{
 			glue.GET("", c.GlueStatus)
 			glue.GET("/hosts", c.HostList)
 			glue.GET("/version", c.GlueVersion)
 			glue.GET("/pw", c.PwEncryption)
+
+			glue.GET("/health/mute", c.HealthMuteList)
+			glue.POST("/health/mute", c.HealthMute)
+			glue.OPTIONS("/health/mute", c.HealthOption)
+
+			glue.DELETE("/health/mute/:code", c.HealthUnmute)
+			glue.OPTIONS("/health/mute/:code", c.HealthOption)
 		}
 		pool := v1.Group("/pool")
 		{
 			pool.GET("", c.ListPools)
+			pool.POST("", c.PoolCreate)
+			pool.OPTIONS("", c.GlueOption)
 
+			pool.PUT("/:pool_name", c.PoolUpdate)
 			pool.DELETE("/:pool_name", c.PoolDelete)
 			pool.OPTIONS("/:pool_name", c.GlueOption)
+
+			pool.GET("/stats", c.PoolStatsList)
+			pool.OPTIONS("/stats", c.GlueOption)
+			pool.GET("/:pool_name/stats", c.PoolStats)
+			pool.OPTIONS("/:pool_name/stats", c.GlueOption)
+
+			pool.PUT("/:pool_name/protect", c.PoolProtect)
+			pool.OPTIONS("/:pool_name/protect", c.GlueOption)
+
+			pool.POST("/:pool_name/scrub", c.PoolScrub)
+			pool.OPTIONS("/:pool_name/scrub", c.GlueOption)
+
+			pool.GET("/:pool_name/qos", c.PoolQos)
+			pool.PUT("/:pool_name/qos", c.PoolQosUpdate)
+			pool.DELETE("/:pool_name/qos", c.PoolQosReset)
+			pool.OPTIONS("/:pool_name/qos", c.GlueOption)
+		}
+		pg := v1.Group("/pg")
+		{
+			pg.GET("", c.PgList)
+			pg.OPTIONS("", c.PgOption)
+
+			pg.GET("/stuck", c.PgStuckList)
+			pg.OPTIONS("/stuck", c.PgOption)
+
+			pg.POST("/:pg_id", c.PgScrub)
+			pg.OPTIONS("/:pg_id", c.PgOption)
+
+			pg.GET("/:pg_id/inconsistent", c.PgInconsistentObjects)
+			pg.OPTIONS("/:pg_id/inconsistent", c.PgOption)
+		}
+		hosts := v1.Group("/host")
+		{
+			hosts.GET("", c.HostOrchList)
+			hosts.POST("", c.HostAdd)
+			hosts.OPTIONS("", c.HostOption)
+
+			hosts.DELETE("/:hostname", c.HostRemove)
+			hosts.OPTIONS("/:hostname", c.HostOption)
+
+			hosts.POST("/:hostname/label", c.HostLabelAdd)
+			hosts.OPTIONS("/:hostname/label", c.HostOption)
+			hosts.DELETE("/:hostname/label/:label", c.HostLabelRemove)
+			hosts.OPTIONS("/:hostname/label/:label", c.HostOption)
+
+			hosts.GET("/:hostname/maintenance", c.HostMaintenanceCheck)
+			hosts.PUT("/:hostname/maintenance", c.HostMaintenance)
+			hosts.OPTIONS("/:hostname/maintenance", c.HostOption)
+		}
+		osd := v1.Group("/osd")
+		{
+			osd.GET("", c.OsdList)
+			osd.POST("", c.OsdCreate)
+			osd.OPTIONS("", c.OsdOption)
+
+			osd.GET("/device", c.DeviceList)
+			osd.OPTIONS("/device", c.OsdOption)
+
+			osd.POST("/spec", c.OsdSpecApply)
+			osd.OPTIONS("/spec", c.OsdOption)
+
+			osd.GET("/flag", c.OsdFlagList)
+			osd.PUT("/flag", c.OsdFlagSet)
+			osd.OPTIONS("/flag", c.OsdOption)
+
+			osd.GET("/remove", c.OsdRemoveStatus)
+			osd.OPTIONS("/remove", c.OsdOption)
+			osd.DELETE("/remove/:osd_id", c.OsdRemoveStop)
+			osd.OPTIONS("/remove/:osd_id", c.OsdOption)
+
+			osd.PUT("/:osd_id", c.OsdMark)
+			osd.DELETE("/:osd_id", c.OsdRemove)
+			osd.OPTIONS("/:osd_id", c.OsdOption)
+
+			osd.GET("/:osd_id/safety", c.OsdSafety)
+			osd.OPTIONS("/:osd_id/safety", c.OsdOption)
+		}
+		crush := v1.Group("/crush")
+		{
+			crush.GET("", c.CrushTree)
+			crush.OPTIONS("", c.CrushOption)
+
+			crush.GET("/rule", c.CrushRuleList)
+			crush.POST("/rule", c.CrushRuleCreate)
+			crush.OPTIONS("/rule", c.CrushOption)
+			crush.DELETE("/rule/:rule_name", c.CrushRuleDelete)
+			crush.OPTIONS("/rule/:rule_name", c.CrushOption)
+		}
+		ecprofile := v1.Group("/ecprofile")
+		{
+			ecprofile.GET("", c.ErasureCodeProfileList)
+			ecprofile.POST("", c.ErasureCodeProfileCreate)
+			ecprofile.OPTIONS("", c.ErasureCodeProfileOption)
+
+			ecprofile.PUT("/:profile_name", c.ErasureCodeProfileUpdate)
+			ecprofile.DELETE("/:profile_name", c.ErasureCodeProfileDelete)
+			ecprofile.OPTIONS("/:profile_name", c.ErasureCodeProfileOption)
 		}
 		image := v1.Group("/image")
 		{
@@ -115,6 +239,86 @@ func main() {
 			image.POST("", c.CreateImage)
 			image.DELETE("", c.DeleteImage)
 			image.OPTIONS("", c.GlueOption)
+
+			image.GET("/task", c.ImageTaskList)
+			image.OPTIONS("/task", c.ImageOption)
+			image.DELETE("/task/:task_id", c.ImageTaskCancel)
+			image.OPTIONS("/task/:task_id", c.ImageOption)
+
+			image.GET("/trash", c.ImageTrashList)
+			image.OPTIONS("/trash", c.ImageOption)
+
+			image.GET("/trash/schedule", c.ImageTrashScheduleList)
+			image.POST("/trash/schedule", c.ImageTrashScheduleAdd)
+			image.DELETE("/trash/schedule", c.ImageTrashScheduleRemove)
+			image.OPTIONS("/trash/schedule", c.ImageOption)
+
+			image.DELETE("/trash/:pool_name", c.ImageTrashPurge)
+			image.OPTIONS("/trash/:pool_name", c.ImageOption)
+
+			image.GET("/trash/:pool_name/deferment", c.ImageTrashDeferment)
+			image.PUT("/trash/:pool_name/deferment", c.ImageTrashDefermentSet)
+			image.OPTIONS("/trash/:pool_name/deferment", c.ImageOption)
+
+			image.DELETE("/trash/:pool_name/:image_id", c.ImageTrashRemove)
+			image.OPTIONS("/trash/:pool_name/:image_id", c.ImageOption)
+
+			image.POST("/trash/:pool_name/:image_id/restore", c.ImageTrashRestore)
+			image.OPTIONS("/trash/:pool_name/:image_id/restore", c.ImageOption)
+
+			image.PUT("/:pool_name/:image_name/size", c.ImageResize)
+			image.OPTIONS("/:pool_name/:image_name/size", c.ImageOption)
+
+			image.PUT("/:pool_name/:image_name/name", c.ImageRename)
+			image.OPTIONS("/:pool_name/:image_name/name", c.ImageOption)
+
+			image.POST("/:pool_name/:image_name/copy", c.ImageCopy)
+			image.OPTIONS("/:pool_name/:image_name/copy", c.ImageOption)
+
+			image.PUT("/:pool_name/:image_name/feature", c.ImageFeature)
+			image.OPTIONS("/:pool_name/:image_name/feature", c.ImageOption)
+
+			image.GET("/:pool_name/:image_name/snapshot", c.ImageSnapshotList)
+			image.POST("/:pool_name/:image_name/snapshot", c.ImageSnapshotCreate)
+			image.OPTIONS("/:pool_name/:image_name/snapshot", c.ImageOption)
+
+			image.DELETE("/:pool_name/:image_name/snapshot/:snapshot_name", c.ImageSnapshotDelete)
+			image.OPTIONS("/:pool_name/:image_name/snapshot/:snapshot_name", c.ImageOption)
+
+			image.PUT("/:pool_name/:image_name/snapshot/:snapshot_name/name", c.ImageSnapshotRename)
+			image.OPTIONS("/:pool_name/:image_name/snapshot/:snapshot_name/name", c.ImageOption)
+
+			image.PUT("/:pool_name/:image_name/snapshot/:snapshot_name/protect", c.ImageSnapshotProtect)
+			image.OPTIONS("/:pool_name/:image_name/snapshot/:snapshot_name/protect", c.ImageOption)
+
+			image.POST("/:pool_name/:image_name/snapshot/:snapshot_name/rollback", c.ImageSnapshotRollback)
+			image.OPTIONS("/:pool_name/:image_name/snapshot/:snapshot_name/rollback", c.ImageOption)
+
+			image.POST("/:pool_name/:image_name/snapshot/:snapshot_name/clone", c.ImageClone)
+			image.OPTIONS("/:pool_name/:image_name/snapshot/:snapshot_name/clone", c.ImageOption)
+
+			image.POST("/:pool_name/:image_name/flatten", c.ImageFlatten)
+			image.OPTIONS("/:pool_name/:image_name/flatten", c.ImageOption)
+
+			image.GET("/:pool_name/:image_name/qos", c.ImageQos)
+			image.PUT("/:pool_name/:image_name/qos", c.ImageQosUpdate)
+			image.DELETE("/:pool_name/:image_name/qos", c.ImageQosReset)
+			image.OPTIONS("/:pool_name/:image_name/qos", c.ImageOption)
+
+			image.GET("/:pool_name/:image_name/lineage", c.ImageLineage)
+			image.OPTIONS("/:pool_name/:image_name/lineage", c.ImageOption)
+
+			image.GET("/:pool_name/:image_name/export", c.ImageExport)
+			image.OPTIONS("/:pool_name/:image_name/export", c.ImageOption)
+
+			image.PUT("/:pool_name/:image_name/import", c.ImageImport)
+			image.OPTIONS("/:pool_name/:image_name/import", c.ImageOption)
+
+			image.GET("/:pool_name/:image_name/export-diff", c.ImageExportDiff)
+			image.OPTIONS("/:pool_name/:image_name/export-diff", c.ImageOption)
+
+			image.PUT("/:pool_name/:image_name/import-diff", c.ImageImportDiff)
+			image.OPTIONS("/:pool_name/:image_name/import-diff", c.ImageOption)
 		}
 		service := v1.Group("/service")
 		{
@@ -124,6 +328,53 @@ func main() {
 			service.DELETE("/:service_name", c.ServiceDelete)
 			service.OPTIONS("/:service_name", c.GlueOption)
 		}
+		config := v1.Group("/config")
+		{
+			config.GET("", c.ConfigDump)
+			config.OPTIONS("", c.ConfigOption)
+
+			config.GET("/option", c.ConfigGet)
+			config.PUT("/option", c.ConfigSet)
+			config.DELETE("/option", c.ConfigRemove)
+			config.OPTIONS("/option", c.ConfigOption)
+
+			config.GET("/history", c.ConfigHistory)
+			config.OPTIONS("/history", c.ConfigOption)
+
+			config.POST("/revert", c.ConfigRevert)
+			config.OPTIONS("/revert", c.ConfigOption)
+		}
+		daemon := v1.Group("/daemon")
+		{
+			daemon.GET("", c.DaemonList)
+			daemon.OPTIONS("", c.DaemonOption)
+
+			daemon.POST("/:daemon_name", c.DaemonControl)
+			daemon.OPTIONS("/:daemon_name", c.DaemonOption)
+		}
+		upgrade := v1.Group("/upgrade")
+		{
+			upgrade.GET("", c.UpgradeProgress)
+			upgrade.POST("", c.UpgradeStart)
+			upgrade.PUT("", c.UpgradeControl)
+			upgrade.OPTIONS("", c.UpgradeOption)
+
+			upgrade.GET("/versions", c.UpgradeVersions)
+			upgrade.OPTIONS("/versions", c.UpgradeOption)
+
+			upgrade.GET("/check", c.UpgradePreflight)
+			upgrade.OPTIONS("/check", c.UpgradeOption)
+		}
+		crash := v1.Group("/crash")
+		{
+			crash.GET("", c.CrashList)
+			crash.PUT("", c.CrashArchiveAll)
+			crash.OPTIONS("", c.CrashOption)
+
+			crash.GET("/:crash_id", c.CrashInfo)
+			crash.PUT("/:crash_id", c.CrashArchive)
+			crash.OPTIONS("/:crash_id", c.CrashOption)
+		}
 		fs := v1.Group("/gluefs")
 		{
 			fs.GET("", c.FsStatus)
@@ -214,6 +465,11 @@ func main() {
 
 				iscsi_target.DELETE("/purge", c.IscsiTargetPurge)
 				iscsi_target.OPTIONS("/purge", c.IscsiOption)
+
+				iscsi_target.GET("/qos", c.IscsiTargetQos)
+				iscsi_target.PUT("/qos", c.IscsiTargetQosUpdate)
+				iscsi_target.DELETE("/qos", c.IscsiTargetQosReset)
+				iscsi_target.OPTIONS("/qos", c.IscsiOption)
 			}
 
 		}
@@ -266,6 +522,10 @@ func main() {
 		{
 			nvmeof.POST("", c.NvmeOfServiceCreate)
 
+			nvmeof.GET("/image", c.NvmeOfImage)
+			nvmeof.PUT("/image", c.NvmeOfImageUpdate)
+			nvmeof.OPTIONS("/image", c.NvmeOption)
+
 			nvmeof.POST("/image/download", c.NvmeOfImageDownload)
 
 			nvmeof.GET("/target", c.NvmeOfTargetList)
@@ -284,6 +544,11 @@ func main() {
 				namespace.POST("", c.NvmeOfNameSpaceCreate)
 				namespace.DELETE("", c.NvmeOfNameSpaceDelete)
 				namespace.OPTIONS("", c.NvmeOption)
+
+				namespace.GET("/qos", c.NvmeOfNameSpaceQos)
+				namespace.PUT("/qos", c.NvmeOfNameSpaceQosUpdate)
+				namespace.DELETE("/qos", c.NvmeOfNameSpaceQosReset)
+				namespace.OPTIONS("/qos", c.NvmeOption)
 			}
 		}
 		mirror := v1.Group("/mirror")
@@ -341,6 +606,47 @@ func main() {
 			license.GET("/isLicenseExpired", c.IsLicenseExpired)
 			license.GET("/controlHostAgent/:action", c.ControlHostAgent)
 		}
+		metric := v1.Group("/metric")
+		{
+			metric.GET("", c.MetricSeries)
+			metric.OPTIONS("", c.MetricOption)
+
+			metric.GET("/sample", c.MetricSample)
+			metric.OPTIONS("/sample", c.MetricOption)
+
+			metric.GET("/forecast", c.MetricForecast)
+			metric.OPTIONS("/forecast", c.MetricOption)
+		}
+		alerts := v1.Group("/alerts")
+		{
+			alerts.GET("", c.AlertList)
+			alerts.OPTIONS("", c.AlertOption)
+
+			alerts.GET("/rule", c.AlertRuleList)
+			alerts.POST("/rule", c.AlertRuleCreate)
+			alerts.OPTIONS("/rule", c.AlertOption)
+			alerts.DELETE("/rule/:rule_name", c.AlertRuleDelete)
+			alerts.OPTIONS("/rule/:rule_name", c.AlertOption)
+
+			alerts.GET("/silence", c.AlertSilenceList)
+			alerts.POST("/silence", c.AlertSilenceCreate)
+			alerts.OPTIONS("/silence", c.AlertOption)
+			alerts.DELETE("/silence/:silence_id", c.AlertSilenceDelete)
+			alerts.OPTIONS("/silence/:silence_id", c.AlertOption)
+		}
+		apply := v1.Group("/apply")
+		{
+			apply.POST("", c.Apply)
+			apply.OPTIONS("", c.ApplyOption)
+		}
+		backup := v1.Group("/backup")
+		{
+			backup.GET("", c.BackupExport)
+			backup.OPTIONS("", c.BackupOption)
+
+			backup.POST("/restore", c.BackupRestore)
+			backup.OPTIONS("/restore", c.BackupOption)
+		}
 		/*
 		   admin := v1.Group("/admin")
 		   {
@@ -403,7 +709,7 @@ func MirroringSchedule(mold model.Mold) {
 								if vm[k].Name == dr[i].Drclustervmmap[j].Drclustermirrorvmname {
 									vmName := vm[k].Instancename
 									hostName := vm[k].Hostname
-									volStatus, _ := mirror.ImageStatus("rbd", dr[i].Drclustervmmap[j].Drclustermirrorvmvolpath)
+									volStatus, _ := mirror.ImageStatus(context.Background(), "rbd", dr[i].Drclustervmmap[j].Drclustermirrorvmvolpath)
 									// 미러링 이미지 상태가 Peer와 정상적으로 ready, sync 인 경우
 									if volStatus.Description == "local image is primary" && strings.Contains(volStatus.PeerSites[0].State, "replaying") && strings.Contains(volStatus.PeerSites[0].Description, "idle") {
 										interval, _ := mirror.ImageMetaGetInterval()
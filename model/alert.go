@@ -0,0 +1,45 @@
+package model
+
+// AlertRule model info
+// @Description Glue 경고 규칙 구조체
+type AlertRule struct {
+	Name string `json:"name"`
+	// health_check, cluster_usage, pool_usage, mirror_image, smb_host
+	Type string `json:"type"`
+	// health_check 는 점검 코드(* 은 전체), pool_usage 는 풀 이름, mirror_image 는 미러링 풀 이름
+	Target    string  `json:"target"`
+	Threshold float64 `json:"threshold"`
+	// warning, critical
+	Severity string `json:"severity"`
+	// 조건이 이 시간(초) 동안 유지되어야 firing 상태가 됩니다.
+	Duration int `json:"duration"`
+} //@name AlertRule
+
+type AlertRuleList []AlertRule //@name AlertRuleList
+
+// Alert model info
+// @Description Glue 경고 구조체
+type Alert struct {
+	Id         string `json:"id"`
+	Rule       string `json:"rule"`
+	Instance   string `json:"instance"`
+	Severity   string `json:"severity"`
+	State      string `json:"state"`
+	Message    string `json:"message"`
+	StartsAt   string `json:"starts_at"`
+	FiringAt   string `json:"firing_at"`
+	ResolvedAt string `json:"resolved_at"`
+	Silenced   bool   `json:"silenced"`
+} //@name Alert
+
+// AlertSilence model info
+// @Description Glue 경고 무시 설정 구조체
+type AlertSilence struct {
+	Id       string `json:"id"`
+	Rule     string `json:"rule"`
+	Instance string `json:"instance"`
+	EndsAt   string `json:"ends_at"`
+	Comment  string `json:"comment"`
+} //@name AlertSilence
+
+type AlertSilenceList []AlertSilence //@name AlertSilenceList
@@ -0,0 +1,117 @@
+package model
+
+// ApplySpec model info
+// @Description Glue 선언형 구성 적용을 위한 원하는 상태 구조체
+type ApplySpec struct {
+	Pools            []ApplyPool            `yaml:"pools" json:"pools"`
+	Images           []ApplyImage           `yaml:"images" json:"images"`
+	Filesystems      []ApplyFs              `yaml:"filesystems" json:"filesystems"`
+	SubVolumeGroups  []ApplySubVolumeGroup  `yaml:"subvolume_groups" json:"subvolume_groups"`
+	NfsExports       []ApplyNfsExport       `yaml:"nfs_exports" json:"nfs_exports"`
+	SmbShares        []ApplySmbShare        `yaml:"smb_shares" json:"smb_shares"`
+	IscsiTargets     []ApplyIscsiTarget     `yaml:"iscsi_targets" json:"iscsi_targets"`
+	NvmeOfNameSpaces []ApplyNvmeOfNameSpace `yaml:"nvmeof_namespaces" json:"nvmeof_namespaces"`
+	RgwUsers         []ApplyRgwUser         `yaml:"rgw_users" json:"rgw_users"`
+	RgwBuckets       []ApplyRgwBucket       `yaml:"rgw_buckets" json:"rgw_buckets"`
+} //@name ApplySpec
+
+type ApplyPool struct {
+	Name        string `yaml:"name" json:"name"`
+	Application string `yaml:"application" json:"application"`
+	Size        int    `yaml:"size" json:"size"`
+} //@name ApplyPool
+
+type ApplyImage struct {
+	Pool string `yaml:"pool" json:"pool"`
+	Name string `yaml:"name" json:"name"`
+	// MB 단위
+	Size int64 `yaml:"size" json:"size"`
+} //@name ApplyImage
+
+type ApplyFs struct {
+	Name  string   `yaml:"name" json:"name"`
+	Hosts []string `yaml:"hosts" json:"hosts"`
+} //@name ApplyFs
+
+type ApplySubVolumeGroup struct {
+	FsName string `yaml:"fs_name" json:"fs_name"`
+	Name   string `yaml:"name" json:"name"`
+	// Byte 단위
+	Size     int64  `yaml:"size" json:"size"`
+	DataPool string `yaml:"data_pool" json:"data_pool"`
+	Mode     string `yaml:"mode" json:"mode"`
+} //@name ApplySubVolumeGroup
+
+type ApplyNfsExport struct {
+	ClusterId   string   `yaml:"cluster_id" json:"cluster_id"`
+	Pseudo      string   `yaml:"pseudo" json:"pseudo"`
+	StorageName string   `yaml:"storage_name" json:"storage_name"`
+	FsName      string   `yaml:"fs_name" json:"fs_name"`
+	Path        string   `yaml:"path" json:"path"`
+	AccessType  string   `yaml:"access_type" json:"access_type"`
+	Squash      string   `yaml:"squash" json:"squash"`
+	Transports  []string `yaml:"transports" json:"transports"`
+	// 지정한 클라이언트만 export 의 접근 설정과 다르게 허용합니다.
+	Clients []NfsExportClient `yaml:"clients" json:"clients"`
+} //@name ApplyNfsExport
+
+type ApplySmbShare struct {
+	Host        string `yaml:"host" json:"host"`
+	FolderName  string `yaml:"folder_name" json:"folder_name"`
+	Path        string `yaml:"path" json:"path"`
+	FsName      string `yaml:"fs_name" json:"fs_name"`
+	VolumePath  string `yaml:"volume_path" json:"volume_path"`
+	Username    string `yaml:"username" json:"username"`
+	Password    string `yaml:"password" json:"-"`
+	CachePolicy string `yaml:"cache_policy" json:"cache_policy"`
+} //@name ApplySmbShare
+
+type ApplyIscsiTarget struct {
+	TargetIqn  string       `yaml:"target_iqn" json:"target_iqn"`
+	Portals    []Portals    `yaml:"portals" json:"portals"`
+	Disks      []ApplyImage `yaml:"disks" json:"disks"`
+	AclEnabled bool         `yaml:"acl_enabled" json:"acl_enabled"`
+	Auth       struct {
+		User           string `yaml:"user" json:"user"`
+		Password       string `yaml:"password" json:"-"`
+		MutualUser     string `yaml:"mutual_user" json:"mutual_user"`
+		MutualPassword string `yaml:"mutual_password" json:"-"`
+	} `yaml:"auth" json:"auth"`
+} //@name ApplyIscsiTarget
+
+type ApplyNvmeOfNameSpace struct {
+	SubsystemNqn string `yaml:"subsystem_nqn" json:"subsystem_nqn"`
+	Pool         string `yaml:"pool" json:"pool"`
+	Image        string `yaml:"image" json:"image"`
+} //@name ApplyNvmeOfNameSpace
+
+type ApplyRgwUser struct {
+	UserId      string `yaml:"user_id" json:"user_id"`
+	DisplayName string `yaml:"display_name" json:"display_name"`
+	Email       string `yaml:"email" json:"email"`
+} //@name ApplyRgwUser
+
+type ApplyRgwBucket struct {
+	Name            string `yaml:"name" json:"name"`
+	Owner           string `yaml:"owner" json:"owner"`
+	Zonegroup       string `yaml:"zonegroup" json:"zonegroup"`
+	PlacementTarget string `yaml:"placement_target" json:"placement_target"`
+} //@name ApplyRgwBucket
+
+// ApplyStep model info
+// @Description Glue 선언형 구성 적용 계획의 리소스별 단계 구조체
+type ApplyStep struct {
+	Kind     string   `json:"kind"`
+	Name     string   `json:"name"`
+	Action   string   `json:"action"`
+	Detail   string   `json:"detail"`
+	Requires []string `json:"requires"`
+	Result   string   `json:"result"`
+} //@name ApplyStep
+
+// ApplyResult model info
+// @Description Glue 선언형 구성 적용 결과 구조체
+type ApplyResult struct {
+	DryRun bool        `json:"dry_run"`
+	Steps  []ApplyStep `json:"steps"`
+} //@name ApplyResult
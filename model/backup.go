@@ -0,0 +1,78 @@
+package model
+
+import "encoding/json"
+
+// BackupManifest model info
+// @Description Glue 설정 백업 번들의 매니페스트 구조체
+type BackupManifest struct {
+	Version   int      `json:"version"`
+	CreatedAt string   `json:"created_at"`
+	Fsid      string   `json:"fsid"`
+	Sections  []string `json:"sections"`
+	// false 이면 RGW 키, 미러링 피어 키, iSCSI 인증 비밀번호가 빠져 있습니다.
+	Secrets bool `json:"secrets"`
+} //@name BackupManifest
+
+// Backup model info
+// @Description Glue 게이트웨이 서비스 설정 백업 구조체
+type Backup struct {
+	Manifest     BackupManifest               `json:"manifest"`
+	ServiceSpecs map[string]string            `json:"service_specs"`
+	Fs           []BackupFs                   `json:"fs"`
+	NfsExports   map[string][]json.RawMessage `json:"nfs_exports"`
+	RgwUsers     []RgwUserInfo                `json:"rgw_users"`
+	IscsiAuth    json.RawMessage              `json:"iscsi_discovery_auth"`
+	IscsiTargets []json.RawMessage            `json:"iscsi_targets"`
+	NvmeOf       []BackupNvmeOfSubSystem      `json:"nvmeof"`
+	Smb          []SmbNormalStatus            `json:"smb"`
+	MirrorPools  []BackupMirrorPool           `json:"mirror"`
+} //@name Backup
+
+type BackupFs struct {
+	Name   string                 `json:"name"`
+	Hosts  []string               `json:"hosts"`
+	Groups []BackupSubVolumeGroup `json:"subvolume_groups"`
+} //@name BackupFs
+
+type BackupSubVolumeGroup struct {
+	Name     string `json:"name"`
+	Size     int64  `json:"size"`
+	DataPool string `json:"data_pool"`
+	Mode     string `json:"mode"`
+} //@name BackupSubVolumeGroup
+
+type BackupNvmeOfSubSystem struct {
+	Nqn        string                  `json:"nqn"`
+	Namespaces []BackupNvmeOfNameSpace `json:"namespaces"`
+	// 이전 버전의 백업에는 없으며, 없으면 모든 호스트를 허용합니다.
+	AllowAnyHost *bool    `json:"allow_any_host,omitempty"`
+	Hosts        []string `json:"hosts"`
+} //@name BackupNvmeOfSubSystem
+
+type BackupNvmeOfNameSpace struct {
+	PoolName  string `json:"pool_name"`
+	ImageName string `json:"image_name"`
+} //@name BackupNvmeOfNameSpace
+
+type BackupMirrorPool struct {
+	PoolName  string        `json:"pool_name"`
+	Conf      MirrorConf    `json:"conf"`
+	Schedules []MirrorImage `json:"schedules"`
+} //@name BackupMirrorPool
+
+// BackupRestoreResult model info
+// @Description Glue 설정 복원 결과 구조체
+type BackupRestoreResult struct {
+	Version   int          `json:"version"`
+	Fsid      string       `json:"fsid"`
+	DryRun    bool         `json:"dry_run"`
+	Applied   []BackupItem `json:"applied"`
+	Conflicts []BackupItem `json:"conflicts"`
+	Errors    []BackupItem `json:"errors"`
+} //@name BackupRestoreResult
+
+type BackupItem struct {
+	Section string `json:"section"`
+	Name    string `json:"name"`
+	Message string `json:"message"`
+} //@name BackupItem
@@ -0,0 +1,21 @@
+package model
+
+// Cluster model info
+// @Description Glue API 에서 관리하는 클러스터 구조체
+type Cluster struct {
+	Name         string `json:"name"`
+	ConfPath     string `json:"conf_path"`
+	KeyringPath  string `json:"keyring_path"`
+	ClientName   string `json:"client_name"`
+	SshHost      string `json:"ssh_host"`
+	SshKeyPath   string `json:"ssh_key_path"`
+	DashboardUrl string `json:"dashboard_url"`
+} //@name Cluster
+
+// ClusterList model info
+// @Description Glue API 에 등록된 클러스터 리스트 구조체
+type ClusterList []Cluster //@name ClusterList
+
+type ClusterFsid struct {
+	Fsid string `json:"fsid"`
+} //@name ClusterFsid
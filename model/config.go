@@ -0,0 +1,55 @@
+package model
+
+// ConfigOption model info
+// @Description ceph config dump 설정 항목 구조체
+type ConfigOption struct {
+	Section            string `json:"section"`
+	Name               string `json:"name"`
+	Value              string `json:"value"`
+	Level              string `json:"level"`
+	CanUpdateAtRuntime bool   `json:"can_update_at_runtime"`
+	Mask               string `json:"mask"`
+	// diff 조회 시 ceph config help 에서 채웁니다.
+	Default string `json:"default,omitempty"`
+} //@name ConfigOption
+
+type ConfigDump []ConfigOption //@name ConfigDump
+
+// ConfigHelp model info
+// @Description ceph config help 설정 설명 구조체
+type ConfigHelp struct {
+	Name               string      `json:"name"`
+	Type               string      `json:"type"`
+	Level              string      `json:"level"`
+	Desc               string      `json:"desc"`
+	LongDesc           string      `json:"long_desc"`
+	Default            interface{} `json:"default"`
+	Min                interface{} `json:"min,omitempty"`
+	Max                interface{} `json:"max,omitempty"`
+	EnumValues         []string    `json:"enum_values"`
+	Services           []string    `json:"services"`
+	CanUpdateAtRuntime bool        `json:"can_update_at_runtime"`
+} //@name ConfigHelp
+
+// ConfigValue model info
+// @Description Glue 설정 값 구조체
+type ConfigValue struct {
+	Who   string     `json:"who"`
+	Name  string     `json:"name"`
+	Value string     `json:"value"`
+	Help  ConfigHelp `json:"help"`
+} //@name ConfigValue
+
+// ConfigLog model info
+// @Description ceph config log 설정 변경 이력 구조체
+type ConfigLog []struct {
+	Version   int    `json:"version"`
+	Timestamp string `json:"timestamp"`
+	Name      string `json:"name"`
+	Changes   []struct {
+		// who/option 형식입니다.
+		Name          string `json:"name"`
+		NewValue      string `json:"new_value"`
+		PreviousValue string `json:"previous_value"`
+	} `json:"changes"`
+} //@name ConfigLog
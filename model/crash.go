@@ -0,0 +1,23 @@
+package model
+
+// Crash model info
+// @Description ceph crash 데몬 장애 보고서 구조체
+type Crash struct {
+	CrashId         string   `json:"crash_id"`
+	Timestamp       string   `json:"timestamp"`
+	EntityName      string   `json:"entity_name"`
+	ProcessName     string   `json:"process_name"`
+	CephVersion     string   `json:"ceph_version"`
+	Hostname        string   `json:"utsname_hostname"`
+	OsName          string   `json:"os_name"`
+	OsVersion       string   `json:"os_version"`
+	AssertCondition string   `json:"assert_condition,omitempty"`
+	AssertFunc      string   `json:"assert_func,omitempty"`
+	AssertFile      string   `json:"assert_file,omitempty"`
+	AssertLine      int      `json:"assert_line,omitempty"`
+	AssertMsg       string   `json:"assert_msg,omitempty"`
+	Backtrace       []string `json:"backtrace,omitempty"`
+	Archived        string   `json:"archived,omitempty"`
+} //@name Crash
+
+type CrashList []Crash //@name CrashList
@@ -0,0 +1,70 @@
+package model
+
+// OsdTreeNode model info
+// @Description ceph osd tree 노드 구조체
+type OsdTreeNode struct {
+	Id          int     `json:"id"`
+	Name        string  `json:"name"`
+	Type        string  `json:"type"`
+	DeviceClass string  `json:"device_class"`
+	CrushWeight float64 `json:"crush_weight"`
+	Status      string  `json:"status"`
+	Reweight    float64 `json:"reweight"`
+	Children    []int   `json:"children"`
+} //@name OsdTreeNode
+
+// OsdTree model info
+// @Description ceph osd tree 결과 구조체
+type OsdTree struct {
+	Nodes []OsdTreeNode `json:"nodes"`
+	Stray []OsdTreeNode `json:"stray"`
+} //@name OsdTree
+
+// CrushNode model info
+// @Description Glue CRUSH 트리 노드 구조체
+type CrushNode struct {
+	Id          int         `json:"id"`
+	Name        string      `json:"name"`
+	Type        string      `json:"type"`
+	DeviceClass string      `json:"device_class,omitempty"`
+	CrushWeight float64     `json:"crush_weight"`
+	Status      string      `json:"status,omitempty"`
+	Reweight    float64     `json:"reweight,omitempty"`
+	Children    []CrushNode `json:"children,omitempty"`
+} //@name CrushNode
+
+// CrushTree model info
+// @Description Glue CRUSH 트리와 디바이스 클래스 구조체
+type CrushTree struct {
+	Roots   []CrushNode `json:"roots"`
+	Stray   []CrushNode `json:"stray"`
+	Classes []string    `json:"classes"`
+} //@name CrushTree
+
+// CrushRuleDump model info
+// @Description ceph osd crush rule dump 결과 구조체
+type CrushRuleDump []struct {
+	RuleId   int    `json:"rule_id"`
+	RuleName string `json:"rule_name"`
+	Type     int    `json:"type"`
+	Steps    []struct {
+		Op       string `json:"op"`
+		Item     int    `json:"item"`
+		ItemName string `json:"item_name"`
+		Num      int    `json:"num"`
+		Type     string `json:"type"`
+	} `json:"steps"`
+} //@name CrushRuleDump
+
+// CrushRule model info
+// @Description Glue CRUSH 규칙 구조체
+type CrushRule struct {
+	RuleId   int    `json:"rule_id"`
+	RuleName string `json:"rule_name"`
+	// replicated, erasure
+	Type          string   `json:"type"`
+	Root          string   `json:"root"`
+	FailureDomain string   `json:"failure_domain"`
+	DeviceClass   string   `json:"device_class"`
+	Pools         []string `json:"pools"`
+} //@name CrushRule
@@ -0,0 +1,28 @@
+package model
+
+// Daemon model info
+// @Description ceph orch ps 데몬 구조체
+type Daemon struct {
+	DaemonType         string `json:"daemon_type"`
+	DaemonId           string `json:"daemon_id"`
+	DaemonName         string `json:"daemon_name"`
+	Hostname           string `json:"hostname"`
+	ServiceName        string `json:"service_name"`
+	Status             int    `json:"status"`
+	StatusDesc         string `json:"status_desc"`
+	Version            string `json:"version"`
+	ContainerId        string `json:"container_id"`
+	ContainerImageName string `json:"container_image_name"`
+	ContainerImageId   string `json:"container_image_id"`
+	MemoryUsage        int64  `json:"memory_usage"`
+	MemoryRequest      int64  `json:"memory_request"`
+	MemoryLimit        int64  `json:"memory_limit"`
+	CpuPercentage      string `json:"cpu_percentage"`
+	Ports              []int  `json:"ports"`
+	Created            string `json:"created"`
+	Started            string `json:"started"`
+	LastRefresh        string `json:"last_refresh"`
+	IsActive           bool   `json:"is_active"`
+} //@name Daemon
+
+type DaemonList []Daemon //@name DaemonList
@@ -0,0 +1,20 @@
+package model
+
+// Device model info
+// @Description ceph orch device ls 디스크 구조체
+type Device struct {
+	Hostname         string   `json:"hostname"`
+	Path             string   `json:"path"`
+	DeviceId         string   `json:"device_id"`
+	Type             string   `json:"type"`
+	Rotational       bool     `json:"rotational"`
+	Size             int64    `json:"size"`
+	Vendor           string   `json:"vendor"`
+	Model            string   `json:"model"`
+	Health           string   `json:"health"`
+	Available        bool     `json:"available"`
+	RejectedReasons  []string `json:"rejected_reasons"`
+	CrushDeviceClass string   `json:"crush_device_class"`
+} //@name Device
+
+type DeviceList []Device //@name DeviceList
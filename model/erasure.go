@@ -0,0 +1,19 @@
+package model
+
+// ErasureCodeProfile model info
+// @Description Glue EC(Erasure Code) 프로파일 구조체
+type ErasureCodeProfile struct {
+	Name string `json:"name"`
+	K    int    `json:"k"`
+	M    int    `json:"m"`
+	// jerasure, isa, clay
+	Plugin             string `json:"plugin"`
+	Technique          string `json:"technique"`
+	CrushFailureDomain string `json:"crush_failure_domain"`
+	CrushDeviceClass   string `json:"crush_device_class"`
+	CrushRoot          string `json:"crush_root"`
+	// 이 프로파일을 사용하는 풀 목록입니다.
+	Pools []string `json:"pools"`
+} //@name ErasureCodeProfile
+
+type ErasureCodeProfileList []ErasureCodeProfile //@name ErasureCodeProfileList
@@ -28,10 +28,9 @@ type GlueVersion struct {
 type GlueStatus struct {
 	Fsid   uuid.UUID `json:"fsid" example:"9980ffe8-4bc1-11ee-9b1f-002481004170" format:"uuid"` //Glue클러스터를 구분하는 ID
 	Health struct {
-		Status string `json:"status" example:"HEALTH_WARN" format:"string"`
-		Checks interface {
-		} `json:"checks"`
-		Mutes interface{} `json:"mutes"`
+		Status string                 `json:"status" example:"HEALTH_WARN" format:"string"`
+		Checks map[string]HealthCheck `json:"checks"`
+		Mutes  []HealthMute           `json:"mutes"`
 	} `json:"health"`
 	ElectionEpoch int      `json:"election_epoch" example:"148" format:"uint32"`
 	Quorum        []int    `json:"quorum"`
@@ -91,6 +90,29 @@ type GlueStatus struct {
 	} `json:"progress_events"`
 } // @name GlueStatus
 
+// HealthCheck model info
+// @Description Glue 상태 점검 항목 구조체
+type HealthCheck struct {
+	Severity string `json:"severity" example:"HEALTH_WARN" format:"string"`
+	Summary  struct {
+		Message string `json:"message"`
+		Count   int    `json:"count"`
+	} `json:"summary"`
+	Detail []struct {
+		Message string `json:"message"`
+	} `json:"detail"`
+	Muted bool `json:"muted"`
+} // @name HealthCheck
+
+// HealthMute model info
+// @Description Glue 상태 점검 항목 무시 구조체
+type HealthMute struct {
+	Code    string `json:"code" example:"OSD_DOWN" format:"string"`
+	Sticky  bool   `json:"sticky"`
+	Summary string `json:"summary"`
+	Count   int    `json:"count"`
+} // @name HealthMute
+
 type GluePools interface{} // @name GluePools
 
 type ServiceLs interface{} // @name ServiceLs
@@ -0,0 +1,36 @@
+package model
+
+// OrchHost model info
+// @Description Glue 오케스트레이터 호스트 구조체
+type OrchHost struct {
+	Addr     string   `json:"addr"`
+	Hostname string   `json:"hostname"`
+	Labels   []string `json:"labels"`
+	// 빈 값은 정상, maintenance 는 유지보수 모드, offline 은 연결 끊김입니다.
+	Status string `json:"status"`
+} //@name OrchHost
+
+type OrchHostList []OrchHost //@name OrchHostList
+
+// HostServiceImpact model info
+// @Description 호스트 유지보수 시 영향을 받는 서비스 구조체
+type HostServiceImpact struct {
+	ServiceName string   `json:"service_name"`
+	Daemons     []string `json:"daemons"`
+	// 다른 호스트에서 실행 중인 같은 서비스의 데몬 수입니다.
+	OtherRunningDaemons int `json:"other_running_daemons"`
+	// degraded, unavailable
+	Impact string `json:"impact"`
+} //@name HostServiceImpact
+
+// HostMaintenanceReport model info
+// @Description 호스트 유지보수 사전 점검 결과 구조체
+type HostMaintenanceReport struct {
+	Hostname        string              `json:"hostname"`
+	OkToStop        bool                `json:"ok_to_stop"`
+	OkToStopMessage string              `json:"ok_to_stop_message"`
+	Gwvm            bool                `json:"gwvm"`
+	Services        []HostServiceImpact `json:"services"`
+	// force 없이 유지보수 모드로 전환할 수 없는 이유입니다.
+	Blockers []string `json:"blockers"`
+} //@name HostMaintenanceReport
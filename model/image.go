@@ -0,0 +1,137 @@
+package model
+
+// ImageDetail model info
+// @Description rbd info 이미지 상세정보 구조체
+type ImageDetail struct {
+	Name            string   `json:"name"`
+	Id              string   `json:"id"`
+	Size            int64    `json:"size"`
+	Objects         int64    `json:"objects"`
+	Order           int      `json:"order"`
+	ObjectSize      int64    `json:"object_size"`
+	SnapshotCount   int      `json:"snapshot_count"`
+	BlockNamePrefix string   `json:"block_name_prefix"`
+	Format          int      `json:"format"`
+	Features        []string `json:"features"`
+	OpFeatures      []string `json:"op_features"`
+	Flags           []string `json:"flags"`
+	CreateTimestamp string   `json:"create_timestamp"`
+	Parent          *struct {
+		Pool     string `json:"pool"`
+		Image    string `json:"image"`
+		Id       string `json:"id"`
+		Snapshot string `json:"snapshot"`
+		Trash    bool   `json:"trash"`
+		Overlap  int64  `json:"overlap"`
+	} `json:"parent,omitempty"`
+} //@name ImageDetail
+
+// ImageSnapshot model info
+// @Description rbd snap ls 이미지 스냅샷 구조체
+type ImageSnapshot struct {
+	Id        int    `json:"id"`
+	Name      string `json:"name"`
+	Size      int64  `json:"size"`
+	Protected bool   `json:"protected"`
+	Timestamp string `json:"timestamp"`
+} //@name ImageSnapshot
+
+type ImageSnapshotList []ImageSnapshot //@name ImageSnapshotList
+
+type ImageWatcher struct {
+	Address string `json:"address"`
+	Client  int64  `json:"client"`
+	Cookie  int64  `json:"cookie"`
+} //@name ImageWatcher
+
+// ImageTask model info
+// @Description ceph rbd task 백그라운드 작업 구조체
+type ImageTask struct {
+	Id       string `json:"id"`
+	Sequence int    `json:"sequence"`
+	Message  string `json:"message"`
+	Refs     struct {
+		Action        string `json:"action"`
+		PoolName      string `json:"pool_name"`
+		PoolNamespace string `json:"pool_namespace"`
+		ImageName     string `json:"image_name"`
+		ImageId       string `json:"image_id"`
+	} `json:"refs"`
+	InProgress    bool    `json:"in_progress"`
+	Progress      float64 `json:"progress"`
+	RetryAttempts int     `json:"retry_attempts,omitempty"`
+	RetryTime     string  `json:"retry_time,omitempty"`
+	RetryMessage  string  `json:"retry_message,omitempty"`
+} //@name ImageTask
+
+type ImageTaskList []ImageTask //@name ImageTaskList
+
+type ImageRef struct {
+	Pool     string `json:"pool"`
+	Image    string `json:"image"`
+	Snapshot string `json:"snapshot,omitempty"`
+} //@name ImageRef
+
+type ImageChild struct {
+	Pool     string       `json:"pool"`
+	Image    string       `json:"image"`
+	Snapshot string       `json:"snapshot"`
+	Children []ImageChild `json:"children"`
+} //@name ImageChild
+
+// ImageLineage model info
+// @Description 이미지의 부모 체인과 자식 클론 트리 구조체
+type ImageLineage struct {
+	Pool     string       `json:"pool"`
+	Image    string       `json:"image"`
+	Parents  []ImageRef   `json:"parents"`
+	Children []ImageChild `json:"children"`
+} //@name ImageLineage
+
+// ImageTrash model info
+// @Description rbd trash ls 휴지통 이미지 구조체
+type ImageTrash struct {
+	Pool      string `json:"pool"`
+	Id        string `json:"id"`
+	Name      string `json:"name"`
+	Source    string `json:"source"`
+	DeletedAt string `json:"deleted_at"`
+	// expired at ..., protected until ...
+	Status string `json:"status"`
+} //@name ImageTrash
+
+type ImageTrashList []ImageTrash //@name ImageTrashList
+
+type TrashPurgeScheduleItem struct {
+	Interval  string `json:"interval"`
+	StartTime string `json:"start_time"`
+} //@name TrashPurgeScheduleItem
+
+// TrashPurgeSchedule model info
+// @Description rbd trash purge schedule 휴지통 자동 비우기 일정 구조체
+type TrashPurgeSchedule struct {
+	Pool      string                   `json:"pool"`
+	Namespace string                   `json:"namespace"`
+	Items     []TrashPurgeScheduleItem `json:"items"`
+} //@name TrashPurgeSchedule
+
+type ImageTrashDeferment struct {
+	Pool    string `json:"pool"`
+	Seconds int    `json:"seconds"`
+} //@name ImageTrashDeferment
+
+// ImageQosConfig model info
+// @Description rbd QoS 설정 구조체. source 는 값이 적용된 단계(config: 전역/기본값, pool: 풀, image: 이미지)입니다.
+type ImageQosConfig struct {
+	Name   string `json:"name"`
+	Value  string `json:"value"`
+	Source string `json:"source"`
+} //@name ImageQosConfig
+
+// ImageQos model info
+// @Description 풀 또는 이미지에 실제로 적용되는 rbd QoS 설정 구조체
+type ImageQos struct {
+	Pool    string           `json:"pool"`
+	Image   string           `json:"image,omitempty"`
+	Configs []ImageQosConfig `json:"configs"`
+} //@name ImageQos
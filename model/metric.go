@@ -0,0 +1,107 @@
+package model
+
+// MetricSample model info
+// @Description Glue 클러스터 상태 수집 샘플 구조체
+type MetricSample struct {
+	Timestamp int64         `json:"timestamp"`
+	Cluster   ClusterMetric `json:"cluster"`
+	Pools     []PoolMetric  `json:"pools"`
+} //@name MetricSample
+
+type ClusterMetric struct {
+	Health        string `json:"health"`
+	DataBytes     int64  `json:"data_bytes"`
+	BytesUsed     int64  `json:"bytes_used"`
+	BytesAvail    int64  `json:"bytes_avail"`
+	BytesTotal    int64  `json:"bytes_total"`
+	ReadBytesSec  int    `json:"read_bytes_sec"`
+	WriteBytesSec int    `json:"write_bytes_sec"`
+	ReadOpPerSec  int    `json:"read_op_per_sec"`
+	WriteOpPerSec int    `json:"write_op_per_sec"`
+	NumOsds       int    `json:"num_osds"`
+	NumUpOsds     int    `json:"num_up_osds"`
+	NumInOsds     int    `json:"num_in_osds"`
+} //@name ClusterMetric
+
+type PoolMetric struct {
+	Name        string  `json:"name"`
+	Stored      int64   `json:"stored"`
+	BytesUsed   int64   `json:"bytes_used"`
+	MaxAvail    int64   `json:"max_avail"`
+	PercentUsed float64 `json:"percent_used"`
+	Objects     int64   `json:"objects"`
+} //@name PoolMetric
+
+// CephDf model info
+// @Description ceph df 결과 구조체
+type CephDf struct {
+	Stats struct {
+		TotalBytes      int64 `json:"total_bytes"`
+		TotalAvailBytes int64 `json:"total_avail_bytes"`
+		TotalUsedBytes  int64 `json:"total_used_bytes"`
+	} `json:"stats"`
+	Pools []struct {
+		Name  string `json:"name"`
+		Id    int    `json:"id"`
+		Stats struct {
+			Stored      int64   `json:"stored"`
+			Objects     int64   `json:"objects"`
+			BytesUsed   int64   `json:"bytes_used"`
+			PercentUsed float64 `json:"percent_used"`
+			MaxAvail    int64   `json:"max_avail"`
+		} `json:"stats"`
+	} `json:"pools"`
+} //@name CephDf
+
+type MetricPoint struct {
+	Timestamp int64   `json:"timestamp"`
+	Value     float64 `json:"value"`
+} //@name MetricPoint
+
+// MetricSeries model info
+// @Description Glue 상태 지표의 시계열 구조체
+type MetricSeries struct {
+	Metric string        `json:"metric"`
+	Pool   string        `json:"pool,omitempty"`
+	Start  int64         `json:"start"`
+	End    int64         `json:"end"`
+	Step   int64         `json:"step"`
+	Points []MetricPoint `json:"points"`
+} //@name MetricSeries
+
+type OsdRatio struct {
+	FullRatio         float64 `json:"full_ratio"`
+	BackfillfullRatio float64 `json:"backfillfull_ratio"`
+	NearfullRatio     float64 `json:"nearfull_ratio"`
+} //@name OsdRatio
+
+type ForecastEta struct {
+	GrowthPerDay   float64 `json:"growth_per_day"`
+	NearfullAt     string  `json:"nearfull_at"`
+	FullAt         string  `json:"full_at"`
+	DaysToNearfull float64 `json:"days_to_nearfull"`
+	DaysToFull     float64 `json:"days_to_full"`
+} //@name ForecastEta
+
+type ForecastItem struct {
+	Name          string      `json:"name"`
+	Size          int         `json:"size"`
+	Overhead      float64     `json:"overhead"` // 복제 풀은 size, EC 풀은 (k+m)/k
+	Used          float64     `json:"used"`
+	NearfullBytes float64     `json:"nearfull_bytes"`
+	FullBytes     float64     `json:"full_bytes"`
+	Linear        ForecastEta `json:"linear"`
+	Seasonal      ForecastEta `json:"seasonal"`
+	Note          string      `json:"note"`
+} //@name ForecastItem
+
+// CapacityForecast model info
+// @Description Glue 클러스터 및 풀 용량 예측 구조체
+type CapacityForecast struct {
+	HistoryStart  int64          `json:"history_start"`
+	HistoryEnd    int64          `json:"history_end"`
+	NearfullRatio float64        `json:"nearfull_ratio"`
+	FullRatio     float64        `json:"full_ratio"`
+	Cluster       ForecastItem   `json:"cluster"`
+	Pools         []ForecastItem `json:"pools"`
+} //@name CapacityForecast
@@ -38,8 +38,18 @@ type NfsExportCreate struct {
 	SecurityLabel bool     `json:"security_label"`
 	Squash        string   `json:"squash"`
 	Transports    []string `json:"transports"`
+	// 비어 있으면 보내지 않습니다.
+	Clients []NfsExportClient `json:"clients,omitempty"`
 } //@name NfsExportCreate
 
+// NfsExportClient model info
+// @Description Glue NFS Export 클라이언트별 접근 설정 구조체
+type NfsExportClient struct {
+	Addresses  []string `yaml:"addresses" json:"addresses"`
+	AccessType string   `yaml:"access_type" json:"access_type"`
+	Squash     string   `yaml:"squash" json:"squash"`
+} //@name NfsExportClient
+
 type NfsFsal struct {
 	Name          string `json:"name"`
 	FsName        string `json:"fs_name"`
@@ -56,6 +66,8 @@ type NfsExportRgwCreate struct {
 	Pseudo     string   `json:"pseudo"`
 	Squash     string   `json:"squash"`
 	Transports []string `json:"transports"`
+	// 비어 있으면 보내지 않습니다.
+	Clients []NfsExportClient `json:"clients,omitempty"`
 } //@name NfsExportCreate
 
 type RgwFsal struct {
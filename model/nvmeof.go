@@ -39,6 +39,16 @@ type NvmeOfNameSpaceList struct {
 	Status int `json:"status"`
 } //@name NvmeOfNameSpaceList
 
+type NvmeOfHostList struct {
+	ErrorMessage string `json:"error_message"`
+	SubsystemNqn string `json:"subsystem_nqn"`
+	AllowAnyHost bool   `json:"allow_any_host"`
+	Hosts        []struct {
+		Nqn string `json:"nqn"`
+	} `json:"hosts"`
+	Status int `json:"status"`
+} //@name NvmeOfHostList
+
 type NvmeOfTargetVerify struct {
 	Genctr  int `json:"genctr"`
 	Records []struct {
@@ -158,3 +168,10 @@ type NvmeOfTarget []struct {
 	} `json:"namespaces"`
 	Session int `json:"session"`
 } //@name NvmeOfTarget
+
+// NvmeOfImage model info
+// @Description NVMe-OF CLI 및 게이트웨이 이미지 구조체
+type NvmeOfImage struct {
+	CliImage     string `json:"cli_image"`
+	GatewayImage string `json:"gateway_image,omitempty"`
+} //@name NvmeOfImage
@@ -0,0 +1,114 @@
+package model
+
+// OsdDf model info
+// @Description ceph osd df 결과 구조체
+type OsdDf struct {
+	Nodes []struct {
+		Id          int     `json:"id"`
+		Name        string  `json:"name"`
+		DeviceClass string  `json:"device_class"`
+		CrushWeight float64 `json:"crush_weight"`
+		Reweight    float64 `json:"reweight"`
+		Kb          int64   `json:"kb"`
+		KbUsed      int64   `json:"kb_used"`
+		KbAvail     int64   `json:"kb_avail"`
+		Utilization float64 `json:"utilization"`
+		Pgs         int     `json:"pgs"`
+		Status      string  `json:"status"`
+	} `json:"nodes"`
+} //@name OsdDf
+
+// OsdMetadata model info
+// @Description ceph osd metadata 결과 구조체
+type OsdMetadata []struct {
+	Id             int    `json:"id"`
+	Hostname       string `json:"hostname"`
+	Devices        string `json:"devices"`
+	OsdObjectstore string `json:"osd_objectstore"`
+	CephVersion    string `json:"ceph_version_short"`
+} //@name OsdMetadata
+
+// Osd model info
+// @Description Glue OSD 구조체
+type Osd struct {
+	Id          int    `json:"id"`
+	Name        string `json:"name"`
+	Host        string `json:"host"`
+	Devices     string `json:"devices"`
+	DeviceClass string `json:"device_class"`
+	// up, down, destroyed
+	Status      string  `json:"status"`
+	In          bool    `json:"in"`
+	CrushWeight float64 `json:"crush_weight"`
+	Reweight    float64 `json:"reweight"`
+	TotalBytes  int64   `json:"total_bytes"`
+	UsedBytes   int64   `json:"used_bytes"`
+	AvailBytes  int64   `json:"avail_bytes"`
+	Utilization float64 `json:"utilization"`
+	Pgs         int     `json:"pgs"`
+	Version     string  `json:"version"`
+} //@name Osd
+
+// OsdSafety model info
+// @Description Glue OSD 중지 및 제거 안전성 구조체
+type OsdSafety struct {
+	OsdId                int    `json:"osd_id"`
+	OkToStop             bool   `json:"ok_to_stop"`
+	OkToStopMessage      string `json:"ok_to_stop_message"`
+	SafeToDestroy        bool   `json:"safe_to_destroy"`
+	SafeToDestroyMessage string `json:"safe_to_destroy_message"`
+} //@name OsdSafety
+
+// OsdRmStatus model info
+// @Description Glue OSD 제거 진행 상태 구조체
+type OsdRmStatus struct {
+	OsdId            int    `json:"osd_id"`
+	Hostname         string `json:"hostname"`
+	Started          bool   `json:"started"`
+	Draining         bool   `json:"draining"`
+	Stopped          bool   `json:"stopped"`
+	Replace          bool   `json:"replace"`
+	Force            bool   `json:"force"`
+	Zap              bool   `json:"zap"`
+	DrainStartedAt   string `json:"drain_started_at"`
+	DrainDoneAt      string `json:"drain_done_at"`
+	ProcessStartedAt string `json:"process_started_at"`
+	// 비워야 할 남은 PG 수입니다. ceph osd df 에서 채웁니다.
+	Pgs int `json:"pgs"`
+} //@name OsdRmStatus
+
+// OsdFlag model info
+// @Description Glue 클러스터 OSD 플래그 구조체
+type OsdFlag struct {
+	Flag    string `json:"flag"`
+	Enabled bool   `json:"enabled"`
+} //@name OsdFlag
+
+type OsdDeviceSelection struct {
+	Paths []string `yaml:"paths,omitempty"`
+	// 1 은 HDD, 0 은 SSD 및 NVMe 입니다.
+	Rotational *int `yaml:"rotational,omitempty"`
+	All        bool `yaml:"all,omitempty"`
+} //@name OsdDeviceSelection
+
+type OsdPlacement struct {
+	Hosts []string `yaml:"hosts,omitempty"`
+	Label string   `yaml:"label,omitempty"`
+} //@name OsdPlacement
+
+type OsdSpecDetail struct {
+	DataDevices   OsdDeviceSelection  `yaml:"data_devices"`
+	DbDevices     *OsdDeviceSelection `yaml:"db_devices,omitempty"`
+	WalDevices    *OsdDeviceSelection `yaml:"wal_devices,omitempty"`
+	Encrypted     bool                `yaml:"encrypted,omitempty"`
+	OsdsPerDevice int                 `yaml:"osds_per_device,omitempty"`
+} //@name OsdSpecDetail
+
+// OsdSpec model info
+// @Description ceph orch apply OSD 서비스 명세 구조체
+type OsdSpec struct {
+	ServiceType string        `yaml:"service_type"`
+	ServiceId   string        `yaml:"service_id"`
+	Placement   OsdPlacement  `yaml:"placement"`
+	Spec        OsdSpecDetail `yaml:"spec"`
+} //@name OsdSpec
@@ -0,0 +1,49 @@
+package model
+
+// Pg model info
+// @Description Glue 배치 그룹(PG) 구조체
+type Pg struct {
+	PgId               string   `json:"pgid"`
+	Pool               string   `json:"pool"`
+	State              string   `json:"state"`
+	Up                 []int    `json:"up"`
+	Acting             []int    `json:"acting"`
+	UpPrimary          int      `json:"up_primary"`
+	ActingPrimary      int      `json:"acting_primary"`
+	Objects            int64    `json:"objects"`
+	ObjectsDegraded    int64    `json:"objects_degraded"`
+	ObjectsMisplaced   int64    `json:"objects_misplaced"`
+	ObjectsUnfound     int64    `json:"objects_unfound"`
+	LastScrubStamp     string   `json:"last_scrub_stamp"`
+	LastDeepScrubStamp string   `json:"last_deep_scrub_stamp"`
+	Stuck              []string `json:"stuck,omitempty"`
+} //@name Pg
+
+type PgList []Pg //@name PgList
+
+type PgShard struct {
+	Osd     int      `json:"osd"`
+	Primary bool     `json:"primary"`
+	Errors  []string `json:"errors"`
+	Size    int64    `json:"size"`
+} //@name PgShard
+
+type PgInconsistentObject struct {
+	Object struct {
+		Name    string      `json:"name"`
+		Nspace  string      `json:"nspace"`
+		Locator string      `json:"locator"`
+		Snap    interface{} `json:"snap"`
+		Version int64       `json:"version"`
+	} `json:"object"`
+	Errors           []string  `json:"errors"`
+	UnionShardErrors []string  `json:"union_shard_errors"`
+	Shards           []PgShard `json:"shards"`
+} //@name PgInconsistentObject
+
+// PgInconsistent model info
+// @Description rados list-inconsistent-obj 불일치 객체 구조체
+type PgInconsistent struct {
+	Epoch         int                    `json:"epoch"`
+	Inconsistents []PgInconsistentObject `json:"inconsistents"`
+} //@name PgInconsistent
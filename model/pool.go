@@ -0,0 +1,110 @@
+package model
+
+// PoolDetail model info
+// @Description Glue 스토리지 풀 상세정보 구조체
+type PoolDetail []struct {
+	PoolId              int                    `json:"pool_id"`
+	PoolName            string                 `json:"pool_name"`
+	Type                int                    `json:"type"`
+	Size                int                    `json:"size"`
+	MinSize             int                    `json:"min_size"`
+	CrushRule           int                    `json:"crush_rule"`
+	PgNum               int                    `json:"pg_num"`
+	PgAutoscaleMode     string                 `json:"pg_autoscale_mode"`
+	ErasureCodeProfile  string                 `json:"erasure_code_profile"`
+	QuotaMaxBytes       int64                  `json:"quota_max_bytes"`
+	QuotaMaxObjects     int64                  `json:"quota_max_objects"`
+	FlagsNames          string                 `json:"flags_names"`
+	ApplicationMetadata map[string]interface{} `json:"application_metadata"`
+	Options             map[string]interface{} `json:"options"`
+} //@name PoolDetail
+
+// PoolSpec model info
+// @Description Glue 스토리지 풀 생성 정보 구조체
+type PoolSpec struct {
+	Name string `json:"name"`
+	// replicated, erasure
+	Type               string `json:"type"`
+	PgNum              int    `json:"pg_num"`
+	PgAutoscaleMode    string `json:"pg_autoscale_mode"`
+	CrushRule          string `json:"crush_rule"`
+	ErasureCodeProfile string `json:"erasure_code_profile"`
+	// rbd, cephfs, rgw
+	Application string `json:"application"`
+	Size        int    `json:"size"`
+	MinSize     int    `json:"min_size"`
+} //@name PoolSpec
+
+// PoolDeleteConfirm model info
+// @Description Glue 스토리지 풀 삭제 확인 구조체
+type PoolDeleteConfirm struct {
+	PoolName     string   `json:"pool_name"`
+	Cluster      string   `json:"cluster"`
+	Force        bool     `json:"force"`
+	RbdImages    int      `json:"rbd_images"`
+	TrashImages  int      `json:"trash_images"`
+	MirrorImages int      `json:"mirror_images"`
+	FsNames      []string `json:"fs_names"`
+	RgwBuckets   int      `json:"rgw_buckets"`
+	// 두 번째 삭제 요청에 confirm_token 으로 전달해야 합니다.
+	ConfirmToken string `json:"confirm_token"`
+	ExpiresAt    string `json:"expires_at"`
+} //@name PoolDeleteConfirm
+
+// PoolIoStats model info
+// @Description Glue 스토리지 풀 클라이언트 I/O 구조체
+type PoolIoStats []struct {
+	PoolName     string `json:"pool_name"`
+	PoolId       int    `json:"pool_id"`
+	ClientIoRate struct {
+		ReadBytesSec  int64 `json:"read_bytes_sec"`
+		WriteBytesSec int64 `json:"write_bytes_sec"`
+		ReadOpPerSec  int64 `json:"read_op_per_sec"`
+		WriteOpPerSec int64 `json:"write_op_per_sec"`
+	} `json:"client_io_rate"`
+} //@name PoolIoStats
+
+// PoolAutoscaleStatus model info
+// @Description Glue 스토리지 풀 PG 자동 조정 상태 구조체
+type PoolAutoscaleStatus []struct {
+	PoolName        string  `json:"pool_name"`
+	PgAutoscaleMode string  `json:"pg_autoscale_mode"`
+	PgNumTarget     int     `json:"pg_num_target"`
+	PgNumFinal      int     `json:"pg_num_final"`
+	WouldAdjust     bool    `json:"would_adjust"`
+	TargetRatio     float64 `json:"target_ratio"`
+	CapacityRatio   float64 `json:"capacity_ratio"`
+} //@name PoolAutoscaleStatus
+
+// PoolStats model info
+// @Description Glue 스토리지 풀 사용량 및 I/O 통계 구조체
+type PoolStats struct {
+	PoolName string `json:"pool_name"`
+	PoolId   int    `json:"pool_id"`
+	// replicated, erasure
+	Type               string   `json:"type"`
+	Size               int      `json:"size"`
+	MinSize            int      `json:"min_size"`
+	ErasureCodeProfile string   `json:"erasure_code_profile"`
+	CrushRule          int      `json:"crush_rule"`
+	Applications       []string `json:"applications"`
+	Stored             int64    `json:"stored"`
+	BytesUsed          int64    `json:"bytes_used"`
+	MaxAvail           int64    `json:"max_avail"`
+	PercentUsed        float64  `json:"percent_used"`
+	Objects            int64    `json:"objects"`
+	QuotaMaxBytes      int64    `json:"quota_max_bytes"`
+	QuotaMaxObjects    int64    `json:"quota_max_objects"`
+	// 할당량이 없으면 0 입니다.
+	QuotaBytesPercent   float64 `json:"quota_bytes_percent"`
+	QuotaObjectsPercent float64 `json:"quota_objects_percent"`
+	PgNum               int     `json:"pg_num"`
+	PgAutoscaleMode     string  `json:"pg_autoscale_mode"`
+	// PG 자동 조정이 권장하는 PG 수입니다.
+	PgNumRecommended int   `json:"pg_num_recommended"`
+	PgWouldAdjust    bool  `json:"pg_would_adjust"`
+	ReadBytesSec     int64 `json:"read_bytes_sec"`
+	WriteBytesSec    int64 `json:"write_bytes_sec"`
+	ReadOpPerSec     int64 `json:"read_op_per_sec"`
+	WriteOpPerSec    int64 `json:"write_op_per_sec"`
+} //@name PoolStats
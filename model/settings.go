@@ -12,4 +12,6 @@ type Settings struct {
 	GluePort string `json:"glue_port"`
 	GlueUser string `json:"glue_user"`
 	GluePw string `json:"glue_pw"`
+	MetricInterval string `json:"metric_interval"`
+	MetricRetention string `json:"metric_retention"`
 }
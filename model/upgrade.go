@@ -0,0 +1,74 @@
+package model
+
+// UpgradeVersions model info
+// @Description ceph orch upgrade ls 업그레이드 가능 버전 구조체
+type UpgradeVersions struct {
+	Image     string   `json:"image"`
+	Registry  string   `json:"registry"`
+	BareImage string   `json:"bare_image"`
+	Versions  []string `json:"versions"`
+} //@name UpgradeVersions
+
+type UpgradeCheckDaemon struct {
+	CurrentName    string `json:"current_name"`
+	CurrentVersion string `json:"current_version"`
+	CurrentId      string `json:"current_id"`
+} //@name UpgradeCheckDaemon
+
+// UpgradeCheck model info
+// @Description ceph orch upgrade check 대상 이미지 점검 구조체
+type UpgradeCheck struct {
+	TargetName          string                        `json:"target_name"`
+	TargetId            string                        `json:"target_id"`
+	TargetVersion       string                        `json:"target_version"`
+	TargetDigest        interface{}                   `json:"target_digest"`
+	NeedsUpdate         map[string]UpgradeCheckDaemon `json:"needs_update"`
+	UpToDate            []string                      `json:"up_to_date"`
+	NonCephImageDaemons []string                      `json:"non_ceph_image_daemons"`
+} //@name UpgradeCheck
+
+// UpgradePreflight model info
+// @Description Glue 업그레이드 사전 점검 결과 구조체
+type UpgradePreflight struct {
+	Ready    bool         `json:"ready"`
+	Blockers []string     `json:"blockers"`
+	Check    UpgradeCheck `json:"check"`
+} //@name UpgradePreflight
+
+// UpgradeStatus model info
+// @Description ceph orch upgrade status 업그레이드 진행 상태 구조체
+type UpgradeStatus struct {
+	TargetImage      string   `json:"target_image"`
+	InProgress       bool     `json:"in_progress"`
+	Which            string   `json:"which"`
+	ServicesComplete []string `json:"services_complete"`
+	Progress         string   `json:"progress"`
+	Message          string   `json:"message"`
+	IsPaused         bool     `json:"is_paused"`
+} //@name UpgradeStatus
+
+type UpgradeDaemonProgress struct {
+	DaemonType string         `json:"daemon_type"`
+	Total      int            `json:"total"`
+	Upgraded   int            `json:"upgraded"`
+	Versions   map[string]int `json:"versions"`
+} //@name UpgradeDaemonProgress
+
+// UpgradeProgress model info
+// @Description Glue 업그레이드 데몬 종류별 진행률 구조체
+type UpgradeProgress struct {
+	TargetVersion string                  `json:"target_version"`
+	StartedAt     string                  `json:"started_at"`
+	Status        UpgradeStatus           `json:"status"`
+	Total         int                     `json:"total"`
+	Upgraded      int                     `json:"upgraded"`
+	Daemons       []UpgradeDaemonProgress `json:"daemons"`
+} //@name UpgradeProgress
+
+// UpgradeTarget model info
+// @Description 진행 중인 업그레이드의 대상 버전 구조체
+type UpgradeTarget struct {
+	Image     string `json:"image"`
+	Version   string `json:"version"`
+	StartedAt string `json:"started_at"`
+} //@name UpgradeTarget
@@ -0,0 +1,410 @@
+package alert
+
+import (
+	"Glue-API/model"
+	"Glue-API/utils"
+	"Glue-API/utils/glue"
+	"Glue-API/utils/metric"
+	"Glue-API/utils/mirror"
+	"Glue-API/utils/smb"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var rule_conf = "./alert_rules.json"
+var silence_conf = "./alert_silences.json"
+
+// 해제된 경고는 이 기간 동안 목록에 남겨 둡니다.
+var resolved_retention = 24 * time.Hour
+
+var RuleTypes = []string{"health_check", "cluster_usage", "pool_usage", "mirror_image", "smb_host"}
+
+// lock 은 경고 상태와 함께 규칙 및 무음 설정 파일의 읽기-수정-쓰기를 보호합니다.
+var lock sync.Mutex
+var alerts = make(map[string]*model.Alert)
+
+func readJson(file string, dat interface{}) (err error) {
+	content, err := os.ReadFile(file)
+	if err != nil {
+		if os.IsNotExist(err) {
+			err = nil
+			return
+		}
+		utils.FancyHandleError(err)
+		return
+	}
+	if err = json.Unmarshal(content, dat); err != nil {
+		utils.FancyHandleError(err)
+		return
+	}
+	return
+}
+func writeJson(file string, dat interface{}) (err error) {
+	json_data, err := json.MarshalIndent(dat, "", " ")
+	if err != nil {
+		utils.FancyHandleError(err)
+		return
+	}
+	if err = os.WriteFile(file, json_data, 0600); err != nil {
+		utils.FancyHandleError(err)
+		return
+	}
+	return
+}
+
+func RuleList() (dat model.AlertRuleList, err error) {
+	dat = make(model.AlertRuleList, 0)
+	err = readJson(rule_conf, &dat)
+	return
+}
+func RuleAdd(rule model.AlertRule) (output string, err error) {
+	valid := false
+	for _, rule_type := range RuleTypes {
+		if rule.Type == rule_type {
+			valid = true
+		}
+	}
+	if rule.Name == "" || !valid {
+		err = errors.New("rule name and type(health_check, cluster_usage, pool_usage, mirror_image, smb_host) are required")
+		return
+	}
+	if rule.Severity != "warning" && rule.Severity != "critical" {
+		err = errors.New("severity must be warning or critical")
+		return
+	}
+	if (rule.Type == "health_check" || rule.Type == "pool_usage" || rule.Type == "mirror_image") && rule.Target == "" {
+		err = errors.New("target is required for " + rule.Type + " rule")
+		return
+	}
+	if (rule.Type == "cluster_usage" || rule.Type == "pool_usage") && (rule.Threshold <= 0 || rule.Threshold > 100) {
+		err = errors.New("threshold must be a percentage between 0 and 100")
+		return
+	}
+	lock.Lock()
+	defer lock.Unlock()
+	list, err := RuleList()
+	if err != nil {
+		return
+	}
+	for _, current := range list {
+		if current.Name == rule.Name {
+			err = errors.New("rule " + rule.Name + " already exists")
+			return
+		}
+	}
+	list = append(list, rule)
+	if err = writeJson(rule_conf, list); err != nil {
+		return
+	}
+	output = "Success"
+	return
+}
+func RuleDelete(rule_name string) (output string, err error) {
+	lock.Lock()
+	defer lock.Unlock()
+	list, err := RuleList()
+	if err != nil {
+		return
+	}
+	rules := make(model.AlertRuleList, 0)
+	for _, rule := range list {
+		if rule.Name != rule_name {
+			rules = append(rules, rule)
+		}
+	}
+	if len(rules) == len(list) {
+		err = errors.New("rule " + rule_name + " is not found")
+		return
+	}
+	if err = writeJson(rule_conf, rules); err != nil {
+		return
+	}
+	// 삭제된 규칙의 경고도 함께 제거합니다.
+	for id, dat := range alerts {
+		if dat.Rule == rule_name {
+			delete(alerts, id)
+		}
+	}
+	output = "Success"
+	return
+}
+
+func SilenceList() (dat model.AlertSilenceList, err error) {
+	dat = make(model.AlertSilenceList, 0)
+	err = readJson(silence_conf, &dat)
+	return
+}
+func SilenceAdd(silence model.AlertSilence) (output string, err error) {
+	if silence.Rule == "" {
+		err = errors.New("rule is required")
+		return
+	}
+	if _, err = time.Parse(time.RFC3339, silence.EndsAt); err != nil {
+		err = errors.New("ends_at must be RFC3339 time")
+		return
+	}
+	lock.Lock()
+	defer lock.Unlock()
+	list, err := SilenceList()
+	if err != nil {
+		return
+	}
+	silence.Id = uuid.New().String()
+	list = append(list, silence)
+	if err = writeJson(silence_conf, list); err != nil {
+		return
+	}
+	output = silence.Id
+	return
+}
+func SilenceDelete(silence_id string) (output string, err error) {
+	lock.Lock()
+	defer lock.Unlock()
+	list, err := SilenceList()
+	if err != nil {
+		return
+	}
+	silences := make(model.AlertSilenceList, 0)
+	for _, silence := range list {
+		if silence.Id != silence_id {
+			silences = append(silences, silence)
+		}
+	}
+	if len(silences) == len(list) {
+		err = errors.New("silence " + silence_id + " is not found")
+		return
+	}
+	if err = writeJson(silence_conf, silences); err != nil {
+		return
+	}
+	output = "Success"
+	return
+}
+
+func silenced(silences model.AlertSilenceList, dat *model.Alert, now time.Time) bool {
+	for _, silence := range silences {
+		ends_at, err := time.Parse(time.RFC3339, silence.EndsAt)
+		if err != nil || now.After(ends_at) {
+			continue
+		}
+		if silence.Rule == dat.Rule && (silence.Instance == "" || silence.Instance == dat.Instance) {
+			return true
+		}
+	}
+	return false
+}
+
+// List 는 현재 경고 목록을 반환합니다. state 가 비어 있으면 모든 상태를 반환합니다.
+func List(state string) (dat []model.Alert) {
+	lock.Lock()
+	defer lock.Unlock()
+
+	silences, _ := SilenceList()
+	now := time.Now()
+	dat = make([]model.Alert, 0)
+	for _, alert := range alerts {
+		alert.Silenced = silenced(silences, alert, now)
+		if state == "" || alert.State == state {
+			dat = append(dat, *alert)
+		}
+	}
+	sort.Slice(dat, func(i, j int) bool {
+		return dat[i].StartsAt > dat[j].StartsAt
+	})
+	return
+}
+
+// 규칙 조건을 만족하는 인스턴스와 메시지입니다.
+type condition map[string]string
+
+// 평가 중 한 번만 조회하도록 클러스터 상태를 보관합니다.
+type snapshot struct {
+	status      *model.GlueStatus
+	df          *model.CephDf
+	smb         []model.SmbNormalStatus
+	smb_fetched bool
+	mirror      map[string]model.MirrorList
+}
+
+func (s *snapshot) glueStatus() (dat *model.GlueStatus, err error) {
+	if s.status == nil {
+		status, err := glue.Status()
+		if err != nil {
+			return nil, err
+		}
+		s.status = &status
+	}
+	return s.status, nil
+}
+func (s *snapshot) cephDf() (dat *model.CephDf, err error) {
+	if s.df == nil {
+		df, err := metric.Df(context.Background())
+		if err != nil {
+			return nil, err
+		}
+		s.df = &df
+	}
+	return s.df, nil
+}
+
+func evaluate(rule model.AlertRule, s *snapshot) (dat condition, err error) {
+	dat = make(condition)
+	switch rule.Type {
+	case "health_check":
+		status, err := s.glueStatus()
+		if err != nil {
+			return dat, err
+		}
+		for code, check := range status.Health.Checks {
+			if check.Muted || (rule.Target != "*" && rule.Target != code) {
+				continue
+			}
+			dat[code] = check.Summary.Message
+		}
+	case "cluster_usage":
+		status, err := s.glueStatus()
+		if err != nil {
+			return dat, err
+		}
+		if status.Pgmap.BytesTotal == 0 {
+			return dat, nil
+		}
+		usage := float64(status.Pgmap.BytesUsed) / float64(status.Pgmap.BytesTotal) * 100
+		if usage >= rule.Threshold {
+			dat["cluster"] = "cluster usage " + strconv.FormatFloat(usage, 'f', 1, 64) + "% is above " + strconv.FormatFloat(rule.Threshold, 'f', 1, 64) + "%"
+		}
+	case "pool_usage":
+		df, err := s.cephDf()
+		if err != nil {
+			return dat, err
+		}
+		for _, pool := range df.Pools {
+			if rule.Target != "*" && rule.Target != pool.Name {
+				continue
+			}
+			usage := pool.Stats.PercentUsed * 100
+			if usage >= rule.Threshold {
+				dat[pool.Name] = "pool " + pool.Name + " usage " + strconv.FormatFloat(usage, 'f', 1, 64) + "% is above " + strconv.FormatFloat(rule.Threshold, 'f', 1, 64) + "%"
+			}
+		}
+	case "mirror_image":
+		if s.mirror == nil {
+			s.mirror = make(map[string]model.MirrorList)
+		}
+		if _, ok := s.mirror[rule.Target]; !ok {
+			list, err := mirror.ImageList(context.Background(), rule.Target)
+			if err != nil {
+				return dat, err
+			}
+			s.mirror[rule.Target] = list
+		}
+		// Primary 이미지는 up+stopped, Secondary 이미지는 up+replaying 이 정상 상태입니다.
+		for _, image := range s.mirror[rule.Target].Images {
+			if image.State != "up+replaying" && image.State != "up+stopped" {
+				dat[rule.Target+"/"+image.Name] = "mirror image " + image.Name + " is " + image.State + ": " + image.Description
+			}
+		}
+	case "smb_host":
+		if !s.smb_fetched {
+			if s.smb, err = smb.StatusList(); err != nil {
+				return
+			}
+			s.smb_fetched = true
+		}
+		for _, host := range s.smb {
+			if host.Status == "Error" {
+				dat[host.IpAddress] = "smb host " + host.Hostname + " is in Error state: " + host.State
+			}
+		}
+	}
+	return
+}
+
+// Evaluate 는 모든 규칙을 평가하여 경고의 pending, firing, resolved 상태를 갱신합니다.
+func Evaluate() (err error) {
+	rules, err := RuleList()
+	if err != nil || len(rules) == 0 {
+		return
+	}
+	var s snapshot
+	results := make(map[string]condition)
+	for _, rule := range rules {
+		// 조회에 실패한 규칙은 이전 상태를 유지합니다.
+		if results[rule.Name], err = evaluate(rule, &s); err != nil {
+			delete(results, rule.Name)
+		}
+	}
+
+	lock.Lock()
+	defer lock.Unlock()
+	now := time.Now()
+	for _, rule := range rules {
+		result, ok := results[rule.Name]
+		if !ok {
+			continue
+		}
+		for instance, message := range result {
+			id := rule.Name + "/" + instance
+			dat, exist := alerts[id]
+			if !exist || dat.State == "resolved" {
+				dat = &model.Alert{
+					Id:       id,
+					Rule:     rule.Name,
+					Instance: instance,
+					Severity: rule.Severity,
+					State:    "pending",
+					StartsAt: now.Format(time.RFC3339),
+				}
+				alerts[id] = dat
+			}
+			dat.Message = message
+			starts_at, _ := time.Parse(time.RFC3339, dat.StartsAt)
+			if dat.State == "pending" && now.Sub(starts_at) >= time.Duration(rule.Duration)*time.Second {
+				dat.State = "firing"
+				dat.FiringAt = now.Format(time.RFC3339)
+			}
+		}
+		for id, dat := range alerts {
+			if dat.Rule != rule.Name || dat.State == "resolved" {
+				continue
+			}
+			if _, ok := result[dat.Instance]; ok {
+				continue
+			}
+			if dat.State == "pending" {
+				delete(alerts, id)
+				continue
+			}
+			dat.State = "resolved"
+			dat.ResolvedAt = now.Format(time.RFC3339)
+		}
+	}
+	for id, dat := range alerts {
+		resolved_at, err := time.Parse(time.RFC3339, dat.ResolvedAt)
+		if dat.State == "resolved" && err == nil && now.Sub(resolved_at) > resolved_retention {
+			delete(alerts, id)
+		}
+	}
+	return nil
+}
+
+// Watch 는 상태 수집 주기와 같은 주기로 경고 규칙을 평가하는 백그라운드 작업입니다.
+func Watch(settings model.Settings) {
+	interval := 60 * time.Second
+	if seconds, err := strconv.Atoi(settings.MetricInterval); err == nil && seconds > 0 {
+		interval = time.Duration(seconds) * time.Second
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for ; true; <-ticker.C {
+		Evaluate()
+	}
+}
@@ -0,0 +1,335 @@
+package backup
+
+import (
+	"Glue-API/model"
+	"Glue-API/utils"
+	"archive/tar"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"os/exec"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// 백업 번들의 포맷이 바뀌면 Version 을 올리고 Read 에서 이전 버전을 변환합니다.
+var Version = 1
+
+// 백업 대상 게이트웨이 서비스 타입입니다. mds 스펙은 파일 시스템 복원 시 배치 호스트로만 사용합니다.
+var ServiceTypes = []string{"mds", "nfs", "ingress", "rgw", "iscsi", "nvmeof"}
+
+func Fsid() (output string, err error) {
+	var stdout []byte
+	cmd := exec.Command("ceph", "fsid", "-f", "json")
+	stdout, err = cmd.CombinedOutput()
+	if err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	var dat model.ClusterFsid
+	if err = json.Unmarshal(stdout, &dat); err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	output = dat.Fsid
+	return
+}
+func ServiceSpec(service_type string) (output string, err error) {
+	var stdout []byte
+	cmd := exec.Command("ceph", "orch", "ls", "--service_type", service_type, "--export")
+	stdout, err = cmd.CombinedOutput()
+	if err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	if strings.Contains(string(stdout), "No services reported") {
+		return
+	}
+	output = string(stdout)
+	return
+}
+func ServiceNames() (output []string, err error) {
+	var stdout []byte
+	cmd := exec.Command("ceph", "orch", "ls", "-f", "json")
+	stdout, err = cmd.CombinedOutput()
+	if err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	var dat []struct {
+		ServiceName string `json:"service_name"`
+	}
+	if len(stdout) > 4 {
+		if err = json.Unmarshal(stdout, &dat); err != nil {
+			err_str := strings.ReplaceAll(string(stdout), "\n", "")
+			err = errors.New(err_str)
+			utils.FancyHandleError(err)
+			return
+		}
+	}
+	for i := 0; i < len(dat); i++ {
+		output = append(output, dat[i].ServiceName)
+	}
+	return
+}
+func ServiceApply(yaml_file string) (output string, err error) {
+	var stdout []byte
+	cmd := exec.Command("ceph", "orch", "apply", "-i", yaml_file)
+	stdout, err = cmd.CombinedOutput()
+	if err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	output = "Success"
+	return
+}
+
+// ceph nfs export apply 에 그대로 사용할 수 있도록 Export 정보를 원본 JSON 으로 반환합니다.
+func NfsExports(cluster_id string) (dat []json.RawMessage, err error) {
+	var stdout []byte
+	cmd := exec.Command("ceph", "nfs", "export", "ls", cluster_id, "--detailed")
+	stdout, err = cmd.CombinedOutput()
+	if err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	if err = json.Unmarshal(stdout, &dat); err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	return
+}
+func MirrorPoolInfo(pool_name string) (dat model.MirrorConf, err error) {
+	var stdout []byte
+	cmd := exec.Command("rbd", "mirror", "pool", "info", "--pool", pool_name, "--all", "--format", "json")
+	stdout, err = cmd.CombinedOutput()
+	if err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	if err = json.Unmarshal(stdout, &dat); err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	return
+}
+func MirrorSchedules(pool_name string) (dat []model.MirrorImage, err error) {
+	var stdout []byte
+	cmd := exec.Command("rbd", "mirror", "snapshot", "schedule", "ls", "--pool", pool_name, "--recursive", "--format", "json")
+	stdout, err = cmd.CombinedOutput()
+	if err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	if err = json.Unmarshal(stdout, &dat); err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	return
+}
+func MirrorPoolEnable(pool_name string, mode string, site_name string) (output string, err error) {
+	var stdout []byte
+	cmd := exec.Command("rbd", "mirror", "pool", "enable", pool_name, mode, "--site-name", site_name)
+	stdout, err = cmd.CombinedOutput()
+	if err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	output = "Success"
+	return
+}
+func MirrorPeerAdd(pool_name string, client_name string, site_name string, mon_host string, key string) (output string, err error) {
+	key_file, err := os.CreateTemp(os.TempDir(), "Glue-Key-")
+	if err != nil {
+		utils.FancyHandleError(err)
+		return
+	}
+	defer os.Remove(key_file.Name())
+	if _, err = key_file.WriteString(key); err != nil {
+		utils.FancyHandleError(err)
+		return
+	}
+	key_file.Close()
+
+	var stdout []byte
+	cmd := exec.Command("rbd", "mirror", "pool", "peer", "add", pool_name, client_name+"@"+site_name, "--remote-mon-host", mon_host, "--remote-key-file", key_file.Name())
+	stdout, err = cmd.CombinedOutput()
+	if err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	output = "Success"
+	return
+}
+
+func writeFile(tw *tar.Writer, name string, data []byte) (err error) {
+	header := &tar.Header{
+		Name:    name,
+		Mode:    0600,
+		Size:    int64(len(data)),
+		ModTime: time.Now(),
+	}
+	if err = tw.WriteHeader(header); err != nil {
+		utils.FancyHandleError(err)
+		return
+	}
+	if _, err = tw.Write(data); err != nil {
+		utils.FancyHandleError(err)
+		return
+	}
+	return
+}
+func writeJson(tw *tar.Writer, name string, dat interface{}) (err error) {
+	json_data, err := json.MarshalIndent(dat, "", " ")
+	if err != nil {
+		utils.FancyHandleError(err)
+		return
+	}
+	return writeFile(tw, name, json_data)
+}
+
+// Write 는 백업 내용을 manifest.json 과 섹션별 파일로 구성된 tar.gz 번들로 기록합니다.
+func Write(w io.Writer, dat model.Backup) (err error) {
+	gw := gzip.NewWriter(w)
+	tw := tar.NewWriter(gw)
+
+	if err = writeJson(tw, "manifest.json", dat.Manifest); err != nil {
+		return
+	}
+	for service_type, spec := range dat.ServiceSpecs {
+		if err = writeFile(tw, "service_specs/"+service_type+".yaml", []byte(spec)); err != nil {
+			return
+		}
+	}
+	sections := map[string]interface{}{
+		"fs.json":          dat.Fs,
+		"nfs_exports.json": dat.NfsExports,
+		"rgw_users.json":   dat.RgwUsers,
+		"iscsi.json": map[string]interface{}{
+			"discovery_auth": dat.IscsiAuth,
+			"targets":        dat.IscsiTargets,
+		},
+		"nvmeof.json": dat.NvmeOf,
+		"smb.json":    dat.Smb,
+		"mirror.json": dat.MirrorPools,
+	}
+	for name, section := range sections {
+		if err = writeJson(tw, name, section); err != nil {
+			return
+		}
+	}
+	if err = tw.Close(); err != nil {
+		utils.FancyHandleError(err)
+		return
+	}
+	if err = gw.Close(); err != nil {
+		utils.FancyHandleError(err)
+		return
+	}
+	return
+}
+
+// Read 는 Write 로 만든 번들을 읽어 백업 내용을 반환합니다.
+func Read(r io.Reader) (dat model.Backup, err error) {
+	gr, err := gzip.NewReader(r)
+	if err != nil {
+		utils.FancyHandleError(err)
+		return
+	}
+	defer gr.Close()
+	tr := tar.NewReader(gr)
+
+	dat.ServiceSpecs = make(map[string]string)
+	var manifest_found bool
+	for {
+		var header *tar.Header
+		header, err = tr.Next()
+		if err == io.EOF {
+			err = nil
+			break
+		}
+		if err != nil {
+			utils.FancyHandleError(err)
+			return
+		}
+		var content []byte
+		if content, err = io.ReadAll(tr); err != nil {
+			utils.FancyHandleError(err)
+			return
+		}
+		switch {
+		case header.Name == "manifest.json":
+			err = json.Unmarshal(content, &dat.Manifest)
+			manifest_found = true
+		case strings.HasPrefix(header.Name, "service_specs/"):
+			service_type := strings.TrimSuffix(path.Base(header.Name), ".yaml")
+			dat.ServiceSpecs[service_type] = string(content)
+		case header.Name == "fs.json":
+			err = json.Unmarshal(content, &dat.Fs)
+		case header.Name == "nfs_exports.json":
+			err = json.Unmarshal(content, &dat.NfsExports)
+		case header.Name == "rgw_users.json":
+			err = json.Unmarshal(content, &dat.RgwUsers)
+		case header.Name == "iscsi.json":
+			var iscsi struct {
+				DiscoveryAuth json.RawMessage   `json:"discovery_auth"`
+				Targets       []json.RawMessage `json:"targets"`
+			}
+			err = json.Unmarshal(content, &iscsi)
+			dat.IscsiAuth = iscsi.DiscoveryAuth
+			dat.IscsiTargets = iscsi.Targets
+		case header.Name == "nvmeof.json":
+			err = json.Unmarshal(content, &dat.NvmeOf)
+		case header.Name == "smb.json":
+			err = json.Unmarshal(content, &dat.Smb)
+		case header.Name == "mirror.json":
+			err = json.Unmarshal(content, &dat.MirrorPools)
+		}
+		if err != nil {
+			err = errors.Join(err, errors.New("invalid backup section "+header.Name))
+			utils.FancyHandleError(err)
+			return
+		}
+	}
+	if !manifest_found {
+		err = errors.New("manifest.json is not found in backup bundle")
+		utils.FancyHandleError(err)
+		return
+	}
+	if dat.Manifest.Version < 1 || dat.Manifest.Version > Version {
+		err = errors.New("unsupported backup version " + strconv.Itoa(dat.Manifest.Version))
+		utils.FancyHandleError(err)
+		return
+	}
+	return
+}
@@ -0,0 +1,219 @@
+package cluster
+
+import (
+	"Glue-API/model"
+	"Glue-API/utils"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+var cluster_conf = "./cluster.json"
+var cluster_dir = "/etc/glue-api/clusters"
+
+// 클러스터 이름은 설정 디렉터리 경로에 쓰이므로 경로 문자를 허용하지 않습니다.
+var cluster_name_regex = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+var client_name_regex = regexp.MustCompile(`^[A-Za-z0-9_.-]+$`)
+
+func List() (dat model.ClusterList, err error) {
+	content, err := os.ReadFile(cluster_conf)
+	if err != nil {
+		if os.IsNotExist(err) {
+			dat = make(model.ClusterList, 0)
+			err = nil
+			return
+		}
+		utils.FancyHandleError(err)
+		return
+	}
+	if err = json.Unmarshal(content, &dat); err != nil {
+		utils.FancyHandleError(err)
+		return
+	}
+	return
+}
+func Get(cluster_name string) (dat model.Cluster, err error) {
+	list, err := List()
+	if err != nil {
+		return
+	}
+	for i := 0; i < len(list); i++ {
+		if list[i].Name == cluster_name {
+			dat = list[i]
+			return
+		}
+	}
+	err = errors.New("cluster " + cluster_name + " is not registered")
+	return
+}
+func save(list model.ClusterList) (err error) {
+	json_data, err := json.MarshalIndent(list, "", " ")
+	if err != nil {
+		utils.FancyHandleError(err)
+		return
+	}
+	if err = os.WriteFile(cluster_conf, json_data, 0600); err != nil {
+		utils.FancyHandleError(err)
+		return
+	}
+	return
+}
+
+// SSH 로 등록하는 경우 원격 호스트의 ceph.conf 와 keyring 을 가져와 로컬에 저장합니다.
+func fetch(dat model.Cluster) (conf_path string, keyring_path string, err error) {
+	if !cluster_name_regex.MatchString(dat.Name) {
+		err = errors.New("invalid cluster name " + dat.Name)
+		utils.FancyHandleError(err)
+		return
+	}
+	client, err := utils.ConnectSSH(dat.SshHost, dat.SshKeyPath)
+	if err != nil {
+		return
+	}
+	defer client.Close()
+
+	dir := filepath.Join(cluster_dir, dat.Name)
+	if err = os.MkdirAll(dir, 0700); err != nil {
+		utils.FancyHandleError(err)
+		return
+	}
+	conf_path = filepath.Join(dir, "ceph.conf")
+	keyring_path = filepath.Join(dir, "ceph."+dat.ClientName+".keyring")
+	if err = client.Download("/etc/ceph/ceph.conf", conf_path); err != nil {
+		err = errors.Join(err, errors.New("("+dat.SshHost+") /etc/ceph/ceph.conf"))
+		utils.FancyHandleError(err)
+		return
+	}
+	if err = client.Download("/etc/ceph/ceph."+dat.ClientName+".keyring", keyring_path); err != nil {
+		err = errors.Join(err, errors.New("("+dat.SshHost+") /etc/ceph/ceph."+dat.ClientName+".keyring"))
+		utils.FancyHandleError(err)
+		return
+	}
+	return
+}
+func Args(dat model.Cluster) (args []string) {
+	args = append(args, "--conf", dat.ConfPath, "--keyring", dat.KeyringPath, "--name", dat.ClientName)
+	return
+}
+func Fsid(dat model.Cluster) (output string, err error) {
+	var stdout []byte
+	args := append(Args(dat), "fsid", "-f", "json")
+	cmd := exec.Command("ceph", args...)
+	stdout, err = cmd.CombinedOutput()
+	if err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	var fsid model.ClusterFsid
+	if err = json.Unmarshal(stdout, &fsid); err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	output = fsid.Fsid
+	return
+}
+func Add(dat model.Cluster) (output string, err error) {
+	if !cluster_name_regex.MatchString(dat.Name) || dat.Name == "local" {
+		err = errors.New("cluster name must consist of letters, digits, '_' or '-' and must not be local")
+		return
+	}
+	if dat.ClientName == "" {
+		dat.ClientName = "client.admin"
+	}
+	if !client_name_regex.MatchString(dat.ClientName) {
+		err = errors.New("invalid client name " + dat.ClientName)
+		return
+	}
+	list, err := List()
+	if err != nil {
+		return
+	}
+	for i := 0; i < len(list); i++ {
+		if list[i].Name == dat.Name {
+			err = errors.New("cluster " + dat.Name + " is already registered")
+			return
+		}
+	}
+	if dat.SshHost != "" {
+		dat.ConfPath, dat.KeyringPath, err = fetch(dat)
+		if err != nil {
+			return
+		}
+	} else if dat.ConfPath == "" || dat.KeyringPath == "" {
+		err = errors.New("conf_path and keyring_path or ssh_host are required")
+		return
+	}
+	// 등록 전에 실제로 클러스터에 접근이 가능한지 확인합니다.
+	if _, err = Fsid(dat); err != nil {
+		return
+	}
+	list = append(list, dat)
+	if err = save(list); err != nil {
+		return
+	}
+	output = "Success"
+	return
+}
+func Delete(cluster_name string) (output string, err error) {
+	if !cluster_name_regex.MatchString(cluster_name) {
+		err = errors.New("cluster " + cluster_name + " is not registered")
+		return
+	}
+	list, err := List()
+	if err != nil {
+		return
+	}
+	var clusters model.ClusterList
+	for i := 0; i < len(list); i++ {
+		if list[i].Name != cluster_name {
+			clusters = append(clusters, list[i])
+		} else if list[i].SshHost != "" {
+			os.RemoveAll(filepath.Join(cluster_dir, list[i].Name))
+		}
+	}
+	if len(clusters) == len(list) {
+		err = errors.New("cluster " + cluster_name + " is not registered")
+		return
+	}
+	if clusters == nil {
+		clusters = make(model.ClusterList, 0)
+	}
+	if err = save(clusters); err != nil {
+		return
+	}
+	output = "Success"
+	return
+}
+
+// clusterKey 는 요청 context 에 선택된 원격 클러스터를 저장하는 키입니다.
+type clusterKey struct{}
+
+// WithCluster 는 원격 클러스터를 대상으로 하는 context 를 반환합니다.
+func WithCluster(ctx context.Context, dat model.Cluster) context.Context {
+	return context.WithValue(ctx, clusterKey{}, dat)
+}
+
+// FromContext 는 context 가 대상으로 하는 원격 클러스터를 반환합니다. 로컬 클러스터인 경우 false 를 반환합니다.
+func FromContext(ctx context.Context) (dat model.Cluster, remote bool) {
+	dat, remote = ctx.Value(clusterKey{}).(model.Cluster)
+	return
+}
+
+// Command 는 exec.Command 와 같지만, ctx 가 원격 클러스터를 대상으로 하면 그 클러스터의 ceph.conf, keyring,
+// 사용자를 CEPH_ARGS 로 넘깁니다. ceph, rbd 처럼 CEPH_ARGS 를 읽는 명령에만 사용합니다.
+func Command(ctx context.Context, name string, arg ...string) *exec.Cmd {
+	cmd := exec.Command(name, arg...)
+	if dat, remote := FromContext(ctx); remote {
+		cmd.Env = append(os.Environ(), "CEPH_ARGS="+strings.Join(Args(dat), " "))
+	}
+	return cmd
+}
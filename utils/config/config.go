@@ -0,0 +1,220 @@
+package config
+
+import (
+	"Glue-API/model"
+	"Glue-API/utils"
+	"Glue-API/utils/cluster"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os/exec"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// 설정 설명은 업그레이드 전까지 바뀌지 않으므로 메모리에 보관합니다.
+var help_lock sync.Mutex
+var help_cache = make(map[string]model.ConfigHelp)
+
+var size_format = regexp.MustCompile(`^[0-9]+([KMGTP]i?B?)?$`)
+var secs_format = regexp.MustCompile(`^[0-9]+(ms|s|m|h|d|w)?$`)
+
+func Dump(ctx context.Context) (dat model.ConfigDump, err error) {
+	var stdout []byte
+	cmd := cluster.Command(ctx, "ceph", "config", "dump", "-f", "json")
+	stdout, err = cmd.CombinedOutput()
+	if err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	if err = json.Unmarshal(stdout, &dat); err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	return
+}
+func Help(name string) (dat model.ConfigHelp, err error) {
+	help_lock.Lock()
+	defer help_lock.Unlock()
+	if help, ok := help_cache[name]; ok {
+		return help, nil
+	}
+	var stdout []byte
+	cmd := exec.Command("ceph", "config", "help", name, "-f", "json")
+	stdout, err = cmd.CombinedOutput()
+	if err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	if err = json.Unmarshal(stdout, &dat); err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	help_cache[name] = dat
+	return
+}
+func Get(ctx context.Context, who string, name string) (output string, err error) {
+	var stdout []byte
+	cmd := cluster.Command(ctx, "ceph", "config", "get", who, name)
+	stdout, err = cmd.CombinedOutput()
+	if err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	output = strings.TrimSpace(string(stdout))
+	return
+}
+func Set(ctx context.Context, who string, name string, value string) (output string, err error) {
+	var stdout []byte
+	cmd := cluster.Command(ctx, "ceph", "config", "set", who, name, value)
+	stdout, err = cmd.CombinedOutput()
+	if err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	output = "Success"
+	return
+}
+
+// Remove 는 중앙 설정에서 항목을 지워 기본값으로 되돌립니다.
+func Remove(ctx context.Context, who string, name string) (output string, err error) {
+	var stdout []byte
+	cmd := cluster.Command(ctx, "ceph", "config", "rm", who, name)
+	stdout, err = cmd.CombinedOutput()
+	if err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	output = "Success"
+	return
+}
+
+// Log 는 모니터에 기록된 최근 num 개의 설정 변경 이력을 반환합니다.
+func Log(num int) (dat model.ConfigLog, err error) {
+	var stdout []byte
+	cmd := exec.Command("ceph", "config", "log", strconv.Itoa(num), "-f", "json")
+	stdout, err = cmd.CombinedOutput()
+	if err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	if err = json.Unmarshal(stdout, &dat); err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	return
+}
+
+func number(value interface{}) (output float64, ok bool) {
+	switch v := value.(type) {
+	case float64:
+		return v, true
+	case string:
+		f, err := strconv.ParseFloat(v, 64)
+		return f, err == nil && v != ""
+	}
+	return 0, false
+}
+
+// String 은 ceph config help 의 기본값을 설정 값과 같은 문자열 형식으로 바꿉니다.
+func String(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	}
+	return fmt.Sprint(value)
+}
+
+// Equal 은 설정 값과 기본값을 비교합니다. 숫자는 값으로 비교합니다.
+func Equal(value string, default_value interface{}) bool {
+	if value == String(default_value) {
+		return true
+	}
+	v, v_ok := number(value)
+	d, d_ok := number(default_value)
+	return v_ok && d_ok && v == d
+}
+
+// Validate 는 ceph config help 의 타입, 범위, 허용 값으로 설정 값을 확인합니다.
+func Validate(help model.ConfigHelp, value string) (err error) {
+	if len(help.EnumValues) > 0 {
+		for _, allowed := range help.EnumValues {
+			if value == allowed {
+				return
+			}
+		}
+		return errors.New(help.Name + " must be one of " + strings.Join(help.EnumValues, ", "))
+	}
+	var parsed float64
+	switch help.Type {
+	case "bool":
+		if _, err = strconv.ParseBool(value); err != nil {
+			return errors.New(help.Name + " must be true or false")
+		}
+		return
+	case "int":
+		var i int64
+		if i, err = strconv.ParseInt(value, 10, 64); err != nil {
+			return errors.New(help.Name + " must be an integer")
+		}
+		parsed = float64(i)
+	case "uint":
+		var u uint64
+		if u, err = strconv.ParseUint(value, 10, 64); err != nil {
+			return errors.New(help.Name + " must be a positive integer")
+		}
+		parsed = float64(u)
+	case "float":
+		if parsed, err = strconv.ParseFloat(value, 64); err != nil {
+			return errors.New(help.Name + " must be a number")
+		}
+	case "size":
+		if !size_format.MatchString(value) {
+			return errors.New(help.Name + " must be a size such as 1024, 4K or 1G")
+		}
+		return
+	case "secs", "millisecs":
+		if !secs_format.MatchString(value) {
+			return errors.New(help.Name + " must be a duration such as 30 or 30s")
+		}
+		return
+	default:
+		return
+	}
+	// 범위가 없는 설정은 min, max 가 없거나 같은 값으로 표시됩니다.
+	min, min_ok := number(help.Min)
+	max, max_ok := number(help.Max)
+	if min_ok && max_ok && min == max {
+		return
+	}
+	if min_ok && parsed < min {
+		return errors.New(help.Name + " must be " + fmt.Sprint(help.Min) + " or more")
+	}
+	if max_ok && parsed > max {
+		return errors.New(help.Name + " must be " + fmt.Sprint(help.Max) + " or less")
+	}
+	return
+}
@@ -0,0 +1,74 @@
+package crash
+
+import (
+	"Glue-API/model"
+	"Glue-API/utils"
+	"encoding/json"
+	"errors"
+	"os/exec"
+	"strings"
+)
+
+// List 는 crash 모듈에 수집된 데몬 장애 보고서 목록을 조회합니다. archived 가 false 이면 보관되지 않은 보고서만 반환합니다.
+func List(archived bool) (dat model.CrashList, err error) {
+	var stdout []byte
+	command := "ls"
+	if !archived {
+		command = "ls-new"
+	}
+	cmd := exec.Command("ceph", "crash", command, "-f", "json")
+	stdout, err = cmd.CombinedOutput()
+	if err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	dat = make(model.CrashList, 0)
+	if err = json.Unmarshal(stdout, &dat); err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	return
+}
+
+// Info 는 장애 보고서 하나의 assert 정보와 backtrace 를 조회합니다.
+func Info(crash_id string) (dat model.Crash, err error) {
+	var stdout []byte
+	cmd := exec.Command("ceph", "crash", "info", crash_id)
+	stdout, err = cmd.CombinedOutput()
+	if err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	if err = json.Unmarshal(stdout, &dat); err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	return
+}
+
+// Archive 는 장애 보고서를 확인 처리하여 RECENT_CRASH 경고에서 제외합니다. crash_id 가 비어 있으면 모든 보고서를 보관합니다.
+func Archive(crash_id string) (output string, err error) {
+	var stdout []byte
+	args := []string{"crash", "archive-all"}
+	if crash_id != "" {
+		args = []string{"crash", "archive", crash_id}
+	}
+	cmd := exec.Command("ceph", args...)
+	stdout, err = cmd.CombinedOutput()
+	if err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	output = "Success"
+	return
+}
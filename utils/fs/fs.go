@@ -3,16 +3,19 @@ package fs
 import (
 	"Glue-API/model"
 	"Glue-API/utils"
+	"Glue-API/utils/cluster"
+	"Glue-API/utils/glue"
+	"context"
 	"encoding/json"
 	"errors"
 	"os/exec"
 	"strings"
 )
 
-func FsStatus() (dat model.FsStatus, err error) {
+func FsStatus(ctx context.Context) (dat model.FsStatus, err error) {
 
 	var stdout []byte
-	cmd := exec.Command("ceph", "fs", "status", "-f", "json")
+	cmd := cluster.Command(ctx, "ceph", "fs", "status", "-f", "json")
 	stdout, err = cmd.CombinedOutput()
 	if err != nil {
 		err_str := strings.ReplaceAll(string(stdout), "\n", "")
@@ -47,9 +50,9 @@ func CephHost() (dat model.CephHost, err error) {
 	}
 	return
 }
-func FsCreate(fs_name string, hosts string) (output string, err error) {
+func FsCreate(ctx context.Context, fs_name string, hosts string) (output string, err error) {
 	var stdout []byte
-	cmd := exec.Command("ceph", "fs", "volume", "create", fs_name, "--placement", hosts)
+	cmd := cluster.Command(ctx, "ceph", "fs", "volume", "create", fs_name, "--placement", hosts)
 	stdout, err = cmd.CombinedOutput()
 	if err != nil {
 		err_str := strings.ReplaceAll(string(stdout), "\n", "")
@@ -57,7 +60,7 @@ func FsCreate(fs_name string, hosts string) (output string, err error) {
 		utils.FancyHandleError(err)
 		return
 	} else {
-		cmd := exec.Command("ceph", "osd", "pool", "rename", "cephfs."+fs_name+".data", fs_name+".data")
+		cmd := cluster.Command(ctx, "ceph", "osd", "pool", "rename", "cephfs."+fs_name+".data", fs_name+".data")
 		stdout, err = cmd.CombinedOutput()
 		if err != nil {
 			err_str := strings.ReplaceAll(string(stdout), "\n", "")
@@ -65,7 +68,7 @@ func FsCreate(fs_name string, hosts string) (output string, err error) {
 			utils.FancyHandleError(err)
 			return
 		} else {
-			cmd := exec.Command("ceph", "osd", "pool", "rename", "cephfs."+fs_name+".meta", fs_name+".meta")
+			cmd := cluster.Command(ctx, "ceph", "osd", "pool", "rename", "cephfs."+fs_name+".meta", fs_name+".meta")
 			stdout, err = cmd.CombinedOutput()
 			if err != nil {
 				err_str := strings.ReplaceAll(string(stdout), "\n", "")
@@ -73,7 +76,7 @@ func FsCreate(fs_name string, hosts string) (output string, err error) {
 				utils.FancyHandleError(err)
 				return
 			} else {
-				cmd := exec.Command("ceph", "osd", "pool", "set", fs_name+".data", "size", "2")
+				cmd := cluster.Command(ctx, "ceph", "osd", "pool", "set", fs_name+".data", "size", "2")
 				stdout, err = cmd.CombinedOutput()
 				if err != nil {
 					err_str := strings.ReplaceAll(string(stdout), "\n", "")
@@ -81,7 +84,7 @@ func FsCreate(fs_name string, hosts string) (output string, err error) {
 					utils.FancyHandleError(err)
 					return
 				} else {
-					cmd := exec.Command("ceph", "osd", "pool", "set", fs_name+".meta", "size", "2")
+					cmd := cluster.Command(ctx, "ceph", "osd", "pool", "set", fs_name+".meta", "size", "2")
 					stdout, err = cmd.CombinedOutput()
 					if err != nil {
 						err_str := strings.ReplaceAll(string(stdout), "\n", "")
@@ -96,9 +99,18 @@ func FsCreate(fs_name string, hosts string) (output string, err error) {
 		}
 	}
 }
-func FsDelete(fs_name string) (output string, err error) {
+func FsDelete(ctx context.Context, fs_name string) (output string, err error) {
 	var stdout []byte
-	cmd := exec.Command("ceph", "config", "get", "mon", "mon_allow_pool_delete")
+	restore, err := glue.PoolDeleteAllow(ctx)
+	if err != nil {
+		return
+	}
+	defer func() {
+		if restore_err := restore(); restore_err != nil {
+			err = errors.Join(err, restore_err)
+		}
+	}()
+	cmd := cluster.Command(ctx, "ceph", "fs", "volume", "rm", fs_name, "--yes-i-really-mean-it")
 	stdout, err = cmd.CombinedOutput()
 	if err != nil {
 		err_str := strings.ReplaceAll(string(stdout), "\n", "")
@@ -106,42 +118,12 @@ func FsDelete(fs_name string) (output string, err error) {
 		utils.FancyHandleError(err)
 		return
 	}
-	if string(stdout) == "true" {
-		cmd := exec.Command("ceph", "fs", "volume", "rm", fs_name, "--yes-i-really-mean-it")
-		stdout, err = cmd.CombinedOutput()
-		if err != nil {
-			err_str := strings.ReplaceAll(string(stdout), "\n", "")
-			err = errors.New(err_str)
-			utils.FancyHandleError(err)
-			return
-		}
-		output = "Success"
-		return
-	} else {
-		cmd := exec.Command("ceph", "config", "set", "mon", "mon_allow_pool_delete", "true")
-		stdout, err = cmd.CombinedOutput()
-		if err != nil {
-			err_str := strings.ReplaceAll(string(stdout), "\n", "")
-			err = errors.New(err_str)
-			utils.FancyHandleError(err)
-			return
-		} else {
-			cmd := exec.Command("ceph", "fs", "volume", "rm", fs_name, "--yes-i-really-mean-it")
-			stdout, err = cmd.CombinedOutput()
-			if err != nil {
-				err_str := strings.ReplaceAll(string(stdout), "\n", "")
-				err = errors.New(err_str)
-				utils.FancyHandleError(err)
-				return
-			}
-			output = "Success"
-			return
-		}
-	}
+	output = "Success"
+	return
 }
-func FsGetInfo(fs_name string) (dat model.FsGetInfo, err error) {
+func FsGetInfo(ctx context.Context, fs_name string) (dat model.FsGetInfo, err error) {
 	var stdout []byte
-	cmd := exec.Command("ceph", "fs", "get", fs_name, "-f", "json")
+	cmd := cluster.Command(ctx, "ceph", "fs", "get", fs_name, "-f", "json")
 	stdout, err = cmd.CombinedOutput()
 	if err != nil {
 		err_str := strings.ReplaceAll(string(stdout), "\n", "")
@@ -157,9 +139,9 @@ func FsGetInfo(fs_name string) (dat model.FsGetInfo, err error) {
 	}
 	return
 }
-func FsList() (dat model.FsList, err error) {
+func FsList(ctx context.Context) (dat model.FsList, err error) {
 	var stdout []byte
-	cmd := exec.Command("ceph", "fs", "ls", "-f", "json")
+	cmd := cluster.Command(ctx, "ceph", "fs", "ls", "-f", "json")
 	stdout, err = cmd.CombinedOutput()
 	if err != nil {
 		err_str := strings.ReplaceAll(string(stdout), "\n", "")
@@ -175,9 +157,9 @@ func FsList() (dat model.FsList, err error) {
 	}
 	return
 }
-func FsUpdate(old_name string, new_name string, hosts string) (output string, err error) {
+func FsUpdate(ctx context.Context, old_name string, new_name string, hosts string) (output string, err error) {
 	var stdout []byte
-	cmd := exec.Command("ceph", "fs", "rename", old_name, new_name, "--yes-i-really-mean-it")
+	cmd := cluster.Command(ctx, "ceph", "fs", "rename", old_name, new_name, "--yes-i-really-mean-it")
 	stdout, err = cmd.CombinedOutput()
 	if err != nil {
 		err_str := strings.ReplaceAll(string(stdout), "\n", "")
@@ -185,7 +167,7 @@ func FsUpdate(old_name string, new_name string, hosts string) (output string, er
 		utils.FancyHandleError(err)
 		return
 	} else {
-		cmd := exec.Command("ceph", "osd", "pool", "rename", old_name+".data", new_name+".data")
+		cmd := cluster.Command(ctx, "ceph", "osd", "pool", "rename", old_name+".data", new_name+".data")
 		stdout, err = cmd.CombinedOutput()
 		if err != nil {
 			err_str := strings.ReplaceAll(string(stdout), "\n", "")
@@ -193,7 +175,7 @@ func FsUpdate(old_name string, new_name string, hosts string) (output string, er
 			utils.FancyHandleError(err)
 			return
 		} else {
-			cmd := exec.Command("ceph", "osd", "pool", "rename", old_name+".meta", new_name+".meta")
+			cmd := cluster.Command(ctx, "ceph", "osd", "pool", "rename", old_name+".meta", new_name+".meta")
 			stdout, err = cmd.CombinedOutput()
 			if err != nil {
 				err_str := strings.ReplaceAll(string(stdout), "\n", "")
@@ -202,7 +184,7 @@ func FsUpdate(old_name string, new_name string, hosts string) (output string, er
 				return
 			} else {
 				if hosts != "" {
-					cmd := exec.Command("ceph", "orch", "apply", "mds", new_name, hosts)
+					cmd := cluster.Command(ctx, "ceph", "orch", "apply", "mds", new_name, hosts)
 					stdout, err = cmd.CombinedOutput()
 					if err != nil {
 						err_str := strings.ReplaceAll(string(stdout), "\n", "")
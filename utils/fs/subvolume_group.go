@@ -3,15 +3,17 @@ package fs
 import (
 	"Glue-API/model"
 	"Glue-API/utils"
+	"Glue-API/utils/cluster"
+	"context"
 	"encoding/json"
 	"errors"
 	"os/exec"
 	"strings"
 )
 
-func SubVolumeGroupCreate(vol_name string, group_name string, size string, data_pool_name string, mode string) (output string, err error) {
+func SubVolumeGroupCreate(ctx context.Context, vol_name string, group_name string, size string, data_pool_name string, mode string) (output string, err error) {
 	var stdout []byte
-	cmd := exec.Command("ceph", "fs", "subvolumegroup", "create", vol_name, group_name, size, data_pool_name, "--mode", mode)
+	cmd := cluster.Command(ctx, "ceph", "fs", "subvolumegroup", "create", vol_name, group_name, size, data_pool_name, "--mode", mode)
 	stdout, err = cmd.CombinedOutput()
 	if err != nil {
 		err_str := strings.ReplaceAll(string(stdout), "\n", "")
@@ -22,9 +24,9 @@ func SubVolumeGroupCreate(vol_name string, group_name string, size string, data_
 	output = "Success"
 	return
 }
-func SubVolumeGroupInfo(vol_name string, group_name string) (dat model.SubVolumeGroupInfo, err error) {
+func SubVolumeGroupInfo(ctx context.Context, vol_name string, group_name string) (dat model.SubVolumeGroupInfo, err error) {
 	var stdout []byte
-	cmd := exec.Command("ceph", "fs", "subvolumegroup", "info", vol_name, group_name)
+	cmd := cluster.Command(ctx, "ceph", "fs", "subvolumegroup", "info", vol_name, group_name)
 	stdout, err = cmd.CombinedOutput()
 	if err != nil {
 		err_str := strings.ReplaceAll(string(stdout), "\n", "")
@@ -40,9 +42,9 @@ func SubVolumeGroupInfo(vol_name string, group_name string) (dat model.SubVolume
 	}
 	return
 }
-func SubVolumeGroupLs(vol_name string) (dat model.SubVolumeAllLs, err error) {
+func SubVolumeGroupLs(ctx context.Context, vol_name string) (dat model.SubVolumeAllLs, err error) {
 	var stdout []byte
-	cmd := exec.Command("ceph", "fs", "subvolumegroup", "ls", vol_name)
+	cmd := cluster.Command(ctx, "ceph", "fs", "subvolumegroup", "ls", vol_name)
 	stdout, err = cmd.CombinedOutput()
 	if err != nil {
 		err_str := strings.ReplaceAll(string(stdout), "\n", "")
@@ -58,9 +60,9 @@ func SubVolumeGroupLs(vol_name string) (dat model.SubVolumeAllLs, err error) {
 	}
 	return
 }
-func SubVolumeGroupGetPath(vol_name string, group_name string) (output string, err error) {
+func SubVolumeGroupGetPath(ctx context.Context, vol_name string, group_name string) (output string, err error) {
 	var stdout []byte
-	cmd := exec.Command("ceph", "fs", "subvolumegroup", "getpath", vol_name, group_name)
+	cmd := cluster.Command(ctx, "ceph", "fs", "subvolumegroup", "getpath", vol_name, group_name)
 	stdout, err = cmd.CombinedOutput()
 	if err != nil {
 		err_str := strings.ReplaceAll(string(stdout), "\n", "")
@@ -126,9 +128,9 @@ func SubVolumeGroupDelete(vol_name string, group_name string, path string) (outp
 		}
 	}
 }
-func SubVolumeGroupResize(vol_name string, group_name string, new_size string) (output string, err error) {
+func SubVolumeGroupResize(ctx context.Context, vol_name string, group_name string, new_size string) (output string, err error) {
 	var stdout []byte
-	cmd := exec.Command("ceph", "fs", "subvolumegroup", "resize", vol_name, group_name, new_size, "--no_shrink")
+	cmd := cluster.Command(ctx, "ceph", "fs", "subvolumegroup", "resize", vol_name, group_name, new_size, "--no_shrink")
 	stdout, err = cmd.CombinedOutput()
 	if err != nil {
 		err_str := strings.ReplaceAll(string(stdout), "\n", "")
@@ -152,9 +154,9 @@ func SubVolumeGroupSnapDelete(vol_name string, group_name string, snap_name stri
 	output = "Success"
 	return
 }
-func SubVolumeGroupSnapLs(vol_name string, group_name string) (dat model.SubVolumeAllSnapLs, err error) {
+func SubVolumeGroupSnapLs(ctx context.Context, vol_name string, group_name string) (dat model.SubVolumeAllSnapLs, err error) {
 	var stdout []byte
-	cmd := exec.Command("ceph", "fs", "subvolumegroup", "snapshot", "ls", vol_name, group_name)
+	cmd := cluster.Command(ctx, "ceph", "fs", "subvolumegroup", "snapshot", "ls", vol_name, group_name)
 	stdout, err = cmd.CombinedOutput()
 	if err != nil {
 		err_str := strings.ReplaceAll(string(stdout), "\n", "")
@@ -0,0 +1,148 @@
+package glue
+
+import (
+	"Glue-API/model"
+	"Glue-API/utils"
+	"Glue-API/utils/cluster"
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+)
+
+// 부모 체인과 자식 트리를 따라갈 최대 깊이입니다.
+var lineage_depth = 16
+
+// CloneImage 는 보호된 스냅샷에서 다른 풀 또는 같은 풀에 클론 이미지를 생성합니다.
+func CloneImage(ctx context.Context, pool_name string, image_name string, snapshot_name string, dest_pool_name string, dest_image_name string) (output string, err error) {
+	if _, err = rbd(ctx, "clone", pool_name+"/"+image_name+"@"+snapshot_name, dest_pool_name+"/"+dest_image_name); err != nil {
+		return
+	}
+	output = "Success"
+	return
+}
+
+func task(ctx context.Context, args ...string) (stdout []byte, err error) {
+	cmd := cluster.Command(ctx, "ceph", append([]string{"rbd", "task"}, args...)...)
+	stdout, err = cmd.CombinedOutput()
+	if err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	return
+}
+
+// FlattenImage 는 mgr rbd_support 모듈에 flatten 작업을 등록하여 백그라운드에서 부모 데이터를 복사합니다.
+func FlattenImage(ctx context.Context, pool_name string, image_name string) (dat model.ImageTask, err error) {
+	stdout, err := task(ctx, "add", "flatten", pool_name+"/"+image_name)
+	if err != nil {
+		return
+	}
+	if err = json.Unmarshal(stdout, &dat); err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	return
+}
+
+// TaskList 는 대기 중이거나 진행 중인 이미지 백그라운드 작업과 진행률을 조회합니다.
+func TaskList(ctx context.Context) (dat model.ImageTaskList, err error) {
+	stdout, err := task(ctx, "list")
+	if err != nil {
+		return
+	}
+	dat = make(model.ImageTaskList, 0)
+	if err = json.Unmarshal(stdout, &dat); err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	return
+}
+
+func TaskCancel(ctx context.Context, task_id string) (output string, err error) {
+	if _, err = task(ctx, "cancel", task_id); err != nil {
+		return
+	}
+	output = "Success"
+	return
+}
+
+// SnapshotChildren 은 스냅샷에서 생성된 클론 이미지 목록을 조회합니다. 휴지통에 있는 클론은 제외합니다.
+func SnapshotChildren(ctx context.Context, pool_name string, image_name string, snapshot_name string) (dat []model.ImageRef, err error) {
+	var children []struct {
+		Pool  string `json:"pool"`
+		Image string `json:"image"`
+		Trash bool   `json:"trash"`
+	}
+	stdout, err := rbd(ctx, "children", pool_name+"/"+image_name+"@"+snapshot_name, "--format", "json")
+	if err != nil {
+		return
+	}
+	if err = json.Unmarshal(stdout, &children); err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	dat = make([]model.ImageRef, 0)
+	for _, child := range children {
+		if !child.Trash {
+			dat = append(dat, model.ImageRef{Pool: child.Pool, Image: child.Image})
+		}
+	}
+	return
+}
+
+func imageChildren(ctx context.Context, pool_name string, image_name string, depth int) (dat []model.ImageChild, err error) {
+	dat = make([]model.ImageChild, 0)
+	if depth >= lineage_depth {
+		return
+	}
+	snaps, err := SnapshotList(ctx, pool_name, image_name)
+	if err != nil {
+		return
+	}
+	for _, snap := range snaps {
+		children, err := SnapshotChildren(ctx, pool_name, image_name, snap.Name)
+		if err != nil {
+			return dat, err
+		}
+		for _, child := range children {
+			node := model.ImageChild{Pool: child.Pool, Image: child.Image, Snapshot: snap.Name}
+			if node.Children, err = imageChildren(ctx, child.Pool, child.Image, depth+1); err != nil {
+				return dat, err
+			}
+			dat = append(dat, node)
+		}
+	}
+	return
+}
+
+// ImageLineage 는 이미지의 부모 체인(가까운 부모부터)과 스냅샷별 자식 클론 트리를 조회합니다.
+func ImageLineage(ctx context.Context, pool_name string, image_name string) (dat model.ImageLineage, err error) {
+	dat = model.ImageLineage{Pool: pool_name, Image: image_name, Parents: make([]model.ImageRef, 0)}
+	pool, image := pool_name, image_name
+	for depth := 0; depth < lineage_depth; depth++ {
+		detail, err := ImageDetail(ctx, pool, image)
+		if err != nil {
+			return dat, err
+		}
+		if detail.Parent == nil {
+			break
+		}
+		dat.Parents = append(dat.Parents, model.ImageRef{Pool: detail.Parent.Pool, Image: detail.Parent.Image, Snapshot: detail.Parent.Snapshot})
+		// 휴지통으로 옮겨진 부모는 이름으로 조회할 수 없습니다.
+		if detail.Parent.Trash {
+			break
+		}
+		pool, image = detail.Parent.Pool, detail.Parent.Image
+	}
+	dat.Children, err = imageChildren(ctx, pool_name, image_name, 0)
+	return
+}
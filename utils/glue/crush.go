@@ -0,0 +1,99 @@
+package glue
+
+import (
+	"Glue-API/model"
+	"Glue-API/utils"
+	"Glue-API/utils/cluster"
+	"context"
+	"encoding/json"
+	"errors"
+	"os/exec"
+	"strings"
+)
+
+func OsdTree() (dat model.OsdTree, err error) {
+	var stdout []byte
+	cmd := exec.Command("ceph", "osd", "tree", "-f", "json")
+	stdout, err = cmd.CombinedOutput()
+	if err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	if err = json.Unmarshal(stdout, &dat); err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	return
+}
+func CrushClassList() (dat []string, err error) {
+	var stdout []byte
+	cmd := exec.Command("ceph", "osd", "crush", "class", "ls", "-f", "json")
+	stdout, err = cmd.CombinedOutput()
+	if err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	if err = json.Unmarshal(stdout, &dat); err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	return
+}
+func CrushRuleDump(ctx context.Context) (dat model.CrushRuleDump, err error) {
+	var stdout []byte
+	cmd := cluster.Command(ctx, "ceph", "osd", "crush", "rule", "dump", "-f", "json")
+	stdout, err = cmd.CombinedOutput()
+	if err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	if err = json.Unmarshal(stdout, &dat); err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	return
+}
+
+// CrushRuleCreate 는 root 아래에서 failure_domain 단위로 복제본을 나누는 규칙을 생성합니다. device_class 를 지정하면 해당 클래스의 OSD 만 사용합니다.
+func CrushRuleCreate(rule_name string, root string, failure_domain string, device_class string) (output string, err error) {
+	var stdout []byte
+	args := []string{"osd", "crush", "rule", "create-replicated", rule_name, root, failure_domain}
+	if device_class != "" {
+		args = append(args, device_class)
+	}
+	cmd := exec.Command("ceph", args...)
+	stdout, err = cmd.CombinedOutput()
+	if err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	output = "Success"
+	return
+}
+func CrushRuleDelete(rule_name string) (output string, err error) {
+	var stdout []byte
+	cmd := exec.Command("ceph", "osd", "crush", "rule", "rm", rule_name)
+	stdout, err = cmd.CombinedOutput()
+	if err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	output = "Success"
+	return
+}
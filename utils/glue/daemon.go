@@ -0,0 +1,58 @@
+package glue
+
+import (
+	"Glue-API/model"
+	"Glue-API/utils"
+	"encoding/json"
+	"errors"
+	"os/exec"
+	"strings"
+)
+
+// DaemonList 는 ceph orch ps 로 데몬 목록을 조회합니다. hostname, service_name 이 비어 있으면 모든 데몬을 반환합니다.
+func DaemonList(hostname string, service_name string) (dat model.DaemonList, err error) {
+	var stdout []byte
+	args := []string{"orch", "ps"}
+	if hostname != "" {
+		args = append(args, hostname)
+	}
+	if service_name != "" {
+		args = append(args, "--service_name", service_name)
+	}
+	args = append(args, "-f", "json")
+	cmd := exec.Command("ceph", args...)
+	stdout, err = cmd.CombinedOutput()
+	if err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	dat = make(model.DaemonList, 0)
+	// 조건에 맞는 데몬이 없으면 JSON 이 아닌 안내 문구를 출력합니다.
+	if !strings.HasPrefix(strings.TrimSpace(string(stdout)), "[") {
+		return
+	}
+	if err = json.Unmarshal(stdout, &dat); err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	return
+}
+
+// DaemonControl 은 데몬 하나를 시작, 중지, 재시작하거나 다시 배포합니다.
+func DaemonControl(control string, daemon_name string) (output string, err error) {
+	var stdout []byte
+	cmd := exec.Command("ceph", "orch", "daemon", control, daemon_name)
+	stdout, err = cmd.CombinedOutput()
+	if err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	output = strings.TrimSuffix(strings.ReplaceAll(string(stdout), "\n", "."), ".")
+	return
+}
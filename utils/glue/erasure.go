@@ -0,0 +1,130 @@
+package glue
+
+import (
+	"Glue-API/model"
+	"Glue-API/utils"
+	"Glue-API/utils/cluster"
+	"context"
+	"encoding/json"
+	"errors"
+	"os/exec"
+	"strconv"
+	"strings"
+)
+
+func ErasureCodeProfileNames() (dat []string, err error) {
+	var stdout []byte
+	cmd := exec.Command("ceph", "osd", "erasure-code-profile", "ls", "-f", "json")
+	stdout, err = cmd.CombinedOutput()
+	if err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	if err = json.Unmarshal(stdout, &dat); err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	return
+}
+
+// ErasureCodeProfileGet 은 프로파일을 조회합니다. ceph 는 모든 값을 문자열로 반환합니다.
+func ErasureCodeProfileGet(ctx context.Context, profile_name string) (dat model.ErasureCodeProfile, err error) {
+	var stdout []byte
+	var values map[string]string
+	cmd := cluster.Command(ctx, "ceph", "osd", "erasure-code-profile", "get", profile_name, "-f", "json")
+	stdout, err = cmd.CombinedOutput()
+	if err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	if err = json.Unmarshal(stdout, &values); err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	dat = model.ErasureCodeProfile{
+		Name:               profile_name,
+		Plugin:             values["plugin"],
+		Technique:          values["technique"],
+		CrushFailureDomain: values["crush-failure-domain"],
+		CrushDeviceClass:   values["crush-device-class"],
+		CrushRoot:          values["crush-root"],
+		Pools:              make([]string, 0),
+	}
+	dat.K, _ = strconv.Atoi(values["k"])
+	dat.M, _ = strconv.Atoi(values["m"])
+	return
+}
+
+// ErasureCodeProfileSet 은 프로파일을 생성합니다. force 를 지정하면 기존 프로파일을 덮어씁니다.
+func ErasureCodeProfileSet(dat model.ErasureCodeProfile, force bool) (output string, err error) {
+	var stdout []byte
+	args := []string{"osd", "erasure-code-profile", "set", dat.Name,
+		"k=" + strconv.Itoa(dat.K),
+		"m=" + strconv.Itoa(dat.M),
+		"plugin=" + dat.Plugin,
+		"crush-failure-domain=" + dat.CrushFailureDomain,
+	}
+	if dat.Technique != "" {
+		args = append(args, "technique="+dat.Technique)
+	}
+	if dat.CrushDeviceClass != "" {
+		args = append(args, "crush-device-class="+dat.CrushDeviceClass)
+	}
+	if dat.CrushRoot != "" {
+		args = append(args, "crush-root="+dat.CrushRoot)
+	}
+	if force {
+		args = append(args, "--force")
+	}
+	cmd := exec.Command("ceph", args...)
+	stdout, err = cmd.CombinedOutput()
+	if err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	output = "Success"
+	return
+}
+func ErasureCodeProfileDelete(profile_name string) (output string, err error) {
+	var stdout []byte
+	cmd := exec.Command("ceph", "osd", "erasure-code-profile", "rm", profile_name)
+	stdout, err = cmd.CombinedOutput()
+	if err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	output = "Success"
+	return
+}
+
+// CrushClassOsds 는 지정한 디바이스 클래스에 속한 OSD 번호 목록을 반환합니다.
+func CrushClassOsds(device_class string) (dat []int, err error) {
+	var stdout []byte
+	cmd := exec.Command("ceph", "osd", "crush", "class", "ls-osd", device_class, "-f", "json")
+	stdout, err = cmd.CombinedOutput()
+	if err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	if err = json.Unmarshal(stdout, &dat); err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	return
+}
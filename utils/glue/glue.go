@@ -3,15 +3,17 @@ package glue
 import (
 	"Glue-API/model"
 	"Glue-API/utils"
+	"Glue-API/utils/cluster"
+	"context"
 	"encoding/json"
 	"errors"
 	"os/exec"
 	"strings"
 )
 
-func RbdPool() (pools []string, err error) {
+func RbdPool(ctx context.Context) (pools []string, err error) {
 	var stdout []byte
-	cmd := exec.Command("sh", "-c", "ceph osd pool ls detail | grep 'rbd' | cut -d \"'\" -f2")
+	cmd := cluster.Command(ctx, "sh", "-c", "ceph osd pool ls detail | grep 'rbd' | cut -d \"'\" -f2")
 	stdout, err = cmd.CombinedOutput()
 	if err != nil {
 		return
@@ -25,9 +27,9 @@ func RbdPool() (pools []string, err error) {
 	}
 	return
 }
-func RbdImage(pool_name string) (pools []string, err error) {
+func RbdImage(ctx context.Context, pool_name string) (pools []string, err error) {
 	var stdout []byte
-	cmd := exec.Command("rbd", "ls", "-p", pool_name, "--format", "json")
+	cmd := cluster.Command(ctx, "rbd", "ls", "-p", pool_name, "--format", "json")
 	stdout, err = cmd.CombinedOutput()
 	if err != nil {
 		err_str := strings.ReplaceAll(string(stdout), "\n", "")
@@ -43,10 +45,10 @@ func RbdImage(pool_name string) (pools []string, err error) {
 	}
 	return
 }
-func ListPool(pool_name string) (pools []string, err error) {
+func ListPool(ctx context.Context, pool_name string) (pools []string, err error) {
 	var stdout []byte
 	if pool_name == "" {
-		cmd := exec.Command("ceph", "osd", "pool", "ls", "--format", "json")
+		cmd := cluster.Command(ctx, "ceph", "osd", "pool", "ls", "--format", "json")
 		stdout, err = cmd.CombinedOutput()
 		if err != nil {
 			err_str := strings.ReplaceAll(string(stdout), "\n", "")
@@ -63,7 +65,7 @@ func ListPool(pool_name string) (pools []string, err error) {
 		}
 		return
 	} else {
-		cmd := exec.Command("sh", "-c", "ceph osd pool ls detail | grep \""+pool_name+"\" | cut -d \"'\" -f2")
+		cmd := cluster.Command(ctx, "sh", "-c", "ceph osd pool ls detail | grep \""+pool_name+"\" | cut -d \"'\" -f2")
 		stdout, err = cmd.CombinedOutput()
 		if err != nil {
 			return
@@ -78,9 +80,9 @@ func ListPool(pool_name string) (pools []string, err error) {
 		return
 	}
 }
-func InfoImage(pool_name string) (dat model.Images, err error) {
+func InfoImage(ctx context.Context, pool_name string) (dat model.Images, err error) {
 	var stdout []byte
-	cmd := exec.Command("rbd", "ls", "-l", "-p", pool_name, "--format", "json")
+	cmd := cluster.Command(ctx, "rbd", "ls", "-l", "-p", pool_name, "--format", "json")
 	stdout, err = cmd.CombinedOutput()
 
 	if err != nil {
@@ -98,10 +100,10 @@ func InfoImage(pool_name string) (dat model.Images, err error) {
 	}
 	return
 }
-func ListAndInfoImage(image_name string, pool_name string) (dat model.ImageCommon, err error) {
+func ListAndInfoImage(ctx context.Context, image_name string, pool_name string) (dat model.ImageCommon, err error) {
 	var stdout []byte
 	if image_name != "" && pool_name == "" {
-		cmd := exec.Command("rbd", "info", image_name, "--format", "json")
+		cmd := cluster.Command(ctx, "rbd", "info", image_name, "--format", "json")
 		stdout, err = cmd.CombinedOutput()
 		if err != nil {
 			err_str := strings.ReplaceAll(string(stdout), "\n", "")
@@ -116,7 +118,7 @@ func ListAndInfoImage(image_name string, pool_name string) (dat model.ImageCommo
 			return
 		}
 	} else {
-		cmd := exec.Command("rbd", "info", pool_name+"/"+image_name, "--format", "json")
+		cmd := cluster.Command(ctx, "rbd", "info", pool_name+"/"+image_name, "--format", "json")
 		stdout, err = cmd.CombinedOutput()
 		if err != nil {
 			err_str := strings.ReplaceAll(string(stdout), "\n", "")
@@ -133,9 +135,9 @@ func ListAndInfoImage(image_name string, pool_name string) (dat model.ImageCommo
 	}
 	return
 }
-func CreateImage(image_name string, pool_name string, size string) (output string, err error) {
+func CreateImage(ctx context.Context, image_name string, pool_name string, size string) (output string, err error) {
 	var stdout []byte
-	cmd := exec.Command("rbd", "create", "--size", size, pool_name+"/"+image_name)
+	cmd := cluster.Command(ctx, "rbd", "create", "--size", size, pool_name+"/"+image_name)
 	stdout, err = cmd.CombinedOutput()
 	if err != nil {
 		err_str := strings.ReplaceAll(string(stdout), "\n", "")
@@ -146,13 +148,12 @@ func CreateImage(image_name string, pool_name string, size string) (output strin
 	output = "Success"
 	return
 }
-func DeleteImage(image_name string, pool_name string) (output string, err error) {
+func ResizeImage(ctx context.Context, image_name string, pool_name string, size string) (output string, err error) {
 	var stdout []byte
-	cmd := exec.Command("rbd", "rm", pool_name+"/"+image_name)
+	cmd := cluster.Command(ctx, "rbd", "resize", "--size", size, pool_name+"/"+image_name)
 	stdout, err = cmd.CombinedOutput()
 	if err != nil {
-		std := strings.ReplaceAll(string(stdout), "\n", "")
-		err_str := strings.ReplaceAll(std, "\r", "")
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
 		err = errors.New(err_str)
 		utils.FancyHandleError(err)
 		return
@@ -160,6 +161,15 @@ func DeleteImage(image_name string, pool_name string) (output string, err error)
 	output = "Success"
 	return
 }
+
+// DeleteImage 는 실수로 삭제한 이미지를 복구할 수 있도록 이미지를 풀의 유예 기간으로 휴지통에 옮깁니다.
+func DeleteImage(ctx context.Context, image_name string, pool_name string) (output string, err error) {
+	seconds, err := TrashDeferment(ctx, pool_name)
+	if err != nil {
+		return
+	}
+	return TrashMove(ctx, pool_name, image_name, seconds)
+}
 func Status() (dat model.GlueStatus, err error) {
 	var stdout []byte
 	cmd := exec.Command("ceph", "-s", "-f", "json")
@@ -178,9 +188,18 @@ func Status() (dat model.GlueStatus, err error) {
 	}
 	return
 }
-func PoolDelete(pool_name string) (output string, err error) {
+func PoolDelete(ctx context.Context, pool_name string) (output string, err error) {
 	var stdout []byte
-	cmd := exec.Command("ceph", "config", "get", "mon", "mon_allow_pool_delete")
+	restore, err := PoolDeleteAllow(ctx)
+	if err != nil {
+		return
+	}
+	defer func() {
+		if restore_err := restore(); restore_err != nil {
+			err = errors.Join(err, restore_err)
+		}
+	}()
+	cmd := cluster.Command(ctx, "ceph", "osd", "pool", "rm", pool_name, pool_name, "--yes-i-really-really-mean-it")
 	stdout, err = cmd.CombinedOutput()
 	if err != nil {
 		err_str := strings.ReplaceAll(string(stdout), "\n", "")
@@ -188,38 +207,8 @@ func PoolDelete(pool_name string) (output string, err error) {
 		utils.FancyHandleError(err)
 		return
 	}
-	if string(stdout) == "true" {
-		cmd := exec.Command("ceph", "osd", "pool", "rm", pool_name, pool_name, "--yes-i-really-really-mean-it")
-		stdout, err = cmd.CombinedOutput()
-		if err != nil {
-			err_str := strings.ReplaceAll(string(stdout), "\n", "")
-			err = errors.New(err_str)
-			utils.FancyHandleError(err)
-			return
-		}
-		output = "Success"
-		return
-	} else {
-		cmd := exec.Command("ceph", "config", "set", "mon", "mon_allow_pool_delete", "true")
-		stdout, err = cmd.CombinedOutput()
-		if err != nil {
-			err_str := strings.ReplaceAll(string(stdout), "\n", "")
-			err = errors.New(err_str)
-			utils.FancyHandleError(err)
-			return
-		} else {
-			cmd := exec.Command("ceph", "osd", "pool", "rm", pool_name, pool_name, "--yes-i-really-really-mean-it")
-			stdout, err = cmd.CombinedOutput()
-			if err != nil {
-				err_str := strings.ReplaceAll(string(stdout), "\n", "")
-				err = errors.New(err_str)
-				utils.FancyHandleError(err)
-				return
-			}
-			output = "Success"
-			return
-		}
-	}
+	output = "Success"
+	return
 }
 func ServiceLs(service_name string, service_type string) (dat model.ServiceLs, err error) {
 	var stdout []byte
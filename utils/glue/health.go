@@ -0,0 +1,45 @@
+package glue
+
+import (
+	"Glue-API/utils"
+	"errors"
+	"os/exec"
+	"strings"
+)
+
+// HealthMute 는 상태 점검 항목을 무시합니다. ttl 이 비어 있으면 해제할 때까지 무시하고, sticky 이면 항목이 해소된 뒤 다시 발생해도 무시를 유지합니다.
+func HealthMute(code string, ttl string, sticky bool) (output string, err error) {
+	var stdout []byte
+	args := []string{"health", "mute", code}
+	if ttl != "" {
+		args = append(args, ttl)
+	}
+	if sticky {
+		args = append(args, "--sticky")
+	}
+	cmd := exec.Command("ceph", args...)
+	stdout, err = cmd.CombinedOutput()
+	if err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	output = "Success"
+	return
+}
+
+// HealthUnmute 는 상태 점검 항목의 무시를 해제합니다.
+func HealthUnmute(code string) (output string, err error) {
+	var stdout []byte
+	cmd := exec.Command("ceph", "health", "unmute", code)
+	stdout, err = cmd.CombinedOutput()
+	if err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	output = "Success"
+	return
+}
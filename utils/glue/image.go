@@ -0,0 +1,95 @@
+package glue
+
+import (
+	"Glue-API/model"
+	"Glue-API/utils"
+	"Glue-API/utils/cluster"
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+)
+
+// ImageFeatures 는 API 로 변경할 수 있는 이미지 기능과 해당 기능이 필요로 하는 기능입니다.
+var ImageFeatures = map[string][]string{
+	"exclusive-lock": {},
+	"object-map":     {"exclusive-lock"},
+	"fast-diff":      {"object-map"},
+	"journaling":     {"exclusive-lock"},
+}
+
+func rbd(ctx context.Context, args ...string) (stdout []byte, err error) {
+	cmd := cluster.Command(ctx, "rbd", args...)
+	stdout, err = cmd.CombinedOutput()
+	if err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	return
+}
+
+func ImageDetail(ctx context.Context, pool_name string, image_name string) (dat model.ImageDetail, err error) {
+	stdout, err := rbd(ctx, "info", pool_name+"/"+image_name, "--format", "json")
+	if err != nil {
+		return
+	}
+	if err = json.Unmarshal(stdout, &dat); err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	return
+}
+
+// ShrinkImage 는 이미지 크기를 줄입니다. 줄어든 영역의 데이터는 복구할 수 없습니다.
+func ShrinkImage(ctx context.Context, image_name string, pool_name string, size string) (output string, err error) {
+	if _, err = rbd(ctx, "resize", "--size", size, "--allow-shrink", pool_name+"/"+image_name); err != nil {
+		return
+	}
+	output = "Success"
+	return
+}
+
+// RenameImage 는 같은 풀 안에서 이미지 이름을 변경합니다.
+func RenameImage(ctx context.Context, image_name string, pool_name string, new_image_name string) (output string, err error) {
+	if _, err = rbd(ctx, "rename", pool_name+"/"+image_name, pool_name+"/"+new_image_name); err != nil {
+		return
+	}
+	output = "Success"
+	return
+}
+
+// CopyImage 는 이미지를 다른 풀 또는 이름으로 복사합니다. deep 이면 스냅샷과 클론 관계까지 복사합니다.
+func CopyImage(ctx context.Context, pool_name string, image_name string, dest_pool_name string, dest_image_name string, deep bool) (output string, err error) {
+	args := []string{"cp"}
+	if deep {
+		args = []string{"deep", "cp"}
+	}
+	args = append(args, "--no-progress", pool_name+"/"+image_name, dest_pool_name+"/"+dest_image_name)
+	if _, err = rbd(ctx, args...); err != nil {
+		return
+	}
+	output = "Success"
+	return
+}
+
+// ImageFeature 는 이미지 기능을 켜거나 끕니다. object-map 을 켜면 기존 데이터의 object map 을 다시 만듭니다.
+func ImageFeature(ctx context.Context, pool_name string, image_name string, feature string, enabled bool) (output string, err error) {
+	action := "disable"
+	if enabled {
+		action = "enable"
+	}
+	if _, err = rbd(ctx, "feature", action, pool_name+"/"+image_name, feature); err != nil {
+		return
+	}
+	if enabled && (feature == "object-map" || feature == "fast-diff") {
+		if _, err = rbd(ctx, "object-map", "rebuild", "--no-progress", pool_name+"/"+image_name); err != nil {
+			return
+		}
+	}
+	output = "Success"
+	return
+}
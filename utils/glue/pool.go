@@ -0,0 +1,223 @@
+package glue
+
+import (
+	"Glue-API/model"
+	"Glue-API/utils"
+	"Glue-API/utils/cluster"
+	"Glue-API/utils/config"
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// 풀 삭제 허용 설정을 바꾸고 되돌리는 동안 다른 삭제 요청이 끼어들지 않도록 합니다.
+var delete_lock sync.Mutex
+
+func PoolDetail(ctx context.Context) (dat model.PoolDetail, err error) {
+	var stdout []byte
+	cmd := cluster.Command(ctx, "ceph", "osd", "pool", "ls", "detail", "-f", "json")
+	stdout, err = cmd.CombinedOutput()
+	if err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	if err = json.Unmarshal(stdout, &dat); err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	return
+}
+
+// PoolCreate 는 복제 또는 EC 풀을 생성하고 애플리케이션을 지정합니다.
+func PoolCreate(ctx context.Context, dat model.PoolSpec) (output string, err error) {
+	var stdout []byte
+	if dat.Type == "" {
+		dat.Type = "replicated"
+	}
+	args := []string{"osd", "pool", "create", dat.Name}
+	if dat.PgNum > 0 {
+		args = append(args, strconv.Itoa(dat.PgNum), strconv.Itoa(dat.PgNum))
+	}
+	args = append(args, dat.Type)
+	if dat.Type == "erasure" {
+		if dat.ErasureCodeProfile == "" {
+			dat.ErasureCodeProfile = "default"
+		}
+		args = append(args, dat.ErasureCodeProfile)
+	}
+	if dat.CrushRule != "" {
+		args = append(args, dat.CrushRule)
+	}
+	if dat.PgAutoscaleMode != "" {
+		args = append(args, "--autoscale-mode="+dat.PgAutoscaleMode)
+	}
+	cmd := cluster.Command(ctx, "ceph", args...)
+	stdout, err = cmd.CombinedOutput()
+	if err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	if dat.Type == "erasure" {
+		// RBD 와 CephFS 는 EC 풀에 부분 쓰기를 허용해야 데이터 풀로 사용할 수 있습니다.
+		if dat.Application == "rbd" || dat.Application == "cephfs" {
+			if output, err = PoolSet(ctx, dat.Name, "allow_ec_overwrites", "true"); err != nil {
+				return
+			}
+		}
+	} else {
+		if dat.Size > 0 {
+			if output, err = PoolSet(ctx, dat.Name, "size", strconv.Itoa(dat.Size)); err != nil {
+				return
+			}
+		}
+		if dat.MinSize > 0 {
+			if output, err = PoolSet(ctx, dat.Name, "min_size", strconv.Itoa(dat.MinSize)); err != nil {
+				return
+			}
+		}
+	}
+	if dat.Application == "" {
+		output = "Success"
+		return
+	}
+	// EC 풀은 omap 을 지원하지 않아 rbd pool init 을 사용할 수 없습니다.
+	if dat.Application == "rbd" && dat.Type != "erasure" {
+		cmd = cluster.Command(ctx, "rbd", "pool", "init", dat.Name)
+	} else {
+		cmd = cluster.Command(ctx, "ceph", "osd", "pool", "application", "enable", dat.Name, dat.Application)
+	}
+	stdout, err = cmd.CombinedOutput()
+	if err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	output = "Success"
+	return
+}
+func PoolSet(ctx context.Context, pool_name string, key string, value string) (output string, err error) {
+	var stdout []byte
+	cmd := cluster.Command(ctx, "ceph", "osd", "pool", "set", pool_name, key, value)
+	stdout, err = cmd.CombinedOutput()
+	if err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	output = "Success"
+	return
+}
+
+// PoolQuota 는 풀의 할당량을 설정합니다. 0 은 제한 없음입니다.
+func PoolQuota(ctx context.Context, pool_name string, key string, value string) (output string, err error) {
+	var stdout []byte
+	cmd := cluster.Command(ctx, "ceph", "osd", "pool", "set-quota", pool_name, key, value)
+	stdout, err = cmd.CombinedOutput()
+	if err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	output = "Success"
+	return
+}
+
+// PoolDeleteAllow 는 mon_allow_pool_delete 를 켜고, 삭제가 끝난 뒤 이전 설정으로 되돌리는 함수를 반환합니다.
+// 이전에 mon 섹션에 직접 설정된 값이 없었다면 되돌릴 때 설정을 지워 기본값을 따르게 합니다.
+func PoolDeleteAllow(ctx context.Context) (restore func() error, err error) {
+	delete_lock.Lock()
+	allowed, err := config.Get(ctx, "mon", "mon_allow_pool_delete")
+	if err != nil {
+		delete_lock.Unlock()
+		return
+	}
+	if allowed == "true" {
+		restore = func() error {
+			delete_lock.Unlock()
+			return nil
+		}
+		return
+	}
+	options, err := config.Dump(ctx)
+	if err != nil {
+		delete_lock.Unlock()
+		return
+	}
+	previous := ""
+	for _, option := range options {
+		if option.Section == "mon" && option.Name == "mon_allow_pool_delete" {
+			previous = option.Value
+		}
+	}
+	if _, err = config.Set(ctx, "mon", "mon_allow_pool_delete", "true"); err != nil {
+		delete_lock.Unlock()
+		return
+	}
+	restore = func() (err error) {
+		defer delete_lock.Unlock()
+		if previous == "" {
+			_, err = config.Remove(ctx, "mon", "mon_allow_pool_delete")
+		} else {
+			_, err = config.Set(ctx, "mon", "mon_allow_pool_delete", previous)
+		}
+		if err != nil {
+			err = errors.Join(errors.New("failed to restore mon_allow_pool_delete"), err)
+		}
+		return
+	}
+	return
+}
+
+// PoolProtect 는 풀의 nodelete 플래그를 설정하여 삭제를 막거나 허용합니다.
+func PoolProtect(ctx context.Context, pool_name string, protected bool) (output string, err error) {
+	return PoolSet(ctx, pool_name, "nodelete", strconv.FormatBool(protected))
+}
+
+func PoolIoStats(ctx context.Context) (dat model.PoolIoStats, err error) {
+	var stdout []byte
+	cmd := cluster.Command(ctx, "ceph", "osd", "pool", "stats", "-f", "json")
+	stdout, err = cmd.CombinedOutput()
+	if err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	if err = json.Unmarshal(stdout, &dat); err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	return
+}
+func PoolAutoscaleStatus(ctx context.Context) (dat model.PoolAutoscaleStatus, err error) {
+	var stdout []byte
+	cmd := cluster.Command(ctx, "ceph", "osd", "pool", "autoscale-status", "-f", "json")
+	stdout, err = cmd.CombinedOutput()
+	if err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	if err = json.Unmarshal(stdout, &dat); err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	return
+}
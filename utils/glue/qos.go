@@ -0,0 +1,85 @@
+package glue
+
+import (
+	"Glue-API/model"
+	"Glue-API/utils"
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+)
+
+var qos_prefix = "rbd_qos_"
+
+// QosOptions 는 API 로 설정할 수 있는 rbd QoS 옵션에서 rbd_qos_ 를 뺀 이름입니다. 값이 0 이면 제한하지 않습니다.
+var QosOptions = []string{
+	"iops_limit", "iops_burst", "iops_burst_seconds",
+	"bps_limit", "bps_burst", "bps_burst_seconds",
+	"read_iops_limit", "read_iops_burst", "read_iops_burst_seconds",
+	"write_iops_limit", "write_iops_burst", "write_iops_burst_seconds",
+	"read_bps_limit", "read_bps_burst", "read_bps_burst_seconds",
+	"write_bps_limit", "write_bps_burst", "write_bps_burst_seconds",
+}
+
+// qosList 는 rbd config pool|image list 에서 QoS 옵션만 골라냅니다. source 로 값이 어느 단계에서 상속되었는지 알 수 있습니다.
+func qosList(ctx context.Context, level string, target string) (dat []model.ImageQosConfig, err error) {
+	stdout, err := rbd(ctx, "config", level, "list", target, "--format", "json")
+	if err != nil {
+		return
+	}
+	var configs []model.ImageQosConfig
+	if err = json.Unmarshal(stdout, &configs); err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	dat = make([]model.ImageQosConfig, 0)
+	for _, option := range QosOptions {
+		for _, config := range configs {
+			if config.Name == qos_prefix+option {
+				config.Name = option
+				dat = append(dat, config)
+			}
+		}
+	}
+	return
+}
+
+func PoolQos(ctx context.Context, pool_name string) (dat model.ImageQos, err error) {
+	dat.Pool = pool_name
+	dat.Configs, err = qosList(ctx, "pool", pool_name)
+	return
+}
+func ImageQos(ctx context.Context, pool_name string, image_name string) (dat model.ImageQos, err error) {
+	dat.Pool = pool_name
+	dat.Image = image_name
+	dat.Configs, err = qosList(ctx, "image", pool_name+"/"+image_name)
+	return
+}
+
+// QosSet 은 풀(level=pool, target=pool) 또는 이미지(level=image, target=pool/image) 단계에 QoS 옵션을 설정합니다.
+func QosSet(ctx context.Context, level string, target string, values map[string]string) (output string, err error) {
+	for _, option := range QosOptions {
+		value, ok := values[option]
+		if !ok {
+			continue
+		}
+		if _, err = rbd(ctx, "config", level, "set", target, qos_prefix+option, value); err != nil {
+			return
+		}
+	}
+	output = "Success"
+	return
+}
+
+// QosRemove 는 해당 단계에 설정한 QoS 옵션을 지워 상위 단계의 값을 상속하도록 합니다.
+func QosRemove(ctx context.Context, level string, target string, options []string) (output string, err error) {
+	for _, option := range options {
+		if _, err = rbd(ctx, "config", level, "remove", target, qos_prefix+option); err != nil {
+			return
+		}
+	}
+	output = "Success"
+	return
+}
@@ -0,0 +1,125 @@
+package glue
+
+import (
+	"Glue-API/model"
+	"Glue-API/utils"
+	"context"
+	"encoding/json"
+	"errors"
+	"os/exec"
+	"strings"
+)
+
+func SnapshotList(ctx context.Context, pool_name string, image_name string) (dat model.ImageSnapshotList, err error) {
+	var snaps []struct {
+		Id        int    `json:"id"`
+		Name      string `json:"name"`
+		Size      int64  `json:"size"`
+		Protected string `json:"protected"`
+		Timestamp string `json:"timestamp"`
+	}
+	stdout, err := rbd(ctx, "snap", "ls", pool_name+"/"+image_name, "--format", "json")
+	if err != nil {
+		return
+	}
+	if err = json.Unmarshal(stdout, &snaps); err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	dat = make(model.ImageSnapshotList, 0)
+	for _, snap := range snaps {
+		dat = append(dat, model.ImageSnapshot{
+			Id:        snap.Id,
+			Name:      snap.Name,
+			Size:      snap.Size,
+			Protected: snap.Protected == "true",
+			Timestamp: snap.Timestamp,
+		})
+	}
+	return
+}
+
+// ImageWatchers 는 이미지를 열어 사용 중인 클라이언트 목록을 조회합니다.
+func ImageWatchers(ctx context.Context, pool_name string, image_name string) (dat []model.ImageWatcher, err error) {
+	var status struct {
+		Watchers []model.ImageWatcher `json:"watchers"`
+	}
+	stdout, err := rbd(ctx, "status", pool_name+"/"+image_name, "--format", "json")
+	if err != nil {
+		return
+	}
+	if err = json.Unmarshal(stdout, &status); err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	dat = status.Watchers
+	return
+}
+
+// SnapshotCreate 는 이미지 스냅샷을 생성합니다. host_name 과 vm_name 을 지정하면 스냅샷 동안 게스트 파일시스템을 고정합니다.
+func SnapshotCreate(ctx context.Context, pool_name string, image_name string, snapshot_name string, host_name string, vm_name string) (output string, err error) {
+	var stdout []byte
+	if host_name != "" && vm_name != "" {
+		cmd := exec.Command("ssh", "-o", "StrictHostKeyChecking=no", host_name, "virsh", "domfsfreeze", vm_name)
+		stdout, err = cmd.CombinedOutput()
+		if err != nil {
+			err_str := strings.ReplaceAll(string(stdout), "\n", "")
+			err = errors.New(err_str)
+			utils.FancyHandleError(err)
+			return
+		}
+		defer func() {
+			cmd := exec.Command("ssh", "-o", "StrictHostKeyChecking=no", host_name, "virsh", "domfsthaw", vm_name)
+			if stdout, err := cmd.CombinedOutput(); err != nil {
+				utils.FancyHandleError(errors.New(strings.ReplaceAll(string(stdout), "\n", "")))
+			}
+		}()
+	}
+	if _, err = rbd(ctx, "snap", "create", pool_name+"/"+image_name+"@"+snapshot_name); err != nil {
+		return
+	}
+	output = "Success"
+	return
+}
+
+func SnapshotRename(ctx context.Context, pool_name string, image_name string, snapshot_name string, new_snapshot_name string) (output string, err error) {
+	if _, err = rbd(ctx, "snap", "rename", pool_name+"/"+image_name+"@"+snapshot_name, pool_name+"/"+image_name+"@"+new_snapshot_name); err != nil {
+		return
+	}
+	output = "Success"
+	return
+}
+
+// SnapshotProtect 는 클론의 부모로 사용할 수 있도록 스냅샷을 보호하거나 보호를 해제합니다.
+func SnapshotProtect(ctx context.Context, pool_name string, image_name string, snapshot_name string, protected bool) (output string, err error) {
+	action := "unprotect"
+	if protected {
+		action = "protect"
+	}
+	if _, err = rbd(ctx, "snap", action, pool_name+"/"+image_name+"@"+snapshot_name); err != nil {
+		return
+	}
+	output = "Success"
+	return
+}
+
+// SnapshotRollback 은 이미지를 스냅샷 시점으로 되돌립니다. 스냅샷 이후의 데이터는 사라집니다.
+func SnapshotRollback(ctx context.Context, pool_name string, image_name string, snapshot_name string) (output string, err error) {
+	if _, err = rbd(ctx, "snap", "rollback", "--no-progress", pool_name+"/"+image_name+"@"+snapshot_name); err != nil {
+		return
+	}
+	output = "Success"
+	return
+}
+
+func SnapshotDelete(ctx context.Context, pool_name string, image_name string, snapshot_name string) (output string, err error) {
+	if _, err = rbd(ctx, "snap", "rm", "--no-progress", pool_name+"/"+image_name+"@"+snapshot_name); err != nil {
+		return
+	}
+	output = "Success"
+	return
+}
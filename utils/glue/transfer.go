@@ -0,0 +1,65 @@
+package glue
+
+import (
+	"Glue-API/utils"
+	"Glue-API/utils/cluster"
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"strings"
+)
+
+// stream 은 rbd 명령의 표준 입출력을 HTTP 요청 및 응답과 직접 연결합니다.
+func stream(ctx context.Context, r io.Reader, w io.Writer, args ...string) (err error) {
+	var stderr bytes.Buffer
+	cmd := cluster.Command(ctx, "rbd", args...)
+	cmd.Stdin = r
+	cmd.Stdout = w
+	cmd.Stderr = &stderr
+	if err = cmd.Run(); err != nil {
+		if stderr.Len() > 0 {
+			err = errors.New(strings.ReplaceAll(stderr.String(), "\n", ""))
+		}
+		utils.FancyHandleError(err)
+		return
+	}
+	return
+}
+
+// Export 는 이미지 또는 스냅샷의 raw 데이터를 w 로 내보냅니다.
+func Export(ctx context.Context, w io.Writer, pool_name string, image_name string, snapshot_name string) (err error) {
+	spec := pool_name + "/" + image_name
+	if snapshot_name != "" {
+		spec += "@" + snapshot_name
+	}
+	return stream(ctx, nil, w, "export", "--no-progress", spec, "-")
+}
+
+// ExportDiff 는 from_snapshot 부터 snapshot 까지 바뀐 영역을 w 로 내보냅니다. from_snapshot 이 비어 있으면 이미지 생성 시점부터의 변경을 내보냅니다.
+func ExportDiff(ctx context.Context, w io.Writer, pool_name string, image_name string, from_snapshot string, snapshot_name string) (err error) {
+	args := []string{"export-diff", "--no-progress"}
+	if from_snapshot != "" {
+		args = append(args, "--from-snap", from_snapshot)
+	}
+	args = append(args, pool_name+"/"+image_name+"@"+snapshot_name, "-")
+	return stream(ctx, nil, w, args...)
+}
+
+// Import 는 r 로 받은 raw 데이터로 새 이미지를 생성합니다.
+func Import(ctx context.Context, r io.Reader, pool_name string, image_name string) (output string, err error) {
+	if err = stream(ctx, r, io.Discard, "import", "--no-progress", "-", pool_name+"/"+image_name); err != nil {
+		return
+	}
+	output = "Success"
+	return
+}
+
+// ImportDiff 는 r 로 받은 변경분을 기존 이미지에 적용합니다. 변경분의 시작 스냅샷이 이미지에 있어야 합니다.
+func ImportDiff(ctx context.Context, r io.Reader, pool_name string, image_name string) (output string, err error) {
+	if err = stream(ctx, r, io.Discard, "import-diff", "--no-progress", "-", pool_name+"/"+image_name); err != nil {
+		return
+	}
+	output = "Success"
+	return
+}
@@ -0,0 +1,167 @@
+package glue
+
+import (
+	"Glue-API/model"
+	"Glue-API/utils"
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// 휴지통 유예 기간은 librbd 의 삭제 시 휴지통 이동 설정과 같은 풀 설정을 사용합니다.
+var trash_deferment_key = "rbd_move_to_trash_on_remove_expire_seconds"
+
+// TrashDeferment 는 풀의 휴지통 유예 기간(초)을 조회합니다. rbd config pool get 은 풀에 설정이 없으면 실패하므로
+// 전역 설정까지 반영된 rbd config pool list 의 값을 사용합니다.
+func TrashDeferment(ctx context.Context, pool_name string) (seconds int, err error) {
+	stdout, err := rbd(ctx, "config", "pool", "list", pool_name, "--format", "json")
+	if err != nil {
+		return
+	}
+	var configs []struct {
+		Name  string `json:"name"`
+		Value string `json:"value"`
+	}
+	if err = json.Unmarshal(stdout, &configs); err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	for _, config := range configs {
+		if config.Name != trash_deferment_key {
+			continue
+		}
+		if seconds, err = strconv.Atoi(config.Value); err != nil {
+			err = errors.New(trash_deferment_key + " has invalid value " + config.Value)
+			utils.FancyHandleError(err)
+		}
+		return
+	}
+	// 설정 항목이 없는 버전에서는 유예 기간 없이 휴지통으로 옮깁니다.
+	return 0, nil
+}
+
+func TrashDefermentSet(ctx context.Context, pool_name string, seconds int) (output string, err error) {
+	if _, err = rbd(ctx, "config", "pool", "set", pool_name, trash_deferment_key, strconv.Itoa(seconds)); err != nil {
+		return
+	}
+	output = "Success"
+	return
+}
+
+// TrashMove 는 이미지를 휴지통으로 옮깁니다. 유예 기간이 지나기 전에는 강제로만 영구 삭제할 수 있습니다.
+// rbd 는 --expires-at 을 UTC 로 해석합니다.
+func TrashMove(ctx context.Context, pool_name string, image_name string, seconds int) (output string, err error) {
+	expires_at := time.Now().UTC().Add(time.Duration(seconds) * time.Second).Format("2006-01-02 15:04:05")
+	if _, err = rbd(ctx, "trash", "mv", pool_name+"/"+image_name, "--expires-at", expires_at); err != nil {
+		return
+	}
+	output = "Success"
+	return
+}
+
+func TrashList(ctx context.Context, pool_name string) (dat model.ImageTrashList, err error) {
+	stdout, err := rbd(ctx, "trash", "ls", pool_name, "--long", "--format", "json")
+	if err != nil {
+		return
+	}
+	dat = make(model.ImageTrashList, 0)
+	if err = json.Unmarshal(stdout, &dat); err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	for i := range dat {
+		dat[i].Pool = pool_name
+	}
+	return
+}
+
+// TrashRestore 는 휴지통의 이미지를 복구합니다. new_image_name 을 지정하면 다른 이름으로 복구합니다.
+func TrashRestore(ctx context.Context, pool_name string, image_id string, new_image_name string) (output string, err error) {
+	args := []string{"trash", "restore", pool_name + "/" + image_id}
+	if new_image_name != "" {
+		args = append(args, "--image", new_image_name)
+	}
+	if _, err = rbd(ctx, args...); err != nil {
+		return
+	}
+	output = "Success"
+	return
+}
+
+// TrashRemove 는 휴지통의 이미지를 영구 삭제합니다. 유예 기간이 남은 이미지는 force 를 지정해야 합니다.
+func TrashRemove(ctx context.Context, pool_name string, image_id string, force bool) (output string, err error) {
+	args := []string{"trash", "rm", "--no-progress", pool_name + "/" + image_id}
+	if force {
+		args = append(args, "--force")
+	}
+	if _, err = rbd(ctx, args...); err != nil {
+		return
+	}
+	output = "Success"
+	return
+}
+
+// TrashPurge 는 풀의 휴지통에서 유예 기간이 지난 이미지를 모두 영구 삭제합니다.
+func TrashPurge(ctx context.Context, pool_name string) (output string, err error) {
+	if _, err = rbd(ctx, "trash", "purge", "--no-progress", pool_name); err != nil {
+		return
+	}
+	output = "Success"
+	return
+}
+
+// TrashScheduleList 는 휴지통 자동 비우기 일정을 조회합니다. pool_name 이 비어 있으면 모든 풀의 일정을 반환합니다.
+func TrashScheduleList(ctx context.Context, pool_name string) (dat []model.TrashPurgeSchedule, err error) {
+	args := []string{"trash", "purge", "schedule", "ls", "--recursive", "--format", "json"}
+	if pool_name != "" {
+		args = append(args, "--pool", pool_name)
+	}
+	stdout, err := rbd(ctx, args...)
+	if err != nil {
+		return
+	}
+	dat = make([]model.TrashPurgeSchedule, 0)
+	if err = json.Unmarshal(stdout, &dat); err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	return
+}
+
+func trashScheduleArgs(action string, pool_name string, interval string, start_time string) []string {
+	args := []string{"trash", "purge", "schedule", action}
+	if pool_name != "" {
+		args = append(args, "--pool", pool_name)
+	}
+	args = append(args, interval)
+	if start_time != "" {
+		args = append(args, start_time)
+	}
+	return args
+}
+
+// TrashScheduleAdd 는 rbd_support 모듈이 주기적으로 유예 기간이 지난 이미지를 비우도록 일정을 등록합니다.
+func TrashScheduleAdd(ctx context.Context, pool_name string, interval string, start_time string) (output string, err error) {
+	if _, err = rbd(ctx, trashScheduleArgs("add", pool_name, interval, start_time)...); err != nil {
+		return
+	}
+	output = "Success"
+	return
+}
+
+func TrashScheduleRemove(ctx context.Context, pool_name string, interval string, start_time string) (output string, err error) {
+	if _, err = rbd(ctx, trashScheduleArgs("rm", pool_name, interval, start_time)...); err != nil {
+		return
+	}
+	output = "Success"
+	return
+}
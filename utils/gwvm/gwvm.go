@@ -5,10 +5,10 @@ import (
 	// "Glue-API/utils"
 	"Glue-API/utils"
 	"errors"
-	"os/exec"
 
 	"github.com/gin-gonic/gin"
 	// "strings"
+	"os/exec"
 )
 
 func VmState(hypervisorType string) (output string, err error) {
@@ -0,0 +1,86 @@
+package host
+
+import (
+	"Glue-API/model"
+	"Glue-API/utils"
+	"encoding/json"
+	"errors"
+	"os/exec"
+	"strings"
+)
+
+func List() (dat model.OrchHostList, err error) {
+	var stdout []byte
+	cmd := exec.Command("ceph", "orch", "host", "ls", "-f", "json")
+	stdout, err = cmd.CombinedOutput()
+	if err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	if err = json.Unmarshal(stdout, &dat); err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	return
+}
+
+// orch 는 ceph orch host 하위 명령을 실행합니다.
+func orch(args ...string) (output string, err error) {
+	var stdout []byte
+	cmd := exec.Command("ceph", append([]string{"orch", "host"}, args...)...)
+	stdout, err = cmd.CombinedOutput()
+	if err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	output = "Success"
+	return
+}
+
+// Add 는 호스트를 오케스트레이터에 추가합니다. 호스트에는 cephadm 공개 키가 등록되어 있어야 합니다.
+func Add(hostname string, addr string, labels []string) (output string, err error) {
+	args := []string{"add", hostname}
+	if addr != "" {
+		args = append(args, addr)
+	} else if len(labels) > 0 {
+		err = errors.New("addr is required when labels are given")
+		return
+	}
+	return orch(append(args, labels...)...)
+}
+
+// Drain 은 호스트의 모든 데몬을 다른 호스트로 옮기도록 _no_schedule 레이블을 붙입니다.
+func Drain(hostname string) (output string, err error) {
+	return orch("drain", hostname)
+}
+func Remove(hostname string) (output string, err error) {
+	return orch("rm", hostname)
+}
+func LabelAdd(hostname string, label string) (output string, err error) {
+	return orch("label", "add", hostname, label)
+}
+func LabelRemove(hostname string, label string) (output string, err error) {
+	return orch("label", "rm", hostname, label)
+}
+
+// OkToStop 은 호스트의 데몬을 모두 중지해도 되는지 확인합니다.
+func OkToStop(hostname string) (ok bool, message string) {
+	cmd := exec.Command("ceph", "orch", "host", "ok-to-stop", hostname)
+	stdout, err := cmd.CombinedOutput()
+	return err == nil, strings.TrimSpace(string(stdout))
+}
+
+// Maintenance 는 호스트를 유지보수 모드로 전환(enter)하거나 해제(exit)합니다.
+func Maintenance(hostname string, action string, force bool) (output string, err error) {
+	args := []string{"maintenance", action, hostname}
+	if force && action == "enter" {
+		args = append(args, "--force")
+	}
+	return orch(args...)
+}
@@ -0,0 +1,222 @@
+package metric
+
+import (
+	"Glue-API/model"
+	"Glue-API/utils"
+	"Glue-API/utils/glue"
+	"context"
+	"encoding/json"
+	"errors"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+// 예측은 1시간 간격으로 평균한 시계열을 사용하며 최대 5년 뒤까지만 계산합니다.
+var forecast_step int64 = 3600
+var forecast_horizon int64 = 5 * 365 * 24 * 3600
+
+func OsdRatio() (dat model.OsdRatio, err error) {
+	var stdout []byte
+	cmd := exec.Command("ceph", "osd", "dump", "-f", "json")
+	stdout, err = cmd.CombinedOutput()
+	if err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	if err = json.Unmarshal(stdout, &dat); err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	return
+}
+
+// 최소 제곱법으로 value = intercept + slope * timestamp 를 구합니다.
+func linearFit(points []model.MetricPoint) (slope float64, intercept float64) {
+	n := float64(len(points))
+	var sum_t, sum_v, sum_tt, sum_tv float64
+	base := float64(points[0].Timestamp)
+	for _, point := range points {
+		t := float64(point.Timestamp) - base
+		sum_t += t
+		sum_v += point.Value
+		sum_tt += t * t
+		sum_tv += t * point.Value
+	}
+	denominator := n*sum_tt - sum_t*sum_t
+	if denominator == 0 {
+		return 0, sum_v / n
+	}
+	slope = (n*sum_tv - sum_t*sum_v) / denominator
+	intercept = (sum_v-slope*sum_t)/n - slope*base
+	return
+}
+
+// 선형 추세에서 벗어난 값을 하루 중 시간대별로 평균하여 일 단위 계절성을 구합니다.
+func seasonalFit(points []model.MetricPoint, slope float64, intercept float64) (season [24]float64) {
+	var count [24]int
+	for _, point := range points {
+		hour := time.Unix(point.Timestamp, 0).Hour()
+		season[hour] += point.Value - (intercept + slope*float64(point.Timestamp))
+		count[hour]++
+	}
+	for hour := 0; hour < 24; hour++ {
+		if count[hour] > 0 {
+			season[hour] /= float64(count[hour])
+		}
+	}
+	return
+}
+
+// predict 로 계산한 값이 limit 에 도달하는 시점을 찾습니다. 도달하지 않으면 0 을 반환합니다.
+func reachAt(now int64, limit float64, predict func(t int64) float64) int64 {
+	for t := now; t <= now+forecast_horizon; t += forecast_step {
+		if predict(t) >= limit {
+			return t
+		}
+	}
+	return 0
+}
+
+func note(dat *model.ForecastItem, message string) {
+	if dat.Note != "" {
+		dat.Note += "; "
+	}
+	dat.Note += message
+}
+
+func eta(now int64, at int64, dat *model.ForecastEta, full bool) {
+	days := float64(-1)
+	at_str := ""
+	if at > 0 {
+		days = float64(at-now) / 86400
+		at_str = time.Unix(at, 0).Format(time.RFC3339)
+	}
+	if full {
+		dat.DaysToFull = days
+		dat.FullAt = at_str
+	} else {
+		dat.DaysToNearfull = days
+		dat.NearfullAt = at_str
+	}
+}
+
+// forecastItem 은 사용량 시계열로 nearfull, full 도달 시점을 선형 및 계절성 회귀로 예측합니다.
+func forecastItem(dat *model.ForecastItem, points []model.MetricPoint, now int64) {
+	if len(points) < 2 {
+		note(dat, "not enough history to forecast")
+		dat.Linear = model.ForecastEta{DaysToNearfull: -1, DaysToFull: -1}
+		dat.Seasonal = dat.Linear
+		return
+	}
+	slope, intercept := linearFit(points)
+	linear := func(t int64) float64 {
+		return intercept + slope*float64(t)
+	}
+	dat.Linear.GrowthPerDay = slope * 86400
+	eta(now, reachAt(now, dat.NearfullBytes, linear), &dat.Linear, false)
+	eta(now, reachAt(now, dat.FullBytes, linear), &dat.Linear, true)
+
+	if points[len(points)-1].Timestamp-points[0].Timestamp < 2*86400 {
+		note(dat, "seasonal forecast needs at least 2 days of history")
+		dat.Seasonal = model.ForecastEta{GrowthPerDay: dat.Linear.GrowthPerDay, DaysToNearfull: -1, DaysToFull: -1}
+		return
+	}
+	season := seasonalFit(points, slope, intercept)
+	seasonal := func(t int64) float64 {
+		return linear(t) + season[time.Unix(t, 0).Hour()]
+	}
+	dat.Seasonal.GrowthPerDay = dat.Linear.GrowthPerDay
+	eta(now, reachAt(now, dat.NearfullBytes, seasonal), &dat.Seasonal, false)
+	eta(now, reachAt(now, dat.FullBytes, seasonal), &dat.Seasonal, true)
+}
+
+// Forecast 는 최근 history 기간 동안 수집한 샘플로 클러스터와 풀의 용량 포화 시점을 예측합니다.
+// 풀은 다른 풀의 사용량이 변하지 않는다고 가정하고, 남은 원시 용량을 복제 수(size) 또는 EC 오버헤드((k+m)/k)로 나누어 논리 용량 한계를 계산합니다.
+// 샘플은 로컬 클러스터에서만 수집하므로 풀과 OSD 정보도 항상 로컬 클러스터에서 읽습니다.
+func Forecast(history time.Duration) (dat model.CapacityForecast, err error) {
+	end := time.Now().Unix()
+	start := end - int64(history.Seconds())
+	samples, err := Query(start, end)
+	if err != nil {
+		return
+	}
+	if len(samples) == 0 {
+		err = errors.New("no metric samples are collected yet")
+		return
+	}
+	ratio, err := OsdRatio()
+	if err != nil {
+		return
+	}
+	pools, err := glue.PoolDetail(context.Background())
+	if err != nil {
+		return
+	}
+
+	latest := samples[len(samples)-1]
+	total := float64(latest.Cluster.BytesTotal)
+	used := float64(latest.Cluster.BytesUsed)
+	dat = model.CapacityForecast{
+		HistoryStart:  samples[0].Timestamp,
+		HistoryEnd:    latest.Timestamp,
+		NearfullRatio: ratio.NearfullRatio,
+		FullRatio:     ratio.FullRatio,
+		Pools:         make([]model.ForecastItem, 0),
+	}
+
+	series, _ := Series(samples, "bytes_used", "", start, end, forecast_step)
+	dat.Cluster = model.ForecastItem{
+		Name:          "cluster",
+		Size:          1,
+		Used:          used,
+		NearfullBytes: total * ratio.NearfullRatio,
+		FullBytes:     total * ratio.FullRatio,
+	}
+	forecastItem(&dat.Cluster, series.Points, end)
+
+	for _, pool := range pools {
+		var stored float64
+		found := false
+		for _, pool_metric := range latest.Pools {
+			if pool_metric.Name == pool.PoolName {
+				stored = float64(pool_metric.Stored)
+				found = true
+			}
+		}
+		if !found {
+			continue
+		}
+		size := pool.Size
+		if size < 1 {
+			size = 1
+		}
+		overhead := float64(size)
+		// EC 풀(type 3)의 size 는 k+m 이므로 프로파일에서 k 를 읽어 (k+m)/k 를 사용합니다.
+		if pool.Type == 3 {
+			if profile, err := glue.ErasureCodeProfileGet(context.Background(), pool.ErasureCodeProfile); err == nil && profile.K > 0 {
+				overhead = float64(profile.K+profile.M) / float64(profile.K)
+			}
+		}
+		item := model.ForecastItem{
+			Name:          pool.PoolName,
+			Size:          size,
+			Overhead:      overhead,
+			Used:          stored,
+			NearfullBytes: stored + (total*ratio.NearfullRatio-used)/overhead,
+			FullBytes:     stored + (total*ratio.FullRatio-used)/overhead,
+		}
+		if pool.QuotaMaxBytes > 0 && float64(pool.QuotaMaxBytes) < item.FullBytes {
+			item.FullBytes = float64(pool.QuotaMaxBytes)
+			item.Note = "full is limited by pool quota"
+		}
+		series, _ := Series(samples, "stored", pool.PoolName, start, end, forecast_step)
+		forecastItem(&item, series.Points, end)
+		dat.Pools = append(dat.Pools, item)
+	}
+	return
+}
@@ -0,0 +1,309 @@
+package metric
+
+import (
+	"Glue-API/model"
+	"Glue-API/utils"
+	"Glue-API/utils/cluster"
+	"Glue-API/utils/glue"
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// 샘플은 하루 단위 JSON Lines 세그먼트 파일에 저장하며, 보존 기간이 지난 세그먼트는 파일 단위로 삭제합니다.
+var metric_dir = "/var/lib/glue-api/metric"
+var segment_format = "20060102"
+var lock sync.RWMutex
+
+// Interval 과 Retention 은 Collect 가 설정 값으로 변경하므로 lock 을 잡고 읽고 씁니다.
+var Interval = 60 * time.Second
+var Retention = 30 * 24 * time.Hour
+
+// ClusterMetrics 와 PoolMetrics 는 조회 가능한 지표 이름입니다.
+var ClusterMetrics = []string{"health", "data_bytes", "bytes_used", "bytes_avail", "bytes_total", "read_bytes_sec", "write_bytes_sec", "read_op_per_sec", "write_op_per_sec", "num_osds", "num_up_osds", "num_in_osds"}
+var PoolMetrics = []string{"stored", "bytes_used", "max_avail", "percent_used", "objects"}
+
+func Df(ctx context.Context) (dat model.CephDf, err error) {
+	var stdout []byte
+	cmd := cluster.Command(ctx, "ceph", "df", "-f", "json")
+	stdout, err = cmd.CombinedOutput()
+	if err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	if err = json.Unmarshal(stdout, &dat); err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	return
+}
+
+// Sample 은 로컬 클러스터의 현재 상태를 한 번 수집합니다.
+func Sample() (dat model.MetricSample, err error) {
+	status, err := glue.Status()
+	if err != nil {
+		return
+	}
+	df, err := Df(context.Background())
+	if err != nil {
+		return
+	}
+	dat.Timestamp = time.Now().Unix()
+	dat.Cluster = model.ClusterMetric{
+		Health:        status.Health.Status,
+		DataBytes:     status.Pgmap.DataBytes,
+		BytesUsed:     status.Pgmap.BytesUsed,
+		BytesAvail:    status.Pgmap.BytesAvail,
+		BytesTotal:    status.Pgmap.BytesTotal,
+		ReadBytesSec:  status.Pgmap.ReadBytesSec,
+		WriteBytesSec: status.Pgmap.WriteBytesSec,
+		ReadOpPerSec:  status.Pgmap.ReadOpPerSec,
+		WriteOpPerSec: status.Pgmap.WriteOpPerSec,
+		NumOsds:       status.Osdmap.NumOsds,
+		NumUpOsds:     status.Osdmap.NumUpOsds,
+		NumInOsds:     status.Osdmap.NumInOsds,
+	}
+	for _, pool := range df.Pools {
+		dat.Pools = append(dat.Pools, model.PoolMetric{
+			Name:        pool.Name,
+			Stored:      pool.Stats.Stored,
+			BytesUsed:   pool.Stats.BytesUsed,
+			MaxAvail:    pool.Stats.MaxAvail,
+			PercentUsed: pool.Stats.PercentUsed,
+			Objects:     pool.Stats.Objects,
+		})
+	}
+	return
+}
+
+func Append(dat model.MetricSample) (err error) {
+	lock.Lock()
+	defer lock.Unlock()
+
+	if err = os.MkdirAll(metric_dir, 0700); err != nil {
+		utils.FancyHandleError(err)
+		return
+	}
+	segment := filepath.Join(metric_dir, time.Unix(dat.Timestamp, 0).Format(segment_format)+".jsonl")
+	file, err := os.OpenFile(segment, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		utils.FancyHandleError(err)
+		return
+	}
+	defer file.Close()
+	json_data, err := json.Marshal(dat)
+	if err != nil {
+		utils.FancyHandleError(err)
+		return
+	}
+	if _, err = file.Write(append(json_data, '\n')); err != nil {
+		utils.FancyHandleError(err)
+		return
+	}
+	return
+}
+
+// Prune 은 보존 기간이 지난 세그먼트 파일을 삭제합니다.
+func Prune() (err error) {
+	lock.Lock()
+	defer lock.Unlock()
+
+	files, err := filepath.Glob(filepath.Join(metric_dir, "*.jsonl"))
+	if err != nil {
+		utils.FancyHandleError(err)
+		return
+	}
+	oldest := time.Now().Add(-Retention).Format(segment_format)
+	for _, file := range files {
+		if strings.TrimSuffix(filepath.Base(file), ".jsonl") < oldest {
+			os.Remove(file)
+		}
+	}
+	return
+}
+
+// Query 는 start 와 end(Unix 초) 사이의 샘플을 시간 순서대로 반환합니다.
+func Query(start int64, end int64) (dat []model.MetricSample, err error) {
+	lock.RLock()
+	defer lock.RUnlock()
+
+	files, err := filepath.Glob(filepath.Join(metric_dir, "*.jsonl"))
+	if err != nil {
+		utils.FancyHandleError(err)
+		return
+	}
+	sort.Strings(files)
+	first := time.Unix(start, 0).Format(segment_format)
+	last := time.Unix(end, 0).Format(segment_format)
+	for _, file := range files {
+		segment := strings.TrimSuffix(filepath.Base(file), ".jsonl")
+		if segment < first || segment > last {
+			continue
+		}
+		var content *os.File
+		if content, err = os.Open(file); err != nil {
+			utils.FancyHandleError(err)
+			return
+		}
+		scanner := bufio.NewScanner(content)
+		scanner.Buffer(make([]byte, 1024*1024), 16*1024*1024)
+		for scanner.Scan() {
+			var sample model.MetricSample
+			if json.Unmarshal(scanner.Bytes(), &sample) != nil {
+				continue
+			}
+			if sample.Timestamp >= start && sample.Timestamp <= end {
+				dat = append(dat, sample)
+			}
+		}
+		content.Close()
+	}
+	return
+}
+
+// Value 는 샘플에서 지표 값을 꺼냅니다. pool_name 이 비어 있으면 클러스터 지표를 반환합니다.
+func Value(dat model.MetricSample, metric string, pool_name string) (value float64, ok bool) {
+	if pool_name != "" {
+		for _, pool := range dat.Pools {
+			if pool.Name != pool_name {
+				continue
+			}
+			ok = true
+			switch metric {
+			case "stored":
+				value = float64(pool.Stored)
+			case "bytes_used":
+				value = float64(pool.BytesUsed)
+			case "max_avail":
+				value = float64(pool.MaxAvail)
+			case "percent_used":
+				value = pool.PercentUsed
+			case "objects":
+				value = float64(pool.Objects)
+			default:
+				ok = false
+			}
+		}
+		return
+	}
+	ok = true
+	switch metric {
+	case "health":
+		// HEALTH_OK 0, HEALTH_WARN 1, HEALTH_ERR 2
+		switch dat.Cluster.Health {
+		case "HEALTH_OK":
+			value = 0
+		case "HEALTH_WARN":
+			value = 1
+		default:
+			value = 2
+		}
+	case "data_bytes":
+		value = float64(dat.Cluster.DataBytes)
+	case "bytes_used":
+		value = float64(dat.Cluster.BytesUsed)
+	case "bytes_avail":
+		value = float64(dat.Cluster.BytesAvail)
+	case "bytes_total":
+		value = float64(dat.Cluster.BytesTotal)
+	case "read_bytes_sec":
+		value = float64(dat.Cluster.ReadBytesSec)
+	case "write_bytes_sec":
+		value = float64(dat.Cluster.WriteBytesSec)
+	case "read_op_per_sec":
+		value = float64(dat.Cluster.ReadOpPerSec)
+	case "write_op_per_sec":
+		value = float64(dat.Cluster.WriteOpPerSec)
+	case "num_osds":
+		value = float64(dat.Cluster.NumOsds)
+	case "num_up_osds":
+		value = float64(dat.Cluster.NumUpOsds)
+	case "num_in_osds":
+		value = float64(dat.Cluster.NumInOsds)
+	default:
+		ok = false
+	}
+	return
+}
+
+// Series 는 샘플을 step 초 구간으로 나누어 구간별 평균으로 시계열을 만듭니다.
+func Series(samples []model.MetricSample, metric string, pool_name string, start int64, end int64, step int64) (dat model.MetricSeries, err error) {
+	valid := false
+	metrics := ClusterMetrics
+	if pool_name != "" {
+		metrics = PoolMetrics
+	}
+	for _, name := range metrics {
+		if name == metric {
+			valid = true
+		}
+	}
+	if !valid {
+		err = errors.New("unknown metric " + metric)
+		return
+	}
+	if step <= 0 {
+		lock.RLock()
+		step = int64(Interval.Seconds())
+		lock.RUnlock()
+	}
+	dat = model.MetricSeries{Metric: metric, Pool: pool_name, Start: start, End: end, Step: step, Points: make([]model.MetricPoint, 0)}
+
+	var bucket int64 = -1
+	var sum float64
+	var count int
+	for _, sample := range samples {
+		value, ok := Value(sample, metric, pool_name)
+		if !ok {
+			continue
+		}
+		current := start + (sample.Timestamp-start)/step*step
+		if current != bucket && count > 0 {
+			dat.Points = append(dat.Points, model.MetricPoint{Timestamp: bucket, Value: sum / float64(count)})
+			sum, count = 0, 0
+		}
+		bucket = current
+		sum += value
+		count++
+	}
+	if count > 0 {
+		dat.Points = append(dat.Points, model.MetricPoint{Timestamp: bucket, Value: sum / float64(count)})
+	}
+	return
+}
+
+// Collect 는 설정된 주기로 상태를 수집하여 저장하는 백그라운드 작업입니다.
+func Collect(settings model.Settings) {
+	lock.Lock()
+	if interval, err := strconv.Atoi(settings.MetricInterval); err == nil && interval > 0 {
+		Interval = time.Duration(interval) * time.Second
+	}
+	if retention, err := strconv.Atoi(settings.MetricRetention); err == nil && retention > 0 {
+		Retention = time.Duration(retention) * 24 * time.Hour
+	}
+	interval := Interval
+	lock.Unlock()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for ; true; <-ticker.C {
+		dat, err := Sample()
+		if err != nil {
+			continue
+		}
+		Append(dat)
+		Prune()
+	}
+}
@@ -3,6 +3,8 @@ package mirror
 import (
 	"Glue-API/model"
 	"Glue-API/utils"
+	"Glue-API/utils/cluster"
+	"context"
 	"encoding/base64"
 	"encoding/json"
 	"errors"
@@ -18,8 +20,8 @@ import (
 	"github.com/melbahja/goph"
 )
 
-func IsConfigured() (configured bool, err error) {
-	config, err := GetConfigure()
+func IsConfigured(ctx context.Context) (configured bool, err error) {
+	config, err := GetConfigure(ctx)
 	if err != nil || config.Mode == "disabled" {
 		return false, err
 	} else {
@@ -27,14 +29,14 @@ func IsConfigured() (configured bool, err error) {
 	}
 }
 
-func GetConfigure() (clusterConf model.MirrorConf, err error) {
+func GetConfigure(ctx context.Context) (clusterConf model.MirrorConf, err error) {
 	var stdout []byte
 	//sOut := string(stdout)
 	//lines := strings.Split(sOut, "\n")
 	tfCluster, err := os.CreateTemp(os.TempDir(), "Glue-Cluster-")
 	tfKey, err := os.CreateTemp(os.TempDir(), "Glue-Key-")
 
-	cmd := exec.Command("rbd", "mirror", "pool", "info", "--all", "--format", "json", "--pretty-format")
+	cmd := cluster.Command(ctx, "rbd", "mirror", "pool", "info", "--all", "--format", "json", "--pretty-format")
 	stdout, err = cmd.CombinedOutput()
 	if err != nil {
 		return clusterConf, err
@@ -124,9 +126,9 @@ func GetRemoteConfigure(client *goph.Client) (clusterConf model.MirrorConf, err
 	return clusterConf, nil
 }
 
-func RbdImage(pool_name string) (pools []string, err error) {
+func RbdImage(ctx context.Context, pool_name string) (pools []string, err error) {
 	var stdout []byte
-	cmd := exec.Command("rbd", "ls", "-p", pool_name, "--format", "json")
+	cmd := cluster.Command(ctx, "rbd", "ls", "-p", pool_name, "--format", "json")
 	stdout, err = cmd.CombinedOutput()
 	if err != nil {
 		err_str := strings.ReplaceAll(string(stdout), "\n", "")
@@ -143,11 +145,11 @@ func RbdImage(pool_name string) (pools []string, err error) {
 	return
 }
 
-func ImageInfo(poolName string, imageName string) (imageInfo model.ImageInfo, err error) {
+func ImageInfo(ctx context.Context, poolName string, imageName string) (imageInfo model.ImageInfo, err error) {
 
 	var stdoutMirrorPreSetup []byte
 
-	strMirrorPreSetupOutput := exec.Command("rbd", "info", "--image", imageName, "--format", "json", "--pretty-format")
+	strMirrorPreSetupOutput := cluster.Command(ctx, "rbd", "info", "--image", imageName, "--format", "json", "--pretty-format")
 	stdoutMirrorPreSetup, err = strMirrorPreSetupOutput.CombinedOutput()
 	if err != nil {
 		err = errors.New(string(stdoutMirrorPreSetup))
@@ -162,11 +164,11 @@ func ImageInfo(poolName string, imageName string) (imageInfo model.ImageInfo, er
 	return imageInfo, err
 }
 
-func ImageList(pool string) (MirrorList model.MirrorList, err error) {
+func ImageList(ctx context.Context, pool string) (MirrorList model.MirrorList, err error) {
 
 	var stdout []byte
 
-	cmd := exec.Command("rbd", "mirror", "pool", "status", pool, "--verbose", "--format", "json", "--pretty-format")
+	cmd := cluster.Command(ctx, "rbd", "mirror", "pool", "status", pool, "--verbose", "--format", "json", "--pretty-format")
 	stdout, err = cmd.CombinedOutput()
 
 	if err != nil {
@@ -180,12 +182,12 @@ func ImageList(pool string) (MirrorList model.MirrorList, err error) {
 	return MirrorList, err
 }
 
-func Status() (mirrorStatus model.MirrorStatus, err error) {
+func Status(ctx context.Context) (mirrorStatus model.MirrorStatus, err error) {
 	var tmpdat struct {
 		Summary model.MirrorStatus `json:"summary"`
 	}
 	var stdout []byte
-	cmd := exec.Command("rbd", "mirror", "pool", "status", "--format", "json", "--pretty-format")
+	cmd := cluster.Command(ctx, "rbd", "mirror", "pool", "status", "--format", "json", "--pretty-format")
 	var out strings.Builder
 	//cmd.Stderr = &out
 	stdout, err = cmd.CombinedOutput()
@@ -208,11 +210,11 @@ func Status() (mirrorStatus model.MirrorStatus, err error) {
 	return
 }
 
-func ImagePreDelete(poolName string, imageName string) (output string, err error) {
+func ImagePreDelete(ctx context.Context, poolName string, imageName string) (output string, err error) {
 
 	var stdoutMirrorPreDelete []byte
 
-	info, err := ImageInfo(poolName, imageName)
+	info, err := ImageInfo(ctx, poolName, imageName)
 	if info.Parent.Image != "" {
 		stdoutMirrorPreDeleteOutput := exec.Command("rbd", "mirror", "image", "disable", "--pool", poolName, "--image", info.Parent.Image, "snapshot")
 		stdoutMirrorPreDelete, err = stdoutMirrorPreDeleteOutput.CombinedOutput()
@@ -274,11 +276,11 @@ func ImageDeleteSchedule(poolName string, imageName string) (output string, err
 	return
 }
 
-func ImagePreSetup(poolName string, imageName string) (output string, err error) {
+func ImagePreSetup(ctx context.Context, poolName string, imageName string) (output string, err error) {
 
 	var stdoutMirrorPreSetupEnable []byte
 
-	info, err := ImageInfo(poolName, imageName)
+	info, err := ImageInfo(ctx, poolName, imageName)
 	if info.Parent.Image != "" {
 		stdoutMirrorPreSetupEnableOutput := exec.Command("rbd", "mirror", "image", "enable", "--pool", poolName, "--image", info.Parent.Image, "snapshot")
 		stdoutMirrorPreSetupEnable, err = stdoutMirrorPreSetupEnableOutput.CombinedOutput()
@@ -446,7 +448,7 @@ func goCronEventListeners(scheduler gocron.Scheduler, jobID uuid.UUID, beforeIt
 								vm := listVirtualMachinesMetrics.Virtualmachine
 								for k := 0; k < len(vm); k++ {
 									if vm[k].Name == dr[i].Drclustervmmap[j].Drclustermirrorvmname {
-										volStatus, _ := ImageStatus("rbd", dr[i].Drclustervmmap[j].Drclustermirrorvmvolpath)
+										volStatus, _ := ImageStatus(context.Background(), "rbd", dr[i].Drclustervmmap[j].Drclustermirrorvmvolpath)
 										// 미러링 이미지 상태가 Peer와 정상적으로 ready, sync 인 경우
 										if volStatus.Description == "local image is primary" && strings.Contains(volStatus.PeerSites[0].State, "replaying") && strings.Contains(volStatus.PeerSites[0].Description, "idle") {
 											params2 := []utils.MoldParams{
@@ -657,12 +659,12 @@ func ImageUpdate(poolName string, imageName string, interval string, startTime s
 	return
 }
 
-func ImageRemoteUpdate(poolName string, imageName string, interval string, startTime string) (output string, err error) {
+func ImageRemoteUpdate(ctx context.Context, poolName string, imageName string, interval string, startTime string) (output string, err error) {
 
 	var stdoutScheduleEnable []byte
 	var strScheduleOutput *exec.Cmd
 
-	mirrorConfig, err := GetConfigure()
+	mirrorConfig, err := GetConfigure(ctx)
 	if err != nil {
 		return
 	}
@@ -683,11 +685,11 @@ func ImageRemoteUpdate(poolName string, imageName string, interval string, start
 	return
 }
 
-func ImageStatus(poolName string, imageName string) (imageStatus model.ImageStatus, err error) {
+func ImageStatus(ctx context.Context, poolName string, imageName string) (imageStatus model.ImageStatus, err error) {
 
 	var stdoutScheduleEnable []byte
 
-	strScheduleOutput := exec.Command("rbd", "mirror", "image", "status", "--pool", poolName, "--image", imageName, "--format", "json")
+	strScheduleOutput := cluster.Command(ctx, "rbd", "mirror", "image", "status", "--pool", poolName, "--image", imageName, "--format", "json")
 	stdoutScheduleEnable, err = strScheduleOutput.CombinedOutput()
 	if err != nil {
 		err = errors.Join(err, errors.New(string(stdoutScheduleEnable)))
@@ -1179,13 +1181,13 @@ func ImageMetaGetInterval() (output string, err error) {
 	return
 }
 
-func ImagePromote(poolName string, imageName string) (output string, err error) {
+func ImagePromote(ctx context.Context, poolName string, imageName string) (output string, err error) {
 
 	var stdoutScheduleEnable []byte
-	strScheduleOutput := exec.Command("rbd", "mirror", "image", "promote", "--pool", poolName, "--image", imageName, "--force")
+	strScheduleOutput := cluster.Command(ctx, "rbd", "mirror", "image", "promote", "--pool", poolName, "--image", imageName, "--force")
 	stdoutScheduleEnable, err = strScheduleOutput.CombinedOutput()
 	if strings.Contains(string(stdoutScheduleEnable), "unrecognised option") {
-		strScheduleOutput = exec.Command("rbd", "mirror", "image", "promote", "--pool", poolName, "--image", imageName)
+		strScheduleOutput = cluster.Command(ctx, "rbd", "mirror", "image", "promote", "--pool", poolName, "--image", imageName)
 		stdoutScheduleEnable, err = strScheduleOutput.CombinedOutput()
 	}
 	if !strings.Contains(string(stdoutScheduleEnable), "Image promoted") {
@@ -1201,14 +1203,14 @@ func ImagePromote(poolName string, imageName string) (output string, err error)
 	return
 }
 
-func ImageDemote(poolName string, imageName string) (output string, err error) {
+func ImageDemote(ctx context.Context, poolName string, imageName string) (output string, err error) {
 
 	var stdoutScheduleEnable []byte
 
-	strScheduleOutput := exec.Command("rbd", "mirror", "image", "demote", "--pool", poolName, "--image", imageName, "--force")
+	strScheduleOutput := cluster.Command(ctx, "rbd", "mirror", "image", "demote", "--pool", poolName, "--image", imageName, "--force")
 	stdoutScheduleEnable, err = strScheduleOutput.CombinedOutput()
 	if strings.Contains(string(stdoutScheduleEnable), "unrecognised option") {
-		strScheduleOutput = exec.Command("rbd", "mirror", "image", "demote", "--pool", poolName, "--image", imageName)
+		strScheduleOutput = cluster.Command(ctx, "rbd", "mirror", "image", "demote", "--pool", poolName, "--image", imageName)
 		stdoutScheduleEnable, err = strScheduleOutput.CombinedOutput()
 	}
 	if !strings.Contains(string(stdoutScheduleEnable), "Image demoted") {
@@ -1222,10 +1224,10 @@ func ImageDemote(poolName string, imageName string) (output string, err error) {
 	return
 }
 
-func RemoteImagePromote(poolName string, imageName string) (output string, err error) {
+func RemoteImagePromote(ctx context.Context, poolName string, imageName string) (output string, err error) {
 
 	var stdoutScheduleEnable []byte
-	conf, err := GetConfigure()
+	conf, err := GetConfigure(ctx)
 	strScheduleOutput := exec.Command("rbd", "-c", conf.ClusterFileName, "--cluster", conf.ClusterName, "--name", conf.Peers[0].ClientName, "--keyfile", conf.KeyFileName, "mirror", "image", "promote", "--pool", poolName, "--image", imageName, "--force")
 	stdoutScheduleEnable, err = strScheduleOutput.CombinedOutput()
 	if strings.Contains(string(stdoutScheduleEnable), "unrecognised option") {
@@ -1243,10 +1245,10 @@ func RemoteImagePromote(poolName string, imageName string) (output string, err e
 	return
 }
 
-func RemoteImageDemote(poolName string, imageName string) (output string, err error) {
+func RemoteImageDemote(ctx context.Context, poolName string, imageName string) (output string, err error) {
 
 	var stdoutScheduleEnable []byte
-	conf, err := GetConfigure()
+	conf, err := GetConfigure(ctx)
 	strScheduleOutput := exec.Command("rbd", "-c", conf.ClusterFileName, "--cluster", conf.ClusterName, "--name", conf.Peers[0].ClientName, "--keyfile", conf.KeyFileName, "mirror", "image", "demote", "--pool", poolName, "--image", imageName, "--force")
 	stdoutScheduleEnable, err = strScheduleOutput.CombinedOutput()
 	if strings.Contains(string(stdoutScheduleEnable), "unrecognised option") {
@@ -1264,10 +1266,10 @@ func RemoteImageDemote(poolName string, imageName string) (output string, err er
 	return
 }
 
-func RemoteImageResync(poolName string, imageName string) (output string, err error) {
+func RemoteImageResync(ctx context.Context, poolName string, imageName string) (output string, err error) {
 
 	var stdoutScheduleEnable []byte
-	conf, err := GetConfigure()
+	conf, err := GetConfigure(ctx)
 	strScheduleOutput := exec.Command("rbd", "-c", conf.ClusterFileName, "--cluster", conf.ClusterName, "--name", conf.Peers[0].ClientName, "--keyfile", conf.KeyFileName, "mirror", "image", "resync", "--pool", poolName, "--image", imageName)
 	stdoutScheduleEnable, err = strScheduleOutput.CombinedOutput()
 
@@ -1282,11 +1284,11 @@ func RemoteImageResync(poolName string, imageName string) (output string, err er
 	return
 }
 
-func ImageResync(poolName string, imageName string) (output string, err error) {
+func ImageResync(ctx context.Context, poolName string, imageName string) (output string, err error) {
 
 	var stdoutScheduleEnable []byte
 
-	strScheduleOutput := exec.Command("rbd", "mirror", "image", "resync", "--pool", poolName, "--image", imageName)
+	strScheduleOutput := cluster.Command(ctx, "rbd", "mirror", "image", "resync", "--pool", poolName, "--image", imageName)
 	stdoutScheduleEnable, err = strScheduleOutput.CombinedOutput()
 
 	if !strings.Contains(string(stdoutScheduleEnable), "Flagged image") {
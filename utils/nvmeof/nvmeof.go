@@ -3,14 +3,67 @@ package nvmeof
 import (
 	"Glue-API/model"
 	"Glue-API/utils"
+	"Glue-API/utils/config"
+	"context"
 	"encoding/json"
 	"errors"
+	"os"
 	"os/exec"
 	"strings"
+	"sync"
 )
 
 var nvme_image_version = "quay.io/ceph/nvmeof-cli:1.2.13"
 
+// ImageUpdate 와 CLI 명령이 동시에 이미지를 읽고 쓰므로 nvme_image_version 을 보호합니다.
+var image_lock sync.RWMutex
+
+// 업그레이드 후 게이트웨이 버전에 맞춰 변경한 CLI 이미지를 보관합니다.
+var nvme_image_conf = "./nvmeof_cli.json"
+
+func init() {
+	var dat model.NvmeOfImage
+	content, err := os.ReadFile(nvme_image_conf)
+	if err != nil {
+		return
+	}
+	if err = json.Unmarshal(content, &dat); err == nil && dat.CliImage != "" {
+		nvme_image_version = dat.CliImage
+	}
+}
+
+// Image 는 NVMe-OF CLI 이미지와 cephadm 이 배포하는 게이트웨이 이미지를 조회합니다.
+func Image() (dat model.NvmeOfImage, err error) {
+	dat.CliImage = cliImage()
+	dat.GatewayImage, err = config.Get(context.Background(), "mgr", "mgr/cephadm/container_image_nvmeof")
+	return
+}
+
+// ImageUpdate 는 NvmeOfCliDownload 및 CLI 명령에 사용하는 NVMe-OF CLI 이미지를 변경합니다.
+func ImageUpdate(image string) (output string, err error) {
+	dat := model.NvmeOfImage{CliImage: image}
+	json_data, err := json.MarshalIndent(dat, "", " ")
+	if err != nil {
+		utils.FancyHandleError(err)
+		return
+	}
+	image_lock.Lock()
+	defer image_lock.Unlock()
+	if err = os.WriteFile(nvme_image_conf, json_data, 0600); err != nil {
+		utils.FancyHandleError(err)
+		return
+	}
+	nvme_image_version = image
+	output = "Success"
+	return
+}
+
+func cliImage() string {
+	image_lock.RLock()
+	defer image_lock.RUnlock()
+	return nvme_image_version
+}
+
 func Container(hostname string) (output string, err error) {
 	var stdout []byte
 	cmd := exec.Command("ssh", "-o", "StrictHostKeyChecking=no", hostname, "podman ps | grep 'nvmeof' | awk '{print $1}'")
@@ -92,7 +145,7 @@ func NvmeOfServiceCreate(yaml_file string, pool_name string) (output string, err
 }
 func NvmeOfCliDownload(hostname string) (output string, err error) {
 	var stdout []byte
-	cmd := exec.Command("ssh", "-o", "StrictHostKeyChecking=no", hostname, "podman", "pull", nvme_image_version)
+	cmd := exec.Command("ssh", "-o", "StrictHostKeyChecking=no", hostname, "podman", "pull", cliImage())
 	stdout, err = cmd.CombinedOutput()
 	if err != nil {
 		err_str := strings.ReplaceAll(string(stdout), "\n", "")
@@ -105,7 +158,7 @@ func NvmeOfCliDownload(hostname string) (output string, err error) {
 }
 func NvmeOfSubSystemCreate(hostname string, server_gateway_ip string, server_gateway_port string, subsystem_nqn_id string) (output string, err error) {
 	var stdout []byte
-	cmd := exec.Command("ssh", "-o", "StrictHostKeyChecking=no", hostname, "podman", "run", "-i", nvme_image_version, "--server-address", server_gateway_ip, "--server-port", server_gateway_port, "subsystem", "add", "--subsystem", subsystem_nqn_id)
+	cmd := exec.Command("ssh", "-o", "StrictHostKeyChecking=no", hostname, "podman", "run", "-i", cliImage(), "--server-address", server_gateway_ip, "--server-port", server_gateway_port, "subsystem", "add", "--subsystem", subsystem_nqn_id)
 	stdout, err = cmd.CombinedOutput()
 	if err != nil {
 		err_str := strings.ReplaceAll(string(stdout), "\n", "")
@@ -140,7 +193,7 @@ func NvmeOfGatewayName() (output model.NvmeOfGatewayName, err error) {
 }
 func NvmeOfDefineGateway(hostname string, server_gateway_ip string, server_gateway_port, subsystem_nqn_id string, gateway_name string, gateway_ip string) (output string, err error) {
 	var stdout []byte
-	cmd := exec.Command("ssh", "-o", "StrictHostKeyChecking=no", hostname, "podman", "run", "-i", nvme_image_version, "--server-address", server_gateway_ip, "--server-port", server_gateway_port, "listener", "add", "--subsystem", subsystem_nqn_id, "--host-name", gateway_name, "--traddr", gateway_ip, "--trsvcid", "4420")
+	cmd := exec.Command("ssh", "-o", "StrictHostKeyChecking=no", hostname, "podman", "run", "-i", cliImage(), "--server-address", server_gateway_ip, "--server-port", server_gateway_port, "listener", "add", "--subsystem", subsystem_nqn_id, "--host-name", gateway_name, "--traddr", gateway_ip, "--trsvcid", "4420")
 	stdout, err = cmd.CombinedOutput()
 	if err != nil {
 		err_str := strings.ReplaceAll(string(stdout), "\n", "")
@@ -153,7 +206,7 @@ func NvmeOfDefineGateway(hostname string, server_gateway_ip string, server_gatew
 }
 func NvmeOfHostAdd(hostname string, server_gateway_ip string, server_gateway_port string, subsystem_nqn_id string) (output string, err error) {
 	var stdout []byte
-	cmd := exec.Command("ssh", "-o", "StrictHostKeyChecking=no", hostname, "podman", "run", "-i", nvme_image_version, "--server-address", server_gateway_ip, "--server-port", server_gateway_port, "host", "add", "--subsystem", subsystem_nqn_id, "--host", "'*'")
+	cmd := exec.Command("ssh", "-o", "StrictHostKeyChecking=no", hostname, "podman", "run", "-i", cliImage(), "--server-address", server_gateway_ip, "--server-port", server_gateway_port, "host", "add", "--subsystem", subsystem_nqn_id, "--host", "'*'")
 	stdout, err = cmd.CombinedOutput()
 	if err != nil {
 		err_str := strings.ReplaceAll(string(stdout), "\n", "")
@@ -164,9 +217,44 @@ func NvmeOfHostAdd(hostname string, server_gateway_ip string, server_gateway_por
 	output = "Success"
 	return
 }
+
+// NvmeOfHostAllow 는 지정한 호스트 NQN 만 서브 시스템에 접근할 수 있도록 추가합니다.
+func NvmeOfHostAllow(hostname string, server_gateway_ip string, server_gateway_port string, subsystem_nqn_id string, host_nqn string) (output string, err error) {
+	var stdout []byte
+	cmd := exec.Command("ssh", "-o", "StrictHostKeyChecking=no", hostname, "podman", "run", "-i", cliImage(), "--server-address", server_gateway_ip, "--server-port", server_gateway_port, "host", "add", "--subsystem", subsystem_nqn_id, "--host", host_nqn)
+	stdout, err = cmd.CombinedOutput()
+	if err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	output = "Success"
+	return
+}
+
+// NvmeOfHostList 는 서브 시스템에 접근할 수 있는 호스트 NQN 과 모든 호스트 허용 여부를 조회합니다.
+func NvmeOfHostList(hostname string, server_gateway_ip string, server_gateway_port string, subsystem_nqn_id string) (output model.NvmeOfHostList, err error) {
+	var stdout []byte
+	cmd := exec.Command("ssh", "-o", "StrictHostKeyChecking=no", hostname, "podman", "run", "-i", cliImage(), "--format", "json", "--server-address", server_gateway_ip, "--server-port", server_gateway_port, "host", "list", "--subsystem", subsystem_nqn_id)
+	stdout, err = cmd.CombinedOutput()
+	if err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	if err = json.Unmarshal(stdout, &output); err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	return
+}
 func NvmeOfNameSpaceCreate(hostname string, server_gateway_ip string, server_gateway_port string, subsystem_nqn_id string, pool_name string, image_name string) (output string, err error) {
 	var stdout []byte
-	cmd := exec.Command("ssh", "-o", "StrictHostKeyChecking=no", hostname, "podman", "run", "-i", nvme_image_version, "--server-address", server_gateway_ip, "--server-port", server_gateway_port, "namespace", "add", "--subsystem", subsystem_nqn_id, "--rbd-pool", pool_name, "--rbd-image", image_name)
+	cmd := exec.Command("ssh", "-o", "StrictHostKeyChecking=no", hostname, "podman", "run", "-i", cliImage(), "--server-address", server_gateway_ip, "--server-port", server_gateway_port, "namespace", "add", "--subsystem", subsystem_nqn_id, "--rbd-pool", pool_name, "--rbd-image", image_name)
 	stdout, err = cmd.CombinedOutput()
 	if err != nil {
 		err_str := strings.ReplaceAll(string(stdout), "\n", "")
@@ -180,7 +268,7 @@ func NvmeOfNameSpaceCreate(hostname string, server_gateway_ip string, server_gat
 func NvmeOfSubSystemList(hostname string, server_gateway_ip string, server_gateway_port string, subsystem_nqn_id string) (output model.NvmeOfSubSystemList, err error) {
 	var stdout []byte
 	if subsystem_nqn_id == "" {
-		cmd := exec.Command("ssh", "-o", "StrictHostKeyChecking=no", hostname, "podman", "run", "-i", nvme_image_version, "--format", "json", "--server-address", server_gateway_ip, "--server-port", server_gateway_port, "subsystem", "list")
+		cmd := exec.Command("ssh", "-o", "StrictHostKeyChecking=no", hostname, "podman", "run", "-i", cliImage(), "--format", "json", "--server-address", server_gateway_ip, "--server-port", server_gateway_port, "subsystem", "list")
 		stdout, err = cmd.CombinedOutput()
 		if err != nil {
 			err_str := strings.ReplaceAll(string(stdout), "\n", "")
@@ -196,7 +284,7 @@ func NvmeOfSubSystemList(hostname string, server_gateway_ip string, server_gatew
 		}
 		return
 	} else {
-		cmd := exec.Command("ssh", "-o", "StrictHostKeyChecking=no", hostname, "podman", "run", "-i", nvme_image_version, "--format", "json", "--server-address", server_gateway_ip, "--server-port", server_gateway_port, "subsystem", "list", "--subsystem", subsystem_nqn_id)
+		cmd := exec.Command("ssh", "-o", "StrictHostKeyChecking=no", hostname, "podman", "run", "-i", cliImage(), "--format", "json", "--server-address", server_gateway_ip, "--server-port", server_gateway_port, "subsystem", "list", "--subsystem", subsystem_nqn_id)
 		stdout, err = cmd.CombinedOutput()
 		if err != nil {
 			err_str := strings.ReplaceAll(string(stdout), "\n", "")
@@ -215,7 +303,7 @@ func NvmeOfSubSystemList(hostname string, server_gateway_ip string, server_gatew
 }
 func NvmeOfNameSpaceList(hostname string, server_gateway_ip string, server_gateway_port string, subsystem_nqn_id string) (output model.NvmeOfNameSpaceList, err error) {
 	var stdout []byte
-	cmd := exec.Command("ssh", "-o", "StrictHostKeyChecking=no", hostname, "podman", "run", "-i", nvme_image_version, "--format", "json", "--server-address", server_gateway_ip, "--server-port", server_gateway_port, "namespace", "list", "--subsystem", subsystem_nqn_id)
+	cmd := exec.Command("ssh", "-o", "StrictHostKeyChecking=no", hostname, "podman", "run", "-i", cliImage(), "--format", "json", "--server-address", server_gateway_ip, "--server-port", server_gateway_port, "namespace", "list", "--subsystem", subsystem_nqn_id)
 	stdout, err = cmd.CombinedOutput()
 	if err != nil {
 		err_str := strings.ReplaceAll(string(stdout), "\n", "")
@@ -233,7 +321,7 @@ func NvmeOfNameSpaceList(hostname string, server_gateway_ip string, server_gatew
 }
 func NvmeOfSubSystemDelete(hostname string, server_gateway_ip string, server_gateway_port string, subsystem_nqn_id string) (output string, err error) {
 	var stdout []byte
-	cmd := exec.Command("ssh", "-o", "StrictHostKeyChecking=no", hostname, "podman", "run", "-i", nvme_image_version, "--server-address", server_gateway_ip, "--server-port", server_gateway_port, "subsystem", "del", "--subsystem", subsystem_nqn_id)
+	cmd := exec.Command("ssh", "-o", "StrictHostKeyChecking=no", hostname, "podman", "run", "-i", cliImage(), "--server-address", server_gateway_ip, "--server-port", server_gateway_port, "subsystem", "del", "--subsystem", subsystem_nqn_id)
 	stdout, err = cmd.CombinedOutput()
 	if err != nil {
 		err_str := strings.ReplaceAll(string(stdout), "\n", "")
@@ -246,7 +334,7 @@ func NvmeOfSubSystemDelete(hostname string, server_gateway_ip string, server_gat
 }
 func NvmeOfNameSpaceDelete(hostname string, server_gateway_ip string, server_gateway_port string, subsystem_nqn_id string, uuid string) (output string, err error) {
 	var stdout []byte
-	cmd := exec.Command("ssh", "-o", "StrictHostKeyChecking=no", hostname, "podman", "run", "-i", nvme_image_version, "--server-address", server_gateway_ip, "--server-port", server_gateway_port, "namespace", "del", "--subsystem", subsystem_nqn_id, "--uuid", uuid)
+	cmd := exec.Command("ssh", "-o", "StrictHostKeyChecking=no", hostname, "podman", "run", "-i", cliImage(), "--server-address", server_gateway_ip, "--server-port", server_gateway_port, "namespace", "del", "--subsystem", subsystem_nqn_id, "--uuid", uuid)
 	stdout, err = cmd.CombinedOutput()
 	if err != nil {
 		err_str := strings.ReplaceAll(string(stdout), "\n", "")
@@ -0,0 +1,152 @@
+package osd
+
+import (
+	"Glue-API/model"
+	"Glue-API/utils"
+	"encoding/json"
+	"errors"
+	"os"
+	"os/exec"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// ceph orch device ls 의 호스트별 인벤토리입니다.
+type inventory []struct {
+	Name    string `json:"name"`
+	Devices []struct {
+		Path   string `json:"path"`
+		SysApi struct {
+			Rotational string  `json:"rotational"`
+			Size       float64 `json:"size"`
+			Vendor     string  `json:"vendor"`
+			Model      string  `json:"model"`
+		} `json:"sys_api"`
+		Available         bool     `json:"available"`
+		RejectedReasons   []string `json:"rejected_reasons"`
+		DeviceId          string   `json:"device_id"`
+		HumanReadableType string   `json:"human_readable_type"`
+		CrushDeviceClass  string   `json:"crush_device_class"`
+		LsmData           struct {
+			Health string `json:"health"`
+		} `json:"lsm_data"`
+	} `json:"devices"`
+}
+
+// DeviceList 는 호스트의 디스크 인벤토리를 조회합니다. hostname 이 비어 있으면 모든 호스트를 조회하고, refresh 이면 인벤토리를 다시 수집합니다.
+func DeviceList(hostname string, refresh bool) (dat model.DeviceList, err error) {
+	var stdout []byte
+	var hosts inventory
+	args := []string{"orch", "device", "ls"}
+	if hostname != "" {
+		args = append(args, hostname)
+	}
+	if refresh {
+		args = append(args, "--refresh")
+	}
+	args = append(args, "-f", "json")
+	cmd := exec.Command("ceph", args...)
+	stdout, err = cmd.CombinedOutput()
+	if err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	dat = make(model.DeviceList, 0)
+	if !strings.HasPrefix(strings.TrimSpace(string(stdout)), "[") {
+		return
+	}
+	if err = json.Unmarshal(stdout, &hosts); err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	for _, host := range hosts {
+		for _, device := range host.Devices {
+			health := device.LsmData.Health
+			if health == "" {
+				health = "Unknown"
+			}
+			rejected_reasons := device.RejectedReasons
+			if rejected_reasons == nil {
+				rejected_reasons = make([]string, 0)
+			}
+			dat = append(dat, model.Device{
+				Hostname:         host.Name,
+				Path:             device.Path,
+				DeviceId:         device.DeviceId,
+				Type:             device.HumanReadableType,
+				Rotational:       device.SysApi.Rotational == "1",
+				Size:             int64(device.SysApi.Size),
+				Vendor:           device.SysApi.Vendor,
+				Model:            device.SysApi.Model,
+				Health:           health,
+				Available:        device.Available,
+				RejectedReasons:  rejected_reasons,
+				CrushDeviceClass: device.CrushDeviceClass,
+			})
+		}
+	}
+	return
+}
+
+// Create 는 호스트의 디스크에 OSD 를 생성합니다. db_devices, wal_devices 를 지정하면 BlueStore DB/WAL 을 해당 디스크에 둡니다.
+func Create(hostname string, data_devices []string, db_devices []string, wal_devices []string) (output string, err error) {
+	var stdout []byte
+	target := hostname + ":data_devices=" + strings.Join(data_devices, ",")
+	if len(db_devices) > 0 {
+		target += ",db_devices=" + strings.Join(db_devices, ",")
+	}
+	if len(wal_devices) > 0 {
+		target += ",wal_devices=" + strings.Join(wal_devices, ",")
+	}
+	cmd := exec.Command("ceph", "orch", "daemon", "add", "osd", target)
+	stdout, err = cmd.CombinedOutput()
+	if err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	output = strings.TrimSuffix(strings.ReplaceAll(string(stdout), "\n", "."), ".")
+	return
+}
+
+// SpecApply 는 OSD 서비스 명세를 적용합니다. dry_run 이면 생성될 OSD 미리보기를 반환합니다.
+func SpecApply(spec model.OsdSpec, dry_run bool) (output string, err error) {
+	var stdout []byte
+	yaml_data, err := yaml.Marshal(spec)
+	if err != nil {
+		utils.FancyHandleError(err)
+		return
+	}
+	file, err := os.CreateTemp("", "osd_spec_*.yaml")
+	if err != nil {
+		utils.FancyHandleError(err)
+		return
+	}
+	defer os.Remove(file.Name())
+	_, err = file.Write(yaml_data)
+	file.Close()
+	if err != nil {
+		utils.FancyHandleError(err)
+		return
+	}
+	args := []string{"orch", "apply", "-i", file.Name()}
+	if dry_run {
+		args = append(args, "--dry-run")
+	}
+	cmd := exec.Command("ceph", args...)
+	stdout, err = cmd.CombinedOutput()
+	if err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	output = string(stdout)
+	return
+}
@@ -0,0 +1,241 @@
+package osd
+
+import (
+	"Glue-API/model"
+	"Glue-API/utils"
+	"encoding/json"
+	"errors"
+	"os/exec"
+	"strconv"
+	"strings"
+	"syscall"
+)
+
+// Flags 는 API 로 설정할 수 있는 클러스터 OSD 플래그입니다.
+var Flags = []string{"noout", "norebalance", "nobackfill", "norecover", "noscrub", "nodeep-scrub", "noup", "nodown", "noin"}
+
+func Df() (dat model.OsdDf, err error) {
+	var stdout []byte
+	cmd := exec.Command("ceph", "osd", "df", "-f", "json")
+	stdout, err = cmd.CombinedOutput()
+	if err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	if err = json.Unmarshal(stdout, &dat); err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	return
+}
+func Metadata() (dat model.OsdMetadata, err error) {
+	var stdout []byte
+	cmd := exec.Command("ceph", "osd", "metadata", "-f", "json")
+	stdout, err = cmd.CombinedOutput()
+	if err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	if err = json.Unmarshal(stdout, &dat); err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	return
+}
+
+// List 는 ceph osd df 와 ceph osd metadata 를 합쳐 OSD 목록을 만듭니다.
+func List() (dat []model.Osd, err error) {
+	df, err := Df()
+	if err != nil {
+		return
+	}
+	metadata, err := Metadata()
+	if err != nil {
+		return
+	}
+	dat = make([]model.Osd, 0)
+	for _, node := range df.Nodes {
+		item := model.Osd{
+			Id:          node.Id,
+			Name:        node.Name,
+			DeviceClass: node.DeviceClass,
+			Status:      node.Status,
+			In:          node.Reweight > 0,
+			CrushWeight: node.CrushWeight,
+			Reweight:    node.Reweight,
+			TotalBytes:  node.Kb * 1024,
+			UsedBytes:   node.KbUsed * 1024,
+			AvailBytes:  node.KbAvail * 1024,
+			Utilization: node.Utilization,
+			Pgs:         node.Pgs,
+		}
+		for _, current := range metadata {
+			if current.Id == node.Id {
+				item.Host = current.Hostname
+				item.Devices = current.Devices
+				item.Version = current.CephVersion
+			}
+		}
+		dat = append(dat, item)
+	}
+	return
+}
+
+// Mark 는 OSD 를 in 또는 out 으로 표시합니다.
+func Mark(osd_id int, state string) (output string, err error) {
+	var stdout []byte
+	cmd := exec.Command("ceph", "osd", state, strconv.Itoa(osd_id))
+	stdout, err = cmd.CombinedOutput()
+	if err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	output = "Success"
+	return
+}
+
+// FlagList 는 ceph osd dump 의 flags 에서 설정 가능한 플래그의 상태를 읽습니다.
+func FlagList() (dat []model.OsdFlag, err error) {
+	var stdout []byte
+	var dump struct {
+		Flags string `json:"flags"`
+	}
+	cmd := exec.Command("ceph", "osd", "dump", "-f", "json")
+	stdout, err = cmd.CombinedOutput()
+	if err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	if err = json.Unmarshal(stdout, &dump); err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	enabled := make(map[string]bool)
+	for _, flag := range strings.Split(dump.Flags, ",") {
+		enabled[flag] = true
+	}
+	dat = make([]model.OsdFlag, 0)
+	for _, flag := range Flags {
+		dat = append(dat, model.OsdFlag{Flag: flag, Enabled: enabled[flag]})
+	}
+	return
+}
+func FlagSet(flag string, enabled bool) (output string, err error) {
+	var stdout []byte
+	action := "unset"
+	if enabled {
+		action = "set"
+	}
+	cmd := exec.Command("ceph", "osd", action, flag)
+	stdout, err = cmd.CombinedOutput()
+	if err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	output = "Success"
+	return
+}
+
+// check 는 ok-to-stop, safe-to-destroy 를 실행합니다. ceph 는 안전하지 않으면 EBUSY 또는 EAGAIN 으로 종료합니다.
+func check(command string, osd_id int) (ok bool, message string, err error) {
+	var stdout []byte
+	cmd := exec.Command("ceph", "osd", command, strconv.Itoa(osd_id))
+	stdout, err = cmd.CombinedOutput()
+	message = strings.TrimSpace(string(stdout))
+	if err == nil {
+		ok = true
+		return
+	}
+	if exit_err, is_exit := err.(*exec.ExitError); is_exit {
+		code := exit_err.ExitCode()
+		if code == int(syscall.EBUSY) || code == int(syscall.EAGAIN) {
+			err = nil
+			return
+		}
+	}
+	err = errors.New(strings.ReplaceAll(message, "\n", ""))
+	utils.FancyHandleError(err)
+	return
+}
+func Safety(osd_id int) (dat model.OsdSafety, err error) {
+	dat.OsdId = osd_id
+	if dat.OkToStop, dat.OkToStopMessage, err = check("ok-to-stop", osd_id); err != nil {
+		return
+	}
+	dat.SafeToDestroy, dat.SafeToDestroyMessage, err = check("safe-to-destroy", osd_id)
+	return
+}
+
+// Remove 는 cephadm 으로 OSD 의 PG 를 비운 뒤 제거합니다. replace 를 지정하면 OSD 번호를 남겨 교체 디스크에서 재사용합니다.
+func Remove(osd_id int, replace bool, zap bool) (output string, err error) {
+	var stdout []byte
+	args := []string{"orch", "osd", "rm", strconv.Itoa(osd_id)}
+	if replace {
+		args = append(args, "--replace")
+	}
+	if zap {
+		args = append(args, "--zap")
+	}
+	cmd := exec.Command("ceph", args...)
+	stdout, err = cmd.CombinedOutput()
+	if err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	output = "Success"
+	return
+}
+func RemoveStatus() (dat []model.OsdRmStatus, err error) {
+	var stdout []byte
+	cmd := exec.Command("ceph", "orch", "osd", "rm", "status", "-f", "json")
+	stdout, err = cmd.CombinedOutput()
+	if err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	dat = make([]model.OsdRmStatus, 0)
+	// 제거 중인 OSD 가 없으면 JSON 이 아닌 안내 문구를 출력합니다.
+	if !strings.HasPrefix(strings.TrimSpace(string(stdout)), "[") {
+		return
+	}
+	if err = json.Unmarshal(stdout, &dat); err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	return
+}
+func RemoveStop(osd_id int) (output string, err error) {
+	var stdout []byte
+	cmd := exec.Command("ceph", "orch", "osd", "rm", "stop", strconv.Itoa(osd_id))
+	stdout, err = cmd.CombinedOutput()
+	if err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	output = "Success"
+	return
+}
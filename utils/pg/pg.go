@@ -0,0 +1,202 @@
+package pg
+
+import (
+	"Glue-API/model"
+	"Glue-API/utils"
+	"Glue-API/utils/cluster"
+	"Glue-API/utils/glue"
+	"context"
+	"encoding/json"
+	"errors"
+	"os/exec"
+	"strconv"
+	"strings"
+)
+
+// StuckTypes 는 ceph pg dump_stuck 으로 조회할 수 있는 상태입니다.
+var StuckTypes = []string{"inactive", "unclean", "stale", "undersized", "degraded"}
+
+type pgStat struct {
+	PgId    string `json:"pgid"`
+	State   string `json:"state"`
+	StatSum struct {
+		NumObjects          int64 `json:"num_objects"`
+		NumObjectsDegraded  int64 `json:"num_objects_degraded"`
+		NumObjectsMisplaced int64 `json:"num_objects_misplaced"`
+		NumObjectsUnfound   int64 `json:"num_objects_unfound"`
+	} `json:"stat_sum"`
+	Up                 []int  `json:"up"`
+	Acting             []int  `json:"acting"`
+	UpPrimary          int    `json:"up_primary"`
+	ActingPrimary      int    `json:"acting_primary"`
+	LastScrubStamp     string `json:"last_scrub_stamp"`
+	LastDeepScrubStamp string `json:"last_deep_scrub_stamp"`
+}
+
+// 버전에 따라 PG 목록을 배열 또는 객체로 출력하므로 둘 다 처리합니다.
+func parse(stdout []byte, key string) (dat []pgStat, err error) {
+	dat = make([]pgStat, 0)
+	output := string(stdout)
+	start := strings.IndexAny(output, "[{")
+	if start < 0 {
+		return
+	}
+	if output[start] == '[' {
+		err = json.Unmarshal([]byte(output[start:]), &dat)
+		return
+	}
+	var wrapper map[string]json.RawMessage
+	if err = json.Unmarshal([]byte(output[start:]), &wrapper); err != nil {
+		return
+	}
+	if raw, ok := wrapper[key]; ok {
+		err = json.Unmarshal(raw, &dat)
+	}
+	return
+}
+
+// pgid(3.1f) 의 앞부분은 풀 ID 입니다.
+func poolNames() (dat map[string]string, err error) {
+	dat = make(map[string]string)
+	pools, err := glue.PoolDetail(context.Background())
+	if err != nil {
+		return
+	}
+	for _, pool := range pools {
+		dat[strconv.Itoa(pool.PoolId)] = pool.PoolName
+	}
+	return
+}
+
+func convert(stats []pgStat, pools map[string]string) (dat model.PgList) {
+	dat = make(model.PgList, 0)
+	for _, stat := range stats {
+		dat = append(dat, model.Pg{
+			PgId:               stat.PgId,
+			Pool:               pools[strings.Split(stat.PgId, ".")[0]],
+			State:              stat.State,
+			Up:                 stat.Up,
+			Acting:             stat.Acting,
+			UpPrimary:          stat.UpPrimary,
+			ActingPrimary:      stat.ActingPrimary,
+			Objects:            stat.StatSum.NumObjects,
+			ObjectsDegraded:    stat.StatSum.NumObjectsDegraded,
+			ObjectsMisplaced:   stat.StatSum.NumObjectsMisplaced,
+			ObjectsUnfound:     stat.StatSum.NumObjectsUnfound,
+			LastScrubStamp:     stat.LastScrubStamp,
+			LastDeepScrubStamp: stat.LastDeepScrubStamp,
+		})
+	}
+	return
+}
+
+// List 는 PG 목록을 조회합니다. pool_name 이 비어 있으면 모든 풀, states 가 비어 있으면 모든 상태의 PG 를 반환합니다.
+func List(pool_name string, states []string) (dat model.PgList, err error) {
+	var stdout []byte
+	args := []string{"pg", "ls"}
+	if pool_name != "" {
+		args = []string{"pg", "ls-by-pool", pool_name}
+	}
+	args = append(args, states...)
+	args = append(args, "-f", "json")
+	cmd := exec.Command("ceph", args...)
+	stdout, err = cmd.CombinedOutput()
+	if err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	stats, err := parse(stdout, "pg_stats")
+	if err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	pools, err := poolNames()
+	if err != nil {
+		return
+	}
+	dat = convert(stats, pools)
+	return
+}
+
+// Stuck 은 지정한 상태로 멈춰 있는 PG 목록을 조회합니다.
+func Stuck(stuck_type string) (dat model.PgList, err error) {
+	var stdout []byte
+	cmd := exec.Command("ceph", "pg", "dump_stuck", stuck_type, "-f", "json")
+	stdout, err = cmd.CombinedOutput()
+	if err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	stats, err := parse(stdout, "stuck_pg_stats")
+	if err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	pools, err := poolNames()
+	if err != nil {
+		return
+	}
+	dat = convert(stats, pools)
+	return
+}
+
+// Scrub 은 PG 에 scrub, deep-scrub, repair 를 요청합니다.
+func Scrub(action string, pg_id string) (output string, err error) {
+	var stdout []byte
+	cmd := exec.Command("ceph", "pg", action, pg_id)
+	stdout, err = cmd.CombinedOutput()
+	if err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	output = strings.TrimSuffix(strings.ReplaceAll(string(stdout), "\n", "."), ".")
+	return
+}
+
+// PoolScrub 은 풀의 모든 PG 에 scrub, deep-scrub, repair 를 요청합니다.
+func PoolScrub(ctx context.Context, action string, pool_name string) (output string, err error) {
+	var stdout []byte
+	cmd := cluster.Command(ctx, "ceph", "osd", "pool", action, pool_name)
+	stdout, err = cmd.CombinedOutput()
+	if err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	output = "Success"
+	return
+}
+
+// InconsistentObjects 는 PG 의 불일치 객체와 샤드별 오류를 조회합니다.
+func InconsistentObjects(pg_id string) (dat model.PgInconsistent, err error) {
+	var stdout []byte
+	cmd := exec.Command("rados", "list-inconsistent-obj", pg_id, "--format=json")
+	stdout, err = cmd.CombinedOutput()
+	if err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	if err = json.Unmarshal(stdout, &dat); err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	if dat.Inconsistents == nil {
+		dat.Inconsistents = make([]model.PgInconsistentObject, 0)
+	}
+	return
+}
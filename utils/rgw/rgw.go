@@ -3,6 +3,8 @@ package rgw
 import (
 	"Glue-API/model"
 	"Glue-API/utils"
+	"Glue-API/utils/cluster"
+	"context"
 	"encoding/json"
 	"errors"
 	"os/exec"
@@ -367,3 +369,88 @@ func RgwBucketDelete(bucket_name string) (output string, err error) {
 	}
 	return
 }
+
+// radosgwAdmin 은 radosgw-admin 을 실행하고 JSON 결과를 dat 에 담습니다.
+func radosgwAdmin(ctx context.Context, dat interface{}, args ...string) (err error) {
+	var stdout []byte
+	cmd := cluster.Command(ctx, "radosgw-admin", args...)
+	stdout, err = cmd.CombinedOutput()
+	if err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	if err = json.Unmarshal(stdout, dat); err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	return
+}
+
+// PoolBuckets 는 풀을 데이터, 추가 데이터 또는 인덱스 풀로 사용하는 버킷 목록을 반환합니다.
+// 버킷에 explicit_placement 가 있으면 그 풀을, 없으면 존의 placement 설정에서 버킷의 placement_rule 에 해당하는 풀을 사용합니다.
+func PoolBuckets(ctx context.Context, pool_name string) (dat []string, err error) {
+	var buckets []struct {
+		Bucket            string `json:"bucket"`
+		PlacementRule     string `json:"placement_rule"`
+		ExplicitPlacement struct {
+			DataPool      string `json:"data_pool"`
+			DataExtraPool string `json:"data_extra_pool"`
+			IndexPool     string `json:"index_pool"`
+		} `json:"explicit_placement"`
+	}
+	var zonegroup struct {
+		DefaultPlacement string `json:"default_placement"`
+	}
+	var zone struct {
+		PlacementPools []struct {
+			Key string `json:"key"`
+			Val struct {
+				IndexPool      string `json:"index_pool"`
+				DataExtraPool  string `json:"data_extra_pool"`
+				StorageClasses map[string]struct {
+					DataPool string `json:"data_pool"`
+				} `json:"storage_classes"`
+			} `json:"val"`
+		} `json:"placement_pools"`
+	}
+	if err = radosgwAdmin(ctx, &buckets, "bucket", "stats"); err != nil {
+		return
+	}
+	if err = radosgwAdmin(ctx, &zonegroup, "zonegroup", "get"); err != nil {
+		return
+	}
+	if err = radosgwAdmin(ctx, &zone, "zone", "get"); err != nil {
+		return
+	}
+	placements := make(map[string]bool)
+	for _, placement := range zone.PlacementPools {
+		used := placement.Val.IndexPool == pool_name || placement.Val.DataExtraPool == pool_name
+		for _, storage_class := range placement.Val.StorageClasses {
+			used = used || storage_class.DataPool == pool_name
+		}
+		placements[placement.Key] = used
+	}
+	dat = make([]string, 0)
+	for _, bucket := range buckets {
+		explicit := bucket.ExplicitPlacement
+		if explicit.DataPool != "" || explicit.IndexPool != "" {
+			if explicit.DataPool == pool_name || explicit.DataExtraPool == pool_name || explicit.IndexPool == pool_name {
+				dat = append(dat, bucket.Bucket)
+			}
+			continue
+		}
+		// placement_rule 은 "placement/storage_class" 형식일 수 있습니다.
+		rule := strings.Split(bucket.PlacementRule, "/")[0]
+		if rule == "" {
+			rule = zonegroup.DefaultPlacement
+		}
+		if placements[rule] {
+			dat = append(dat, bucket.Bucket)
+		}
+	}
+	return
+}
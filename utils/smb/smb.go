@@ -183,3 +183,27 @@ func Hosts() (output []string, err error) {
 	output = host_data
 	return
 }
+
+// StatusList 는 모든 SMB 호스트의 상태를 조회합니다.
+func StatusList() (output []model.SmbNormalStatus, err error) {
+	hosts, err := Hosts()
+	if err != nil {
+		return
+	}
+	for i := 0; i < len(hosts); i++ {
+		if hosts[i] == "" {
+			continue
+		}
+		cmd := exec.Command("sh", "-c", "cat /etc/hosts | grep "+hosts[i]+" | awk '{print $2}'| cut -d '-' -f1")
+		stdout, _ := cmd.CombinedOutput()
+		hostname := strings.Split(string(stdout), "\n")
+		status, _ := SmbStatus(hosts[i], hostname[0])
+
+		var dat model.SmbNormalStatus
+		json_data, _ := json.Marshal(status)
+		json.Unmarshal(json_data, &dat)
+		dat.IpAddress = hosts[i]
+		output = append(output, dat)
+	}
+	return
+}
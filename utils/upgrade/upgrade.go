@@ -0,0 +1,306 @@
+package upgrade
+
+import (
+	"Glue-API/model"
+	"Glue-API/utils"
+	"Glue-API/utils/glue"
+	"Glue-API/utils/mirror"
+	"Glue-API/utils/osd"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"os/exec"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// 업그레이드 시작 시 확인한 대상 버전을 진행률 계산을 위해 보관합니다.
+// 업그레이드 API 는 로컬 클러스터에서만 동작하므로(ClusterSelect) 파일은 로컬 클러스터 기준 하나입니다.
+var target_conf = "./upgrade.json"
+
+// ceph orch upgrade 는 mgr, mon, osd 순서로 데몬을 업그레이드합니다.
+var daemon_order = []string{"mgr", "mon", "osd", "mds", "rgw", "rbd-mirror"}
+
+func orch(args ...string) (stdout []byte, err error) {
+	cmd := exec.Command("ceph", append([]string{"orch", "upgrade"}, args...)...)
+	stdout, err = cmd.CombinedOutput()
+	if err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	return
+}
+
+// Versions 는 레지스트리에서 업그레이드할 수 있는 버전 목록을 조회합니다. image 가 비어 있으면 기본 이미지를 사용합니다.
+func Versions(image string) (dat model.UpgradeVersions, err error) {
+	args := []string{"ls"}
+	if image != "" {
+		args = append(args, "--image", image)
+	}
+	stdout, err := orch(args...)
+	if err != nil {
+		return
+	}
+	if err = json.Unmarshal(stdout, &dat); err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	return
+}
+
+func targetArgs(command string, version string, image string) []string {
+	args := []string{command}
+	if image != "" {
+		args = append(args, "--image", image)
+	} else {
+		args = append(args, "--ceph-version", version)
+	}
+	return args
+}
+
+// Check 는 대상 이미지를 받아 버전을 확인하고 업데이트가 필요한 데몬 목록을 조회합니다.
+func Check(version string, image string) (dat model.UpgradeCheck, err error) {
+	stdout, err := orch(targetArgs("check", version, image)...)
+	if err != nil {
+		return
+	}
+	if err = json.Unmarshal(stdout, &dat); err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	return
+}
+
+// ProgressEvents 는 mgr progress 모듈에서 진행 중인 작업(복구, 리밸런싱 등)의 메시지를 조회합니다.
+func ProgressEvents() (output []string, err error) {
+	var stdout []byte
+	var dat struct {
+		Events []struct {
+			Message string `json:"message"`
+		} `json:"events"`
+	}
+	cmd := exec.Command("ceph", "progress", "json")
+	stdout, err = cmd.CombinedOutput()
+	if err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	if err = json.Unmarshal(stdout, &dat); err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	output = make([]string, 0)
+	for _, event := range dat.Events {
+		output = append(output, event.Message)
+	}
+	return
+}
+
+// Preflight 는 업그레이드를 시작하기 전에 클러스터 상태, 미러링 재동기화, 진행 중인 작업을 점검합니다.
+func Preflight(version string, image string) (dat model.UpgradePreflight, err error) {
+	dat.Blockers = make([]string, 0)
+	if dat.Check, err = Check(version, image); err != nil {
+		return
+	}
+
+	status, err := glue.Status()
+	if err != nil {
+		return
+	}
+	if status.Health.Status != "HEALTH_OK" {
+		checks := make([]string, 0)
+		for code := range status.Health.Checks {
+			checks = append(checks, code)
+		}
+		sort.Strings(checks)
+		dat.Blockers = append(dat.Blockers, "cluster health is "+status.Health.Status+": "+strings.Join(checks, ", "))
+	}
+
+	upgrade, err := Status()
+	if err != nil {
+		return
+	}
+	if upgrade.InProgress {
+		dat.Blockers = append(dat.Blockers, "upgrade to "+upgrade.TargetImage+" is already in progress")
+	}
+
+	// 미러링이 설정되지 않은 풀은 조회에 실패하므로 건너뜁니다.
+	pools, err := glue.RbdPool(context.Background())
+	if err != nil {
+		return
+	}
+	for _, pool := range pools {
+		list, err := mirror.ImageList(context.Background(), pool)
+		if err != nil {
+			continue
+		}
+		for _, image := range list.Images {
+			if strings.Contains(image.State, "syncing") {
+				dat.Blockers = append(dat.Blockers, "mirror image "+pool+"/"+image.Name+" is "+image.State+": "+image.Description)
+			}
+		}
+	}
+
+	events, err := ProgressEvents()
+	if err != nil {
+		return
+	}
+	for _, event := range events {
+		dat.Blockers = append(dat.Blockers, "running job: "+event)
+	}
+	removals, err := osd.RemoveStatus()
+	if err != nil {
+		return
+	}
+	for _, removal := range removals {
+		dat.Blockers = append(dat.Blockers, "running job: removal of osd."+strconv.Itoa(removal.OsdId))
+	}
+
+	dat.Ready = len(dat.Blockers) == 0
+	return
+}
+
+// Start 는 업그레이드를 시작하고 진행률 계산을 위해 대상 버전을 저장합니다.
+func Start(version string, image string, target_version string) (output string, err error) {
+	stdout, err := orch(targetArgs("start", version, image)...)
+	if err != nil {
+		return
+	}
+	target := model.UpgradeTarget{
+		Image:     image,
+		Version:   target_version,
+		StartedAt: time.Now().Format(time.RFC3339),
+	}
+	json_data, err := json.MarshalIndent(target, "", " ")
+	if err != nil {
+		utils.FancyHandleError(err)
+		return
+	}
+	if err = os.WriteFile(target_conf, json_data, 0600); err != nil {
+		utils.FancyHandleError(err)
+		return
+	}
+	output = strings.TrimSuffix(strings.ReplaceAll(string(stdout), "\n", "."), ".")
+	return
+}
+
+// Control 은 진행 중인 업그레이드를 일시 중지(pause), 재개(resume), 중단(stop)합니다.
+func Control(control string) (output string, err error) {
+	stdout, err := orch(control)
+	if err != nil {
+		return
+	}
+	if control == "stop" {
+		os.Remove(target_conf)
+	}
+	output = strings.TrimSuffix(strings.ReplaceAll(string(stdout), "\n", "."), ".")
+	return
+}
+
+func Status() (dat model.UpgradeStatus, err error) {
+	stdout, err := orch("status")
+	if err != nil {
+		return
+	}
+	if err = json.Unmarshal(stdout, &dat); err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	return
+}
+
+func target() (dat model.UpgradeTarget, err error) {
+	content, err := os.ReadFile(target_conf)
+	if err != nil {
+		if os.IsNotExist(err) {
+			err = nil
+		}
+		return
+	}
+	err = json.Unmarshal(content, &dat)
+	return
+}
+
+// Progress 는 ceph versions 의 데몬 종류별 버전 분포로 업그레이드 진행률을 계산합니다.
+func Progress() (dat model.UpgradeProgress, err error) {
+	var stdout []byte
+	if dat.Status, err = Status(); err != nil {
+		return
+	}
+	upgrade_target, err := target()
+	if err != nil {
+		utils.FancyHandleError(err)
+		return
+	}
+	dat.TargetVersion = upgrade_target.Version
+	dat.StartedAt = upgrade_target.StartedAt
+
+	versions := make(map[string]map[string]int)
+	cmd := exec.Command("ceph", "versions")
+	stdout, err = cmd.CombinedOutput()
+	if err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	if err = json.Unmarshal(stdout, &versions); err != nil {
+		err_str := strings.ReplaceAll(string(stdout), "\n", "")
+		err = errors.New(err_str)
+		utils.FancyHandleError(err)
+		return
+	}
+	delete(versions, "overall")
+
+	types := make([]string, 0)
+	for _, daemon_type := range daemon_order {
+		if _, ok := versions[daemon_type]; ok {
+			types = append(types, daemon_type)
+		}
+	}
+	others := make([]string, 0)
+	for daemon_type := range versions {
+		known := false
+		for _, ordered := range daemon_order {
+			if ordered == daemon_type {
+				known = true
+			}
+		}
+		if !known {
+			others = append(others, daemon_type)
+		}
+	}
+	sort.Strings(others)
+	types = append(types, others...)
+
+	dat.Daemons = make([]model.UpgradeDaemonProgress, 0)
+	for _, daemon_type := range types {
+		progress := model.UpgradeDaemonProgress{DaemonType: daemon_type, Versions: versions[daemon_type]}
+		for version, count := range versions[daemon_type] {
+			progress.Total += count
+			// 버전 문자열은 "ceph version 18.2.4 (...) reef (stable)" 형식입니다.
+			if dat.TargetVersion != "" && strings.HasPrefix(version, "ceph version "+dat.TargetVersion+" ") {
+				progress.Upgraded += count
+			}
+		}
+		dat.Total += progress.Total
+		dat.Upgraded += progress.Upgraded
+		dat.Daemons = append(dat.Daemons, progress)
+	}
+	return
+}